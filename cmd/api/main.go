@@ -10,22 +10,52 @@ import (
 	"syscall"
 	"time"
 
+	authrepo "github.com/BagusAK95/amarta_test/internal/application/auth/repository"
+	authuc "github.com/BagusAK95/amarta_test/internal/application/auth/usecase"
+	autoinvestrepo "github.com/BagusAK95/amarta_test/internal/application/autoinvest/repository"
+	autoinvestuc "github.com/BagusAK95/amarta_test/internal/application/autoinvest/usecase"
 	borrowerrepo "github.com/BagusAK95/amarta_test/internal/application/borrower/repository"
+	borroweruc "github.com/BagusAK95/amarta_test/internal/application/borrower/usecase"
+	borrowergrouprepo "github.com/BagusAK95/amarta_test/internal/application/borrowergroup/repository"
+	borrowergroupuc "github.com/BagusAK95/amarta_test/internal/application/borrowergroup/usecase"
+	branchrepo "github.com/BagusAK95/amarta_test/internal/application/branch/repository"
+	eligibilityuc "github.com/BagusAK95/amarta_test/internal/application/eligibility/usecase"
 	employeerepo "github.com/BagusAK95/amarta_test/internal/application/employee/repository"
+	employeeuc "github.com/BagusAK95/amarta_test/internal/application/employee/usecase"
+	installmentrepo "github.com/BagusAK95/amarta_test/internal/application/installment/repository"
 	investmentrepo "github.com/BagusAK95/amarta_test/internal/application/investment/repository"
 	investmentuc "github.com/BagusAK95/amarta_test/internal/application/investment/usecase"
 	investorrepo "github.com/BagusAK95/amarta_test/internal/application/investor/repository"
+	ledgerrepo "github.com/BagusAK95/amarta_test/internal/application/ledger/repository"
+	ledgeruc "github.com/BagusAK95/amarta_test/internal/application/ledger/usecase"
 	loanrepo "github.com/BagusAK95/amarta_test/internal/application/loan/repository"
 	loanuc "github.com/BagusAK95/amarta_test/internal/application/loan/usecase"
 	mailuc "github.com/BagusAK95/amarta_test/internal/application/mail/usecase"
+	marketplaceuc "github.com/BagusAK95/amarta_test/internal/application/marketplace/usecase"
+	pricingrepo "github.com/BagusAK95/amarta_test/internal/application/pricing/repository"
+	pricinguc "github.com/BagusAK95/amarta_test/internal/application/pricing/usecase"
+	repaymentrepo "github.com/BagusAK95/amarta_test/internal/application/repayment/repository"
+	repaymentuc "github.com/BagusAK95/amarta_test/internal/application/repayment/usecase"
+	scoringrepo "github.com/BagusAK95/amarta_test/internal/application/scoring/repository"
+	scoringuc "github.com/BagusAK95/amarta_test/internal/application/scoring/usecase"
+	secondarymarketrepo "github.com/BagusAK95/amarta_test/internal/application/secondarymarket/repository"
+	secondarymarketuc "github.com/BagusAK95/amarta_test/internal/application/secondarymarket/usecase"
+	walletrepo "github.com/BagusAK95/amarta_test/internal/application/wallet/repository"
+	walletuc "github.com/BagusAK95/amarta_test/internal/application/wallet/usecase"
 	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/eligibility"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
 	"github.com/BagusAK95/amarta_test/internal/domain/mail"
+	"github.com/BagusAK95/amarta_test/internal/domain/pricing"
 	"github.com/BagusAK95/amarta_test/internal/infrastructure/bus"
 	"github.com/BagusAK95/amarta_test/internal/infrastructure/database"
 	mailsender "github.com/BagusAK95/amarta_test/internal/infrastructure/mail"
+	"github.com/BagusAK95/amarta_test/internal/infrastructure/payment"
+	"github.com/BagusAK95/amarta_test/internal/infrastructure/token"
 	"github.com/BagusAK95/amarta_test/internal/infrastructure/tracer"
 	buslistener "github.com/BagusAK95/amarta_test/internal/presentation/messaging/bus"
 	"github.com/BagusAK95/amarta_test/internal/presentation/rest/router"
+	"github.com/BagusAK95/amarta_test/internal/presentation/scheduler"
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel"
 )
@@ -48,25 +78,98 @@ func main() {
 	mailSender := mailsender.NewSender(cfg.Mail)
 	mailBus := bus.NewBus[mail.MailSendRequest]()
 
+	// Loan events
+	loanBus := bus.NewBus[loan.LoanApprovedEvent]()
+
+	// Payment gateway
+	paymentGateway, err := payment.NewGateway(cfg.Payment)
+	if err != nil {
+		log.Fatalf("❌ Could not load payment gateway: %v", err)
+	}
+
+	// Token signing
+	tokenManager, err := token.NewManager(cfg.Auth)
+	if err != nil {
+		log.Fatalf("❌ Could not load auth keys: %v", err)
+	}
+
 	// Initialize repository
 	employeeRepo := employeerepo.NewEmployeeRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	roleRepo := employeerepo.NewRoleRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	employeeRoleRepo := employeerepo.NewEmployeeRoleRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	branchRepo := branchrepo.NewBranchRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	employeeBranchRepo := branchrepo.NewEmployeeBranchRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
 	borrowerRepo := borrowerrepo.NewBorrowerRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	kycDocumentRepo := borrowerrepo.NewKYCDocumentRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	blacklistRepo := borrowerrepo.NewBlacklistRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	groupRepo := borrowergrouprepo.NewGroupRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	memberRepo := borrowergrouprepo.NewMemberRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	groupLoanRepo := borrowergrouprepo.NewGroupLoanRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
 	loanRepo := loanrepo.NewLoanRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	approvalPolicyRepo := loanrepo.NewApprovalPolicyRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	approvalVoteRepo := loanrepo.NewApprovalVoteRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
 	investmentRepo := investmentrepo.NewInvestmentRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
 	investorRepo := investorrepo.NewInvestorRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	installmentRepo := installmentrepo.NewInstallmentRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	repaymentRepo := repaymentrepo.NewRepaymentRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	distributionRepo := repaymentrepo.NewDistributionRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	journalEntryRepo := ledgerrepo.NewJournalEntryRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	postingRepo := ledgerrepo.NewPostingRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	topupRepo := walletrepo.NewTopupRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	withdrawalRepo := walletrepo.NewWithdrawalRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	listingRepo := secondarymarketrepo.NewListingRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	transferRepo := secondarymarketrepo.NewTransferRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	ruleRepo := autoinvestrepo.NewRuleRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	autoInvestmentRepo := autoinvestrepo.NewAutoInvestmentRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	scorecardRepo := scoringrepo.NewScorecardRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	tierRepo := pricingrepo.NewTierRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+	revokedTokenRepo := authrepo.NewRevokedTokenRepo(dbConn.Postgres.Master, dbConn.Postgres.Slave)
+
+	// Initialize eligibility rules, every rule runs before a loan is proposed
+	eligibilityEngine := eligibility.NewEngine(
+		eligibility.BorrowerStatusRule{},
+		eligibility.BlacklistRule{},
+		eligibility.MaxActiveLoansRule{Max: config.ELIGIBILITY_MAX_ACTIVE_LOANS},
+		eligibility.RejectionCooldownRule{Period: config.ELIGIBILITY_REJECTION_COOLDOWN},
+		eligibility.CreditLimitRule{
+			BaseLimit: config.ELIGIBILITY_BASE_CREDIT_LIMIT,
+			Step:      config.ELIGIBILITY_CREDIT_LIMIT_STEP,
+			MaxLimit:  config.ELIGIBILITY_MAX_CREDIT_LIMIT,
+		},
+	)
 
 	// Initialize usecase
-	loanUsecase := loanuc.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-	investmentUsecase := investmentuc.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+	authUsecase := authuc.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+	employeeUsecase := employeeuc.NewEmployeeUsecase(employeeRepo, roleRepo, employeeRoleRepo, branchRepo, employeeBranchRepo)
+	borrowerUsecase := borroweruc.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+	ledgerUsecase := ledgeruc.NewLedgerUsecase(journalEntryRepo, postingRepo, investorRepo)
+	eligibilityUsecase := eligibilityuc.NewEligibilityUsecase(eligibilityEngine, loanRepo, installmentRepo, blacklistRepo)
+	scoringUsecase := scoringuc.NewScoringUsecase(scorecardRepo, loanRepo, installmentRepo)
+	pricingUsecase := pricinguc.NewPricingUsecase(pricing.Policy{
+		MinSpread:    config.PRICING_MIN_SPREAD,
+		MaxDeviation: config.PRICING_MAX_DEVIATION,
+	}, tierRepo)
+	loanUsecase := loanuc.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+	borrowerGroupUsecase := borrowergroupuc.NewBorrowerGroupUsecase(groupRepo, memberRepo, groupLoanRepo, borrowerRepo, loanRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, loanUsecase, loanBus)
+	investmentUsecase := investmentuc.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+	repaymentUsecase := repaymentuc.NewRepaymentUsecase(repaymentRepo, distributionRepo, loanRepo, installmentRepo, investmentRepo, ledgerUsecase)
+	walletUsecase := walletuc.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, paymentGateway)
+	marketplaceUsecase := marketplaceuc.NewMarketplaceUsecase(loanRepo, investmentRepo, borrowerRepo)
+	secondaryMarketUsecase := secondarymarketuc.NewSecondaryMarketUsecase(listingRepo, transferRepo, investmentRepo, investorRepo, loanRepo, ledgerUsecase)
+	autoInvestUsecase := autoinvestuc.NewAutoInvestUsecase(ruleRepo, autoInvestmentRepo, loanRepo, borrowerRepo, investorRepo, investmentRepo, investmentUsecase)
 	mailUsecase := mailuc.NewMailUsecase(mailSender)
 
 	// Bus listener
-	buslistener.NewBusListener(mailBus, mailUsecase)
+	buslistener.NewBusListener(mailBus, mailUsecase, loanBus, autoInvestUsecase)
+
+	// Background jobs
+	jobScheduler := scheduler.NewScheduler(cfg.Loan, investmentUsecase)
+	jobScheduler.Start()
 
 	// Start server
 	gin.SetMode(gin.ReleaseMode)
 
-	r := router.NewRouter(loanUsecase, investmentUsecase, otel.Tracer("GinServer"))
+	r := router.NewRouter(authUsecase, employeeUsecase, borrowerUsecase, loanUsecase, borrowerGroupUsecase, investmentUsecase, repaymentUsecase, ledgerUsecase, walletUsecase, marketplaceUsecase, secondaryMarketUsecase, autoInvestUsecase, otel.Tracer("GinServer"))
 	addr := fmt.Sprintf(":%d", cfg.Application.Port)
 
 	srv := &http.Server{
@@ -88,6 +191,7 @@ func main() {
 	log.Println("💤 Shutting down server...")
 
 	// Close connection
+	jobScheduler.Stop()
 	database.CloseConnection(dbConn)
 	tracer.Shutdown(context.Background())
 
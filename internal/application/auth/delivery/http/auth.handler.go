@@ -0,0 +1,105 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/auth"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/BagusAK95/amarta_test/internal/utils/validator"
+	"github.com/gin-gonic/gin"
+)
+
+type authHandler struct {
+	usecase   auth.IAuthUsecase
+	validator *validator.CustomValidator
+}
+
+func NewAuthHandler(usecase auth.IAuthUsecase) *authHandler {
+	return &authHandler{
+		usecase:   usecase,
+		validator: validator.NewValidator(),
+	}
+}
+
+func (h *authHandler) LoginEmployee(c *gin.Context) {
+	var body auth.LoginRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	res, err := h.usecase.LoginEmployee(c.Request.Context(), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *authHandler) LoginInvestor(c *gin.Context) {
+	var body auth.LoginRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	res, err := h.usecase.LoginInvestor(c.Request.Context(), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *authHandler) Refresh(c *gin.Context) {
+	var body auth.RefreshRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	res, err := h.usecase.Refresh(c.Request.Context(), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *authHandler) Logout(c *gin.Context) {
+	var body auth.LogoutRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&body); err != nil {
+			_ = c.Error(httpError.NewBadRequestError(err.Error()))
+			return
+		}
+	}
+
+	principal, _ := auth.PrincipalFromContext(c.Request.Context())
+
+	err := h.usecase.Logout(c.Request.Context(), principal, body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
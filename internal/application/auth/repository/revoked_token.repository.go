@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/auth"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var tracerName = "RevokedTokenRepository"
+var tracer = otel.Tracer(tracerName)
+
+type revokedTokenRepo struct {
+	repository.BaseRepo[auth.RevokedToken]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewRevokedTokenRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) auth.IRevokedTokenRepository {
+	baseRepo := repository.NewBaseRepo[auth.RevokedToken](dbMaster, dbSlave)
+
+	return &revokedTokenRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+// IsRevoked reads from the master so a token revoked a moment ago is not accepted from a lagging replica
+func (r *revokedTokenRepo) IsRevoked(ctx context.Context, tokenID uuid.UUID) (revoked bool, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".IsRevoked")
+	defer span.End()
+
+	var model auth.RevokedToken
+
+	builder := sq.
+		Select("COUNT(*) > 0").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"token_id":   tokenID,
+			"deleted_at": nil,
+		})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.writeConn.WithContext(ctx).Raw(qry, args...).Scan(&revoked).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Revoke records the token as revoked in a single statement and reports false when it was already revoked, so of two
+// concurrent revocations of the same token only one succeeds
+func (r *revokedTokenRepo) Revoke(ctx context.Context, revokedToken auth.RevokedToken) (revoked bool, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".Revoke")
+	defer span.End()
+
+	res := r.writeConn.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "token_id"}}, DoNothing: true}).
+		Create(&revokedToken)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected > 0, nil
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/auth"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/employee"
+	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	"github.com/BagusAK95/amarta_test/internal/infrastructure/token"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var tracerName = "AuthUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type authUsecase struct {
+	employeeRepo     employee.IEmployeeRepository
+	branchRepo       branch.IBranchRepository
+	investorRepo     investor.IInvestorRepository
+	revokedTokenRepo auth.IRevokedTokenRepository
+	tokenManager     token.IManager
+}
+
+func NewAuthUsecase(employeeRepo employee.IEmployeeRepository, branchRepo branch.IBranchRepository, investorRepo investor.IInvestorRepository, revokedTokenRepo auth.IRevokedTokenRepository, tokenManager token.IManager) auth.IAuthUsecase {
+	return &authUsecase{
+		employeeRepo:     employeeRepo,
+		branchRepo:       branchRepo,
+		investorRepo:     investorRepo,
+		revokedTokenRepo: revokedTokenRepo,
+		tokenManager:     tokenManager,
+	}
+}
+
+func (u *authUsecase) LoginEmployee(ctx context.Context, req auth.LoginRequest) (*auth.TokenResponse, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".LoginEmployee")
+	defer span.End()
+
+	validEmployee, err := u.employeeRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	} else if validEmployee.ID == uuid.Nil || !checkPassword(validEmployee.PasswordHash, req.Password) {
+		return nil, httpError.NewUnauthorizedError("invalid email or password")
+	}
+
+	return u.issueTokens(validEmployee.ID, auth.RoleEmployee)
+}
+
+func (u *authUsecase) LoginInvestor(ctx context.Context, req auth.LoginRequest) (*auth.TokenResponse, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".LoginInvestor")
+	defer span.End()
+
+	validInvestor, err := u.investorRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	} else if validInvestor.ID == uuid.Nil || !checkPassword(validInvestor.PasswordHash, req.Password) {
+		return nil, httpError.NewUnauthorizedError("invalid email or password")
+	}
+
+	return u.issueTokens(validInvestor.ID, auth.RoleInvestor)
+}
+
+// Refresh rotates a refresh token: the presented token is revoked and a new pair is issued. The token is revoked in
+// one statement, so when it is presented twice at once only one of the requests gets a new pair.
+func (u *authUsecase) Refresh(ctx context.Context, req auth.RefreshRequest) (*auth.TokenResponse, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".Refresh")
+	defer span.End()
+
+	claims, err := u.tokenManager.Parse(req.RefreshToken)
+	if err != nil || claims.Type != token.TypeRefresh {
+		return nil, httpError.NewUnauthorizedError("invalid refresh token")
+	}
+
+	err = u.checkSubject(ctx, claims.Subject, claims.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := u.revokedTokenRepo.Revoke(ctx, auth.RevokedToken{
+		TokenID:   claims.TokenID,
+		ExpiresAt: claims.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	} else if !revoked {
+		return nil, httpError.NewUnauthorizedError("refresh token has been revoked")
+	}
+
+	return u.issueTokens(claims.Subject, claims.Role)
+}
+
+// checkSubject verifies the employee or investor a token was issued to still exists
+func (u *authUsecase) checkSubject(ctx context.Context, subject uuid.UUID, role string) error {
+	switch role {
+	case auth.RoleEmployee:
+		validEmployee, err := u.employeeRepo.GetByID(ctx, subject)
+		if err != nil {
+			return err
+		} else if validEmployee.ID == uuid.Nil {
+			return httpError.NewUnauthorizedError("employee not found")
+		}
+	case auth.RoleInvestor:
+		validInvestor, err := u.investorRepo.GetByID(ctx, subject)
+		if err != nil {
+			return err
+		} else if validInvestor.ID == uuid.Nil {
+			return httpError.NewUnauthorizedError("investor not found")
+		}
+	default:
+		return httpError.NewUnauthorizedError("invalid refresh token")
+	}
+
+	return nil
+}
+
+func (u *authUsecase) Logout(ctx context.Context, principal auth.Principal, req auth.LogoutRequest) error {
+	ctx, span := tracer.Start(ctx, tracerName+".Logout")
+	defer span.End()
+
+	revokedTokens := []auth.RevokedToken{{
+		TokenID:   principal.TokenID,
+		ExpiresAt: principal.ExpiresAt,
+	}}
+
+	if req.RefreshToken != "" {
+		claims, err := u.tokenManager.Parse(req.RefreshToken)
+		if err != nil || claims.Type != token.TypeRefresh || claims.Subject != principal.ID {
+			return httpError.NewBadRequestError("invalid refresh token")
+		}
+
+		revokedTokens = append(revokedTokens, auth.RevokedToken{
+			TokenID:   claims.TokenID,
+			ExpiresAt: claims.ExpiresAt,
+		})
+	}
+
+	for _, revokedToken := range revokedTokens {
+		_, err := u.revokedTokenRepo.Create(ctx, revokedToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *authUsecase) Authenticate(ctx context.Context, accessToken string) (auth.Principal, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".Authenticate")
+	defer span.End()
+
+	claims, err := u.tokenManager.Parse(accessToken)
+	if err != nil || claims.Type != token.TypeAccess {
+		return auth.Principal{}, httpError.NewUnauthorizedError("invalid access token")
+	}
+
+	revoked, err := u.revokedTokenRepo.IsRevoked(ctx, claims.TokenID)
+	if err != nil {
+		return auth.Principal{}, err
+	} else if revoked {
+		return auth.Principal{}, httpError.NewUnauthorizedError("access token has been revoked")
+	}
+
+	principal := auth.Principal{
+		ID:        claims.Subject,
+		Role:      claims.Role,
+		TokenID:   claims.TokenID,
+		ExpiresAt: claims.ExpiresAt,
+	}
+
+	// Permissions and branches are loaded on every request so changes apply without waiting for the token to expire
+	if principal.Role == auth.RoleEmployee {
+		validEmployee, err := u.employeeRepo.GetByIDWithRoles(ctx, principal.ID)
+		if err != nil {
+			return auth.Principal{}, err
+		} else if validEmployee.ID == uuid.Nil {
+			return auth.Principal{}, httpError.NewUnauthorizedError("employee not found")
+		}
+
+		principal.Permissions = validEmployee.Permissions()
+
+		branches, err := u.branchRepo.GetByEmployeeID(ctx, principal.ID)
+		if err != nil {
+			return auth.Principal{}, err
+		}
+
+		for _, b := range branches {
+			principal.BranchIDs = append(principal.BranchIDs, b.ID)
+		}
+	}
+
+	return principal, nil
+}
+
+func (u *authUsecase) issueTokens(subject uuid.UUID, role string) (*auth.TokenResponse, error) {
+	accessToken, _, err := u.tokenManager.Issue(subject, role, token.TypeAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, _, err := u.tokenManager.Issue(subject, role, token.TypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(u.tokenManager.TTL(token.TypeAccess).Seconds()),
+	}, nil
+}
+
+func checkPassword(hash string, password string) bool {
+	return hash != "" && bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
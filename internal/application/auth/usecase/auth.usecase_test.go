@@ -0,0 +1,366 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/application/auth/usecase"
+	"github.com/BagusAK95/amarta_test/internal/domain/auth"
+	authMock "github.com/BagusAK95/amarta_test/internal/domain/auth/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	branchMock "github.com/BagusAK95/amarta_test/internal/domain/branch/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/employee"
+	employeeMock "github.com/BagusAK95/amarta_test/internal/domain/employee/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	investorMock "github.com/BagusAK95/amarta_test/internal/domain/investor/mock"
+	"github.com/BagusAK95/amarta_test/internal/infrastructure/token"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTokenManager(t *testing.T) *token.Manager {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	return token.NewRSAManager(key, 15*time.Minute, 24*time.Hour)
+}
+
+func hashPassword(t *testing.T, password string) string {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	return string(hash)
+}
+
+func TestLogin(t *testing.T) {
+	ctx := context.Background()
+	tokenManager := newTokenManager(t)
+	employeeData := employee.Employee{
+		BaseModel:    model.BaseModel{ID: uuid.New()},
+		Email:        "alice@example.com",
+		PasswordHash: hashPassword(t, "secret"),
+	}
+	investorData := investor.Investor{
+		BaseModel:    model.BaseModel{ID: uuid.New()},
+		Email:        "bob@example.com",
+		PasswordHash: hashPassword(t, "secret"),
+	}
+
+	t.Run("employee success", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		employeeRepo.On("GetByEmail", mock.Anything, employeeData.Email).Return(employeeData, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		res, err := uc.LoginEmployee(ctx, auth.LoginRequest{Email: employeeData.Email, Password: "secret"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer", res.TokenType)
+		assert.Equal(t, 900, res.ExpiresIn)
+
+		claims, err := tokenManager.Parse(res.AccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, employeeData.ID, claims.Subject)
+		assert.Equal(t, auth.RoleEmployee, claims.Role)
+		assert.Equal(t, token.TypeAccess, claims.Type)
+
+		claims, err = tokenManager.Parse(res.RefreshToken)
+		assert.NoError(t, err)
+		assert.Equal(t, token.TypeRefresh, claims.Type)
+		employeeRepo.AssertExpectations(t)
+	})
+
+	t.Run("investor success", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		investorRepo.On("GetByEmail", mock.Anything, investorData.Email).Return(investorData, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		res, err := uc.LoginInvestor(ctx, auth.LoginRequest{Email: investorData.Email, Password: "secret"})
+
+		assert.NoError(t, err)
+
+		claims, err := tokenManager.Parse(res.AccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, investorData.ID, claims.Subject)
+		assert.Equal(t, auth.RoleInvestor, claims.Role)
+		investorRepo.AssertExpectations(t)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		employeeRepo.On("GetByEmail", mock.Anything, employeeData.Email).Return(employeeData, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		res, err := uc.LoginEmployee(ctx, auth.LoginRequest{Email: employeeData.Email, Password: "wrong"})
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewUnauthorizedError("invalid email or password"), err)
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		investorRepo.On("GetByEmail", mock.Anything, "nobody@example.com").Return(investor.Investor{}, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		res, err := uc.LoginInvestor(ctx, auth.LoginRequest{Email: "nobody@example.com", Password: "secret"})
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewUnauthorizedError("invalid email or password"), err)
+	})
+}
+
+func TestRefresh(t *testing.T) {
+	ctx := context.Background()
+	tokenManager := newTokenManager(t)
+	investorData := investor.Investor{BaseModel: model.BaseModel{ID: uuid.New()}}
+	investorID := investorData.ID
+
+	t.Run("success rotates refresh token", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		refreshToken, claims, _ := tokenManager.Issue(investorID, auth.RoleInvestor, token.TypeRefresh)
+		investorRepo.On("GetByID", mock.Anything, investorID).Return(investorData, nil)
+		revokedTokenRepo.On("Revoke", mock.Anything, auth.RevokedToken{TokenID: claims.TokenID, ExpiresAt: claims.ExpiresAt}).Return(true, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		res, err := uc.Refresh(ctx, auth.RefreshRequest{RefreshToken: refreshToken})
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, refreshToken, res.RefreshToken)
+
+		newClaims, err := tokenManager.Parse(res.AccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, investorID, newClaims.Subject)
+		assert.Equal(t, auth.RoleInvestor, newClaims.Role)
+		investorRepo.AssertExpectations(t)
+		revokedTokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("revoked refresh token", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		// also what a concurrent refresh with the same token sees once the other one has revoked it
+		refreshToken, claims, _ := tokenManager.Issue(investorID, auth.RoleInvestor, token.TypeRefresh)
+		investorRepo.On("GetByID", mock.Anything, investorID).Return(investorData, nil)
+		revokedTokenRepo.On("Revoke", mock.Anything, auth.RevokedToken{TokenID: claims.TokenID, ExpiresAt: claims.ExpiresAt}).Return(false, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		res, err := uc.Refresh(ctx, auth.RefreshRequest{RefreshToken: refreshToken})
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewUnauthorizedError("refresh token has been revoked"), err)
+		revokedTokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("employee no longer exists", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		employeeID := uuid.New()
+		refreshToken, _, _ := tokenManager.Issue(employeeID, auth.RoleEmployee, token.TypeRefresh)
+		employeeRepo.On("GetByID", mock.Anything, employeeID).Return(employee.Employee{}, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		res, err := uc.Refresh(ctx, auth.RefreshRequest{RefreshToken: refreshToken})
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewUnauthorizedError("employee not found"), err)
+		employeeRepo.AssertExpectations(t)
+		revokedTokenRepo.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything)
+	})
+
+	t.Run("access token used as refresh token", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		accessToken, _, _ := tokenManager.Issue(investorID, auth.RoleInvestor, token.TypeAccess)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		res, err := uc.Refresh(ctx, auth.RefreshRequest{RefreshToken: accessToken})
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewUnauthorizedError("invalid refresh token"), err)
+	})
+}
+
+func TestLogout(t *testing.T) {
+	ctx := context.Background()
+	tokenManager := newTokenManager(t)
+	employeeID := uuid.New()
+	principal := auth.Principal{
+		ID:        employeeID,
+		Role:      auth.RoleEmployee,
+		TokenID:   uuid.New(),
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+
+	t.Run("revokes access and refresh token", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		refreshToken, claims, _ := tokenManager.Issue(employeeID, auth.RoleEmployee, token.TypeRefresh)
+		revokedTokenRepo.On("Create", mock.Anything, auth.RevokedToken{TokenID: principal.TokenID, ExpiresAt: principal.ExpiresAt}).Return(auth.RevokedToken{}, nil)
+		revokedTokenRepo.On("Create", mock.Anything, auth.RevokedToken{TokenID: claims.TokenID, ExpiresAt: claims.ExpiresAt}).Return(auth.RevokedToken{}, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		err := uc.Logout(ctx, principal, auth.LogoutRequest{RefreshToken: refreshToken})
+
+		assert.NoError(t, err)
+		revokedTokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("refresh token of another user", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		refreshToken, _, _ := tokenManager.Issue(uuid.New(), auth.RoleEmployee, token.TypeRefresh)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		err := uc.Logout(ctx, principal, auth.LogoutRequest{RefreshToken: refreshToken})
+
+		assert.Equal(t, httpError.NewBadRequestError("invalid refresh token"), err)
+		revokedTokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	tokenManager := newTokenManager(t)
+	employeeID := uuid.New()
+	branchID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		accessToken, claims, _ := tokenManager.Issue(employeeID, auth.RoleEmployee, token.TypeAccess)
+		revokedTokenRepo.On("IsRevoked", mock.Anything, claims.TokenID).Return(false, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, employeeID).Return(employee.Employee{
+			BaseModel: model.BaseModel{ID: employeeID},
+			Roles: []employee.Role{
+				{Name: "field_agent", Permissions: []string{string(employee.PermissionLoanCreate), string(employee.PermissionLoanRead)}},
+				{Name: "approver", Permissions: []string{string(employee.PermissionLoanRead), string(employee.PermissionLoanApprove)}},
+			},
+		}, nil)
+		branchRepo.On("GetByEmployeeID", mock.Anything, employeeID).Return([]branch.Branch{{BaseModel: model.BaseModel{ID: branchID}}}, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		principal, err := uc.Authenticate(ctx, accessToken)
+
+		assert.NoError(t, err)
+		assert.Equal(t, auth.Principal{
+			ID:          employeeID,
+			Role:        auth.RoleEmployee,
+			TokenID:     claims.TokenID,
+			ExpiresAt:   principal.ExpiresAt,
+			Permissions: []employee.Permission{employee.PermissionLoanCreate, employee.PermissionLoanRead, employee.PermissionLoanApprove},
+			BranchIDs:   []uuid.UUID{branchID},
+		}, principal)
+		assert.Equal(t, branch.Scope{BranchIDs: []uuid.UUID{branchID}}, principal.BranchScope())
+		assert.True(t, claims.ExpiresAt.Equal(principal.ExpiresAt))
+		employeeRepo.AssertExpectations(t)
+	})
+
+	t.Run("investor has no permissions", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		investorID := uuid.New()
+		accessToken, claims, _ := tokenManager.Issue(investorID, auth.RoleInvestor, token.TypeAccess)
+		revokedTokenRepo.On("IsRevoked", mock.Anything, claims.TokenID).Return(false, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		principal, err := uc.Authenticate(ctx, accessToken)
+
+		assert.NoError(t, err)
+		assert.Equal(t, investorID, principal.ID)
+		assert.Empty(t, principal.Permissions)
+		employeeRepo.AssertNotCalled(t, "GetByIDWithRoles", mock.Anything, mock.Anything)
+	})
+
+	t.Run("employee not found", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		accessToken, claims, _ := tokenManager.Issue(employeeID, auth.RoleEmployee, token.TypeAccess)
+		revokedTokenRepo.On("IsRevoked", mock.Anything, claims.TokenID).Return(false, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, employeeID).Return(employee.Employee{}, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		_, err := uc.Authenticate(ctx, accessToken)
+
+		assert.Equal(t, httpError.NewUnauthorizedError("employee not found"), err)
+	})
+
+	t.Run("revoked access token", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		accessToken, claims, _ := tokenManager.Issue(employeeID, auth.RoleEmployee, token.TypeAccess)
+		revokedTokenRepo.On("IsRevoked", mock.Anything, claims.TokenID).Return(true, nil)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		_, err := uc.Authenticate(ctx, accessToken)
+
+		assert.Equal(t, httpError.NewUnauthorizedError("access token has been revoked"), err)
+	})
+
+	t.Run("token signed with another key", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		revokedTokenRepo := new(authMock.MockIRevokedTokenRepository)
+
+		accessToken, _, _ := newTokenManager(t).Issue(employeeID, auth.RoleEmployee, token.TypeAccess)
+
+		uc := usecase.NewAuthUsecase(employeeRepo, branchRepo, investorRepo, revokedTokenRepo, tokenManager)
+		_, err := uc.Authenticate(ctx, accessToken)
+
+		assert.Equal(t, httpError.NewUnauthorizedError("invalid access token"), err)
+		revokedTokenRepo.AssertNotCalled(t, "IsRevoked", mock.Anything, mock.Anything)
+	})
+}
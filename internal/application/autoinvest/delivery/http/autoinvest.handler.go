@@ -0,0 +1,105 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/autoinvest"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/BagusAK95/amarta_test/internal/utils/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type autoInvestHandler struct {
+	usecase   autoinvest.IAutoInvestUsecase
+	validator *validator.CustomValidator
+}
+
+func NewAutoInvestHandler(usecase autoinvest.IAutoInvestUsecase) *autoInvestHandler {
+	return &autoInvestHandler{
+		usecase:   usecase,
+		validator: validator.NewValidator(),
+	}
+}
+
+func (h *autoInvestHandler) CreateRule(c *gin.Context) {
+	var body autoinvest.SaveRuleRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.CreateRule(c.Request.Context(), investorID.(uuid.UUID), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *autoInvestHandler) ListRule(c *gin.Context) {
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.ListRule(c.Request.Context(), investorID.(uuid.UUID))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *autoInvestHandler) UpdateRule(c *gin.Context) {
+	ruleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body autoinvest.SaveRuleRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.UpdateRule(c.Request.Context(), investorID.(uuid.UUID), ruleID, body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *autoInvestHandler) DeleteRule(c *gin.Context) {
+	ruleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	err = h.usecase.DeleteRule(c.Request.Context(), investorID.(uuid.UUID), ruleID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,33 @@
+package messaging
+
+import (
+	"context"
+	"log"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/autoinvest"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+)
+
+type autoInvestHandler struct {
+	usecase autoinvest.IAutoInvestUsecase
+}
+
+func NewAutoInvestHandler(usecase autoinvest.IAutoInvestUsecase) *autoInvestHandler {
+	return &autoInvestHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *autoInvestHandler) InvestLoan(msg loan.LoanApprovedEvent) {
+	ctx := branch.WithScope(context.Background(), branch.AllBranches())
+
+	invested, err := h.usecase.InvestLoan(ctx, msg.LoanID)
+	if err != nil {
+		log.Printf("❌ Failed to auto-invest loan %s: %v", msg.LoanID, err)
+	}
+
+	if invested > 0 {
+		log.Printf("🤖 Auto-invested %d times in loan %s", invested, msg.LoanID)
+	}
+}
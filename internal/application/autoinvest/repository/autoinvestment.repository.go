@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/autoinvest"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var autoInvestmentTracerName = "AutoInvestmentRepository"
+var autoInvestmentTracer = otel.Tracer(autoInvestmentTracerName)
+
+type autoInvestmentRepo struct {
+	repository.BaseRepo[autoinvest.AutoInvestment]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewAutoInvestmentRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) autoinvest.IAutoInvestmentRepository {
+	baseRepo := repository.NewBaseRepo[autoinvest.AutoInvestment](dbMaster, dbSlave)
+
+	return &autoInvestmentRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *autoInvestmentRepo) GetTotalByRuleIDSince(ctx context.Context, ruleID uuid.UUID, since time.Time) (total money.Money, err error) {
+	ctx, span := autoInvestmentTracer.Start(ctx, autoInvestmentTracerName+".GetTotalByRuleIDSince")
+	defer span.End()
+
+	var model autoinvest.AutoInvestment
+
+	builder := sq.
+		Select("COALESCE(SUM(amount), 0)").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"rule_id":    ruleID,
+			"deleted_at": nil,
+		}).
+		Where(sq.GtOrEq{
+			"created_at": since,
+		})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&total).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/autoinvest"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var ruleTracerName = "RuleRepository"
+var ruleTracer = otel.Tracer(ruleTracerName)
+
+type ruleRepo struct {
+	repository.BaseRepo[autoinvest.Rule]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewRuleRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) autoinvest.IRuleRepository {
+	baseRepo := repository.NewBaseRepo[autoinvest.Rule](dbMaster, dbSlave)
+
+	return &ruleRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *ruleRepo) GetActive(ctx context.Context) (rules []autoinvest.Rule, err error) {
+	ctx, span := ruleTracer.Start(ctx, ruleTracerName+".GetActive")
+	defer span.End()
+
+	var model autoinvest.Rule
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"active":     true,
+			"deleted_at": nil,
+		}).
+		OrderBy("id ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&rules).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (r *ruleRepo) GetByInvestorID(ctx context.Context, investorID uuid.UUID) (rules []autoinvest.Rule, err error) {
+	ctx, span := ruleTracer.Start(ctx, ruleTracerName+".GetByInvestorID")
+	defer span.End()
+
+	var model autoinvest.Rule
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"investor_id": investorID,
+			"deleted_at":  nil,
+		}).
+		OrderBy("id ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&rules).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete soft deletes the rule, so the auto-investments it made keep pointing at it
+func (r *ruleRepo) Delete(ctx context.Context, ID uuid.UUID) error {
+	ctx, span := ruleTracer.Start(ctx, ruleTracerName+".Delete")
+	defer span.End()
+
+	var model autoinvest.Rule
+
+	err := r.writeConn.WithContext(ctx).Model(&model).Where("id = ? AND deleted_at IS NULL", ID).Update("deleted_at", time.Now()).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
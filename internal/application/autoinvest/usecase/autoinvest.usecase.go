@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/autoinvest"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/investment"
+	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+)
+
+var tracerName = "AutoInvestUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type autoInvestUsecase struct {
+	ruleRepo           autoinvest.IRuleRepository
+	autoInvestmentRepo autoinvest.IAutoInvestmentRepository
+	loanRepo           loan.ILoanRepository
+	borrowerRepo       borrower.IBorrowerRepository
+	investorRepo       investor.IInvestorRepository
+	investmentRepo     investment.IInvestmentRepository
+	investmentUsecase  investment.IInvestmentUsecase
+}
+
+func NewAutoInvestUsecase(ruleRepo autoinvest.IRuleRepository, autoInvestmentRepo autoinvest.IAutoInvestmentRepository, loanRepo loan.ILoanRepository, borrowerRepo borrower.IBorrowerRepository, investorRepo investor.IInvestorRepository, investmentRepo investment.IInvestmentRepository, investmentUsecase investment.IInvestmentUsecase) autoinvest.IAutoInvestUsecase {
+	return &autoInvestUsecase{
+		ruleRepo:           ruleRepo,
+		autoInvestmentRepo: autoInvestmentRepo,
+		loanRepo:           loanRepo,
+		borrowerRepo:       borrowerRepo,
+		investorRepo:       investorRepo,
+		investmentRepo:     investmentRepo,
+		investmentUsecase:  investmentUsecase,
+	}
+}
+
+func (u *autoInvestUsecase) CreateRule(ctx context.Context, investorID uuid.UUID, req autoinvest.SaveRuleRequest) (*autoinvest.Rule, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".CreateRule")
+	defer span.End()
+
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	newRule, err := u.ruleRepo.Create(ctx, autoinvest.Rule{
+		InvestorID:       investorID,
+		MaxAmountPerLoan: req.MaxAmountPerLoan,
+		MinROI:           req.MinROI,
+		MaxTenor:         req.MaxTenor,
+		DailyBudget:      req.DailyBudget,
+		BorrowerSegments: req.BorrowerSegments,
+		Active:           active,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &newRule, nil
+}
+
+func (u *autoInvestUsecase) ListRule(ctx context.Context, investorID uuid.UUID) ([]autoinvest.Rule, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ListRule")
+	defer span.End()
+
+	rules, err := u.ruleRepo.GetByInvestorID(ctx, investorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (u *autoInvestUsecase) UpdateRule(ctx context.Context, investorID uuid.UUID, ruleID uuid.UUID, req autoinvest.SaveRuleRequest) (*autoinvest.Rule, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".UpdateRule")
+	defer span.End()
+
+	validRule, err := u.ruleRepo.GetByID(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	} else if validRule.ID == uuid.Nil || validRule.InvestorID != investorID {
+		return nil, httpError.NewNotFoundError("rule not found")
+	}
+
+	active := validRule.Active
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	updatedRule, err := u.ruleRepo.UpdateWithMap(ctx, ruleID, map[string]any{
+		"max_amount_per_loan": req.MaxAmountPerLoan,
+		"min_roi":             req.MinROI,
+		"max_tenor":           req.MaxTenor,
+		"daily_budget":        req.DailyBudget,
+		"borrower_segments":   pq.StringArray(req.BorrowerSegments),
+		"active":              active,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedRule, nil
+}
+
+func (u *autoInvestUsecase) DeleteRule(ctx context.Context, investorID uuid.UUID, ruleID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, tracerName+".DeleteRule")
+	defer span.End()
+
+	validRule, err := u.ruleRepo.GetByID(ctx, ruleID)
+	if err != nil {
+		return err
+	} else if validRule.ID == uuid.Nil || validRule.InvestorID != investorID {
+		return httpError.NewNotFoundError("rule not found")
+	}
+
+	return u.ruleRepo.Delete(ctx, ruleID)
+}
+
+type candidate struct {
+	rule  autoinvest.Rule
+	limit money.Money
+}
+
+// InvestLoan funds a newly approved loan from the active rules that match it. Each investor takes part through their
+// oldest matching rule, limited by the rule's amount per loan, what is left of its daily budget and their balance. The
+// remaining principal is then shared as evenly as those limits allow and invested through AddInvestment, which
+// re-checks the balance and the principal cap under lock.
+func (u *autoInvestUsecase) InvestLoan(ctx context.Context, loanID uuid.UUID) (invested int, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".InvestLoan")
+	defer span.End()
+
+	validLoan, err := u.loanRepo.GetByID(ctx, loanID)
+	if err != nil {
+		return 0, err
+	} else if validLoan.ID == uuid.Nil {
+		return 0, httpError.NewNotFoundError("loan not found")
+	} else if validLoan.State != loan.StateApproved {
+		return 0, nil
+	}
+
+	validBorrower, err := u.borrowerRepo.GetByID(ctx, validLoan.BorrowerID)
+	if err != nil {
+		return 0, err
+	}
+
+	totalInvestment, err := u.investmentRepo.GetTotalInvestmentByLoanID(ctx, loanID)
+	if err != nil {
+		return 0, err
+	}
+
+	remaining := validLoan.PrincipalAmount - totalInvestment
+	if remaining <= 0 {
+		return 0, nil
+	}
+
+	candidates, err := u.findCandidates(ctx, validLoan, validBorrower)
+	if err != nil {
+		return 0, err
+	}
+
+	limits := make([]money.Money, len(candidates))
+	for i, c := range candidates {
+		limits[i] = c.limit
+	}
+
+	var errs []error
+	for i, amount := range allocate(remaining, limits) {
+		if amount <= 0 {
+			continue
+		}
+
+		rule := candidates[i].rule
+		newInvestment, investErr := u.investmentUsecase.AddInvestment(ctx, rule.InvestorID, investment.CreateInvestmentRequest{
+			LoanID: loanID,
+			Amount: amount,
+		})
+		if investErr != nil {
+			errs = append(errs, fmt.Errorf("auto-invest rule %s: %w", rule.ID, investErr))
+			continue
+		}
+
+		invested++
+
+		_, recordErr := u.autoInvestmentRepo.Create(ctx, autoinvest.AutoInvestment{
+			RuleID:       rule.ID,
+			InvestorID:   rule.InvestorID,
+			LoanID:       loanID,
+			InvestmentID: newInvestment.ID,
+			Amount:       amount,
+		})
+		if recordErr != nil {
+			errs = append(errs, fmt.Errorf("record auto-investment of rule %s: %w", rule.ID, recordErr))
+		}
+	}
+
+	return invested, errors.Join(errs...)
+}
+
+func (u *autoInvestUsecase) findCandidates(ctx context.Context, validLoan loan.Loan, validBorrower borrower.Borrower) ([]candidate, error) {
+	rules, err := u.ruleRepo.GetActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var candidates []candidate
+	seen := make(map[uuid.UUID]bool)
+	for _, rule := range rules {
+		if seen[rule.InvestorID] || !rule.Matches(validLoan, validBorrower) {
+			continue
+		}
+
+		seen[rule.InvestorID] = true
+
+		spent, err := u.autoInvestmentRepo.GetTotalByRuleIDSince(ctx, rule.ID, startOfDay)
+		if err != nil {
+			return nil, err
+		}
+
+		validInvestor, err := u.investorRepo.GetByID(ctx, rule.InvestorID)
+		if err != nil {
+			return nil, err
+		}
+
+		limit := money.Min(money.Min(rule.MaxAmountPerLoan, rule.DailyBudget-spent), validInvestor.Balance)
+
+		// Stay within the per-loan concentration limits up front so a large rule is capped instead of rejected.
+		// The borrower share depends on the whole portfolio and is left to AddInvestment.
+		if maxAmount := config.INVESTMENT_MAX_AMOUNT_PER_LOAN; maxAmount > 0 {
+			limit = money.Min(limit, maxAmount)
+		}
+		if share := config.INVESTMENT_MAX_LOAN_SHARE; share > 0 {
+			limit = money.Min(limit, money.Floor(float64(validLoan.PrincipalAmount)*share/100))
+		}
+		if limit <= 0 {
+			continue
+		}
+
+		candidates = append(candidates, candidate{rule: rule, limit: limit})
+	}
+
+	return candidates, nil
+}
+
+// allocate shares amount across participants so that nobody receives more than their limit and the rest is split
+// evenly. Rupiahs that cannot be split evenly go to the earliest participants.
+func allocate(amount money.Money, limits []money.Money) []money.Money {
+	allocations := make([]money.Money, len(limits))
+
+	var open []int
+	for i, limit := range limits {
+		if limit > 0 {
+			open = append(open, i)
+		}
+	}
+
+	for amount > 0 && len(open) > 0 {
+		share := amount / money.Money(len(open))
+		if share == 0 {
+			for _, i := range open[:amount] {
+				allocations[i]++
+			}
+
+			break
+		}
+
+		var next []int
+		for _, i := range open {
+			given := money.Min(share, limits[i]-allocations[i])
+			allocations[i] += given
+			amount -= given
+
+			if allocations[i] < limits[i] {
+				next = append(next, i)
+			}
+		}
+
+		open = next
+	}
+
+	return allocations
+}
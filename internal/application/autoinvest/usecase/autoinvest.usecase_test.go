@@ -0,0 +1,195 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BagusAK95/amarta_test/internal/application/autoinvest/usecase"
+	"github.com/BagusAK95/amarta_test/internal/domain/autoinvest"
+	autoinvestMock "github.com/BagusAK95/amarta_test/internal/domain/autoinvest/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	borrowerMock "github.com/BagusAK95/amarta_test/internal/domain/borrower/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/investment"
+	investmentMock "github.com/BagusAK95/amarta_test/internal/domain/investment/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	investorMock "github.com/BagusAK95/amarta_test/internal/domain/investor/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	loanMock "github.com/BagusAK95/amarta_test/internal/domain/loan/mock"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInvestLoan(t *testing.T) {
+	ctx := context.Background()
+	loanID := uuid.New()
+	borrowerID := uuid.New()
+	investorA := uuid.New()
+	investorB := uuid.New()
+	investorC := uuid.New()
+	investorD := uuid.New()
+	loanData := loan.Loan{
+		BaseModel:       model.BaseModel{ID: loanID},
+		BorrowerID:      borrowerID,
+		PrincipalAmount: 1000,
+		ROI:             12,
+		Tenor:           10,
+		State:           loan.StateApproved,
+	}
+	borrowerData := borrower.Borrower{
+		BaseModel: model.BaseModel{ID: borrowerID},
+		Segment:   borrower.SegmentMicro,
+	}
+	// A is capped by the amount per loan, B by the daily budget, C by the balance. A's second rule, D's segment
+	// filter and the ROI floor of D's rule keep them out of the allocation.
+	ruleA := autoinvest.Rule{BaseModel: model.BaseModel{ID: uuid.New()}, InvestorID: investorA, MaxAmountPerLoan: 200, MaxTenor: 12, DailyBudget: 5000, Active: true}
+	ruleA2 := autoinvest.Rule{BaseModel: model.BaseModel{ID: uuid.New()}, InvestorID: investorA, MaxAmountPerLoan: 1000, MaxTenor: 12, DailyBudget: 5000, Active: true}
+	ruleB := autoinvest.Rule{BaseModel: model.BaseModel{ID: uuid.New()}, InvestorID: investorB, MaxAmountPerLoan: 1000, MaxTenor: 12, DailyBudget: 500, BorrowerSegments: []string{borrower.SegmentMicro}, Active: true}
+	ruleC := autoinvest.Rule{BaseModel: model.BaseModel{ID: uuid.New()}, InvestorID: investorC, MaxAmountPerLoan: 1000, MinROI: 10, MaxTenor: 10, DailyBudget: 5000, Active: true}
+	ruleD := autoinvest.Rule{BaseModel: model.BaseModel{ID: uuid.New()}, InvestorID: investorD, MaxAmountPerLoan: 1000, MaxTenor: 12, DailyBudget: 5000, BorrowerSegments: []string{borrower.SegmentSmall}, Active: true}
+	ruleD2 := autoinvest.Rule{BaseModel: model.BaseModel{ID: uuid.New()}, InvestorID: investorD, MaxAmountPerLoan: 1000, MinROI: 15, MaxTenor: 12, DailyBudget: 5000, Active: true}
+	rules := []autoinvest.Rule{ruleA, ruleA2, ruleB, ruleC, ruleD, ruleD2}
+
+	setup := func() (*autoinvestMock.MockIRuleRepository, *autoinvestMock.MockIAutoInvestmentRepository, *loanMock.MockILoanRepository, *borrowerMock.MockIBorrowerRepository, *investorMock.MockIInvestorRepository, *investmentMock.MockIInvestmentRepository, *investmentMock.MockIInvestmentUsecase) {
+		ruleRepo := new(autoinvestMock.MockIRuleRepository)
+		autoInvestmentRepo := new(autoinvestMock.MockIAutoInvestmentRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investmentUsecase := new(investmentMock.MockIInvestmentUsecase)
+
+		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
+		investmentRepo.On("GetTotalInvestmentByLoanID", mock.Anything, loanID).Return(money.Money(100), nil)
+		ruleRepo.On("GetActive", mock.Anything).Return(rules, nil)
+		autoInvestmentRepo.On("GetTotalByRuleIDSince", mock.Anything, ruleA.ID, mock.Anything).Return(money.Money(0), nil)
+		autoInvestmentRepo.On("GetTotalByRuleIDSince", mock.Anything, ruleB.ID, mock.Anything).Return(money.Money(100), nil)
+		autoInvestmentRepo.On("GetTotalByRuleIDSince", mock.Anything, ruleC.ID, mock.Anything).Return(money.Money(0), nil)
+		investorRepo.On("GetByID", mock.Anything, investorA).Return(investor.Investor{BaseModel: model.BaseModel{ID: investorA}, Balance: 5000}, nil)
+		investorRepo.On("GetByID", mock.Anything, investorB).Return(investor.Investor{BaseModel: model.BaseModel{ID: investorB}, Balance: 5000}, nil)
+		investorRepo.On("GetByID", mock.Anything, investorC).Return(investor.Investor{BaseModel: model.BaseModel{ID: investorC}, Balance: 1000}, nil)
+
+		return ruleRepo, autoInvestmentRepo, loanRepo, borrowerRepo, investorRepo, investmentRepo, investmentUsecase
+	}
+
+	expectInvestment := func(investmentUsecase *investmentMock.MockIInvestmentUsecase, autoInvestmentRepo *autoinvestMock.MockIAutoInvestmentRepository, rule autoinvest.Rule, amount money.Money) {
+		investmentID := uuid.New()
+		investmentUsecase.On("AddInvestment", mock.Anything, rule.InvestorID, investment.CreateInvestmentRequest{LoanID: loanID, Amount: amount}).
+			Return(&investment.Investment{BaseModel: model.BaseModel{ID: investmentID}}, nil)
+		autoInvestmentRepo.On("Create", mock.Anything, autoinvest.AutoInvestment{
+			RuleID:       rule.ID,
+			InvestorID:   rule.InvestorID,
+			LoanID:       loanID,
+			InvestmentID: investmentID,
+			Amount:       amount,
+		}).Return(autoinvest.AutoInvestment{}, nil)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ruleRepo, autoInvestmentRepo, loanRepo, borrowerRepo, investorRepo, investmentRepo, investmentUsecase := setup()
+
+		// 900 left: A is capped at 200 and the other 700 is split evenly between B and C
+		expectInvestment(investmentUsecase, autoInvestmentRepo, ruleA, 200)
+		expectInvestment(investmentUsecase, autoInvestmentRepo, ruleB, 350)
+		expectInvestment(investmentUsecase, autoInvestmentRepo, ruleC, 350)
+
+		uc := usecase.NewAutoInvestUsecase(ruleRepo, autoInvestmentRepo, loanRepo, borrowerRepo, investorRepo, investmentRepo, investmentUsecase)
+		invested, err := uc.InvestLoan(ctx, loanID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, invested)
+		investmentUsecase.AssertExpectations(t)
+		autoInvestmentRepo.AssertExpectations(t)
+		autoInvestmentRepo.AssertNotCalled(t, "GetTotalByRuleIDSince", mock.Anything, ruleA2.ID, mock.Anything)
+		investorRepo.AssertNotCalled(t, "GetByID", mock.Anything, investorD)
+	})
+
+	t.Run("failed investment does not stop the others", func(t *testing.T) {
+		ruleRepo, autoInvestmentRepo, loanRepo, borrowerRepo, investorRepo, investmentRepo, investmentUsecase := setup()
+
+		expectInvestment(investmentUsecase, autoInvestmentRepo, ruleA, 200)
+		investmentUsecase.On("AddInvestment", mock.Anything, investorB, mock.Anything).Return(nil, httpError.NewBadRequestError("insufficient balance"))
+		expectInvestment(investmentUsecase, autoInvestmentRepo, ruleC, 350)
+
+		uc := usecase.NewAutoInvestUsecase(ruleRepo, autoInvestmentRepo, loanRepo, borrowerRepo, investorRepo, investmentRepo, investmentUsecase)
+		invested, err := uc.InvestLoan(ctx, loanID)
+
+		assert.ErrorContains(t, err, "insufficient balance")
+		assert.Equal(t, 2, invested)
+		investmentUsecase.AssertExpectations(t)
+	})
+
+	t.Run("uneven split", func(t *testing.T) {
+		ruleRepo, autoInvestmentRepo, loanRepo, borrowerRepo, investorRepo, _, investmentUsecase := setup()
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+
+		investmentRepo.On("GetTotalInvestmentByLoanID", mock.Anything, loanID).Return(money.Money(990), nil)
+		expectInvestment(investmentUsecase, autoInvestmentRepo, ruleA, 4)
+		expectInvestment(investmentUsecase, autoInvestmentRepo, ruleB, 3)
+		expectInvestment(investmentUsecase, autoInvestmentRepo, ruleC, 3)
+
+		uc := usecase.NewAutoInvestUsecase(ruleRepo, autoInvestmentRepo, loanRepo, borrowerRepo, investorRepo, investmentRepo, investmentUsecase)
+		invested, err := uc.InvestLoan(ctx, loanID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, invested)
+		investmentUsecase.AssertExpectations(t)
+	})
+
+	t.Run("loan no longer approved", func(t *testing.T) {
+		ruleRepo := new(autoinvestMock.MockIRuleRepository)
+		autoInvestmentRepo := new(autoinvestMock.MockIAutoInvestmentRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investmentUsecase := new(investmentMock.MockIInvestmentUsecase)
+
+		investedLoan := loanData
+		investedLoan.State = loan.StateInvested
+		loanRepo.On("GetByID", mock.Anything, loanID).Return(investedLoan, nil)
+
+		uc := usecase.NewAutoInvestUsecase(ruleRepo, autoInvestmentRepo, loanRepo, borrowerRepo, investorRepo, investmentRepo, investmentUsecase)
+		invested, err := uc.InvestLoan(ctx, loanID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, invested)
+		ruleRepo.AssertNotCalled(t, "GetActive", mock.Anything)
+		investmentUsecase.AssertNotCalled(t, "AddInvestment", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUpdateRule(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	ruleID := uuid.New()
+	req := autoinvest.SaveRuleRequest{
+		MaxAmountPerLoan: 500,
+		MaxTenor:         12,
+		DailyBudget:      1000,
+	}
+
+	t.Run("rule of another investor", func(t *testing.T) {
+		ruleRepo := new(autoinvestMock.MockIRuleRepository)
+		autoInvestmentRepo := new(autoinvestMock.MockIAutoInvestmentRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investmentUsecase := new(investmentMock.MockIInvestmentUsecase)
+
+		ruleRepo.On("GetByID", mock.Anything, ruleID).Return(autoinvest.Rule{BaseModel: model.BaseModel{ID: ruleID}, InvestorID: uuid.New()}, nil)
+
+		uc := usecase.NewAutoInvestUsecase(ruleRepo, autoInvestmentRepo, loanRepo, borrowerRepo, investorRepo, investmentRepo, investmentUsecase)
+		res, err := uc.UpdateRule(ctx, investorID, ruleID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("rule not found"), err)
+		ruleRepo.AssertNotCalled(t, "UpdateWithMap", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
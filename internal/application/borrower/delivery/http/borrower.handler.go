@@ -0,0 +1,276 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/BagusAK95/amarta_test/internal/utils/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type borrowerHandler struct {
+	usecase   borrower.IBorrowerUsecase
+	validator *validator.CustomValidator
+}
+
+func NewBorrowerHandler(usecase borrower.IBorrowerUsecase) *borrowerHandler {
+	return &borrowerHandler{
+		usecase:   usecase,
+		validator: validator.NewValidator(),
+	}
+}
+
+func (h *borrowerHandler) RegisterBorrower(c *gin.Context) {
+	var body borrower.RegisterBorrowerRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	res, err := h.usecase.RegisterBorrower(c.Request.Context(), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *borrowerHandler) UpdateBorrower(c *gin.Context) {
+	borrowerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body borrower.UpdateBorrowerRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	res, err := h.usecase.UpdateBorrower(c.Request.Context(), borrowerID, body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *borrowerHandler) DeactivateBorrower(c *gin.Context) {
+	borrowerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	res, err := h.usecase.DeactivateBorrower(c.Request.Context(), borrowerID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *borrowerHandler) SearchBorrower(c *gin.Context) {
+	var query borrower.SearchBorrowerRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(query); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid query parameter", errs...))
+		return
+	}
+
+	var page int = 1
+	if pageStr := c.Query("page"); pageStr != "" {
+		page, _ = strconv.Atoi(pageStr)
+	}
+
+	var limit int = 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, _ = strconv.Atoi(limitStr)
+	}
+
+	res, err := h.usecase.SearchBorrower(c.Request.Context(), query, page, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *borrowerHandler) DetailBorrower(c *gin.Context) {
+	borrowerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	res, err := h.usecase.DetailBorrower(c.Request.Context(), borrowerID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *borrowerHandler) SubmitKYCDocument(c *gin.Context) {
+	borrowerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body borrower.SubmitKYCDocumentRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.SubmitKYCDocument(c.Request.Context(), borrowerID, employeeID.(uuid.UUID), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *borrowerHandler) VerifyKYCDocument(c *gin.Context) {
+	borrowerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	documentID, err := uuid.Parse(c.Param("document_id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.VerifyKYCDocument(c.Request.Context(), borrowerID, documentID, employeeID.(uuid.UUID))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *borrowerHandler) RejectKYCDocument(c *gin.Context) {
+	borrowerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	documentID, err := uuid.Parse(c.Param("document_id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body borrower.RejectKYCDocumentRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.RejectKYCDocument(c.Request.Context(), borrowerID, documentID, employeeID.(uuid.UUID), body.RejectReason)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *borrowerHandler) BlacklistBorrower(c *gin.Context) {
+	borrowerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body borrower.BlacklistBorrowerRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.BlacklistBorrower(c.Request.Context(), borrowerID, employeeID.(uuid.UUID), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *borrowerHandler) LiftBlacklist(c *gin.Context) {
+	borrowerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	entryID, err := uuid.Parse(c.Param("entry_id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.LiftBlacklist(c.Request.Context(), borrowerID, entryID, employeeID.(uuid.UUID))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
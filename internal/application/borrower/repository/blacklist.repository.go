@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var blacklistTracerName = "BlacklistRepository"
+var blacklistTracer = otel.Tracer(blacklistTracerName)
+
+type blacklistRepo struct {
+	repository.BaseRepo[borrower.BlacklistEntry]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewBlacklistRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) borrower.IBlacklistRepository {
+	baseRepo := repository.NewBaseRepo[borrower.BlacklistEntry](dbMaster, dbSlave)
+
+	return &blacklistRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+// GetActiveByBorrowerID returns the entries that are neither lifted nor expired at asOf
+func (r *blacklistRepo) GetActiveByBorrowerID(ctx context.Context, borrowerID uuid.UUID, asOf time.Time) (entries []borrower.BlacklistEntry, err error) {
+	ctx, span := blacklistTracer.Start(ctx, blacklistTracerName+".GetActiveByBorrowerID")
+	defer span.End()
+
+	var model borrower.BlacklistEntry
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"borrower_id": borrowerID,
+			"lifted_at":   nil,
+			"deleted_at":  nil,
+		}).
+		Where(sq.Or{
+			sq.Eq{"expires_at": nil},
+			sq.Gt{"expires_at": asOf},
+		}).
+		OrderBy("created_at ASC", "id ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&entries).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -1,11 +1,20 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
 	"gorm.io/gorm"
 )
 
+var tracerName = "BorrowerRepository"
+var tracer = otel.Tracer(tracerName)
+
 type borrowerRepo struct {
 	repository.BaseRepo[borrower.Borrower]
 	writeConn *gorm.DB
@@ -21,3 +30,197 @@ func NewBorrowerRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) borrower.IBorrowerRepo
 		readConn:  dbSlave,
 	}
 }
+
+// GetByID is limited to the branches of the request's scope, so a borrower of another branch is not found
+func (r *borrowerRepo) GetByID(ctx context.Context, ID uuid.UUID) (borrowerData borrower.Borrower, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByID")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(borrowerData.TableName()).
+		Where(sq.Eq{
+			"id":         ID,
+			"deleted_at": nil,
+		})
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&borrowerData).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetByIDLockTx is limited to the branches of the request's scope like GetByID
+func (r *borrowerRepo) GetByIDLockTx(ctx context.Context, ID uuid.UUID, trx *gorm.DB) (borrowerData borrower.Borrower, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByIDLockTx")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(borrowerData.TableName()).
+		Where(sq.Eq{
+			"id":         ID,
+			"deleted_at": nil,
+		}).
+		Suffix("FOR UPDATE")
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Raw(qry, args...).Scan(&borrowerData).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetByIDs is limited to the branches of the request's scope like GetByID
+func (r *borrowerRepo) GetByIDs(ctx context.Context, IDs []uuid.UUID) (borrowers []borrower.Borrower, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByIDs")
+	defer span.End()
+
+	var model borrower.Borrower
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"id":         IDs,
+			"deleted_at": nil,
+		})
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&borrowers).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// UpdateWithMap only updates a borrower of the request's branches, otherwise the returned borrower is empty
+func (r *borrowerRepo) UpdateWithMap(ctx context.Context, ID uuid.UUID, payload map[string]any) (borrowerData borrower.Borrower, err error) {
+	return r.UpdateWithMapTx(ctx, ID, payload, r.writeConn)
+}
+
+// UpdateWithMapTx only updates a borrower of the request's branches, otherwise the returned borrower is empty
+func (r *borrowerRepo) UpdateWithMapTx(ctx context.Context, ID uuid.UUID, payload map[string]any, trx *gorm.DB) (borrowerData borrower.Borrower, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".UpdateWithMapTx")
+	defer span.End()
+
+	scope, args, err := branch.ScopeCondition(ctx, "branch_id").ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Model(&borrowerData).Where("id = ?", ID).Where(scope, args...).Updates(payload).Scan(&borrowerData).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetByIdentifiers returns every borrower, of any branch, holding one of the identifiers, which are unique across branches
+func (r *borrowerRepo) GetByIdentifiers(ctx context.Context, idCardNumber string, phoneNumber string, email string) (borrowers []borrower.Borrower, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByIdentifiers")
+	defer span.End()
+
+	var model borrower.Borrower
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Or{
+			sq.Eq{"id_card_number": idCardNumber},
+			sq.Eq{"phone_number": phoneNumber},
+			sq.Eq{"email": email},
+		}).
+		Where(sq.Eq{"deleted_at": nil})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&borrowers).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Search lists the borrowers of the request's branches ordered by name
+func (r *borrowerRepo) Search(ctx context.Context, req borrower.SearchBorrowerRequest, page int, limit int) (res repository.Pagination[borrower.Borrower], err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".Search")
+	defer span.End()
+
+	var model borrower.Borrower
+	var borrowers []borrower.Borrower
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{"deleted_at": nil})
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	if req.Query != "" {
+		builder = builder.Where(sq.Or{
+			sq.ILike{"full_name": "%" + req.Query + "%"},
+			sq.Eq{"id_card_number": req.Query},
+			sq.Eq{"phone_number": req.Query},
+			sq.ILike{"email": req.Query},
+		})
+	}
+	if req.Status != "" {
+		builder = builder.Where(sq.Eq{"status": req.Status})
+	}
+	if req.KYCStatus != "" {
+		builder = builder.Where(sq.Eq{"kyc_status": req.KYCStatus})
+	}
+
+	builder = builder.
+		OrderBy("full_name ASC", "id ASC").
+		Limit(uint64(limit + 1)).
+		Offset(uint64((page - 1) * limit))
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&borrowers).Error
+	if err != nil {
+		return
+	}
+
+	if len(borrowers) > limit {
+		res.HasNext = true
+		borrowers = borrowers[:limit]
+	}
+
+	if page > 1 {
+		res.HasPrev = true
+	}
+
+	res.Data = borrowers
+	return
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var kycDocumentTracerName = "KYCDocumentRepository"
+var kycDocumentTracer = otel.Tracer(kycDocumentTracerName)
+
+type kycDocumentRepo struct {
+	repository.BaseRepo[borrower.KYCDocument]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewKYCDocumentRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) borrower.IKYCDocumentRepository {
+	baseRepo := repository.NewBaseRepo[borrower.KYCDocument](dbMaster, dbSlave)
+
+	return &kycDocumentRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *kycDocumentRepo) GetByBorrowerID(ctx context.Context, borrowerID uuid.UUID) ([]borrower.KYCDocument, error) {
+	ctx, span := kycDocumentTracer.Start(ctx, kycDocumentTracerName+".GetByBorrowerID")
+	defer span.End()
+
+	return r.getByBorrowerID(ctx, borrowerID, r.readConn)
+}
+
+// GetByBorrowerIDWithTx reads within trx, so documents reviewed earlier in the transaction are included
+func (r *kycDocumentRepo) GetByBorrowerIDWithTx(ctx context.Context, borrowerID uuid.UUID, trx *gorm.DB) ([]borrower.KYCDocument, error) {
+	ctx, span := kycDocumentTracer.Start(ctx, kycDocumentTracerName+".GetByBorrowerIDWithTx")
+	defer span.End()
+
+	return r.getByBorrowerID(ctx, borrowerID, trx)
+}
+
+func (r *kycDocumentRepo) getByBorrowerID(ctx context.Context, borrowerID uuid.UUID, conn *gorm.DB) (documents []borrower.KYCDocument, err error) {
+	var model borrower.KYCDocument
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"borrower_id": borrowerID,
+			"deleted_at":  nil,
+		}).
+		OrderBy("created_at ASC", "id ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = conn.WithContext(ctx).Raw(qry, args...).Scan(&documents).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
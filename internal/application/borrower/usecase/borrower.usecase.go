@@ -0,0 +1,387 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var tracerName = "BorrowerUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type borrowerUsecase struct {
+	borrowerRepo    borrower.IBorrowerRepository
+	kycDocumentRepo borrower.IKYCDocumentRepository
+	blacklistRepo   borrower.IBlacklistRepository
+	branchRepo      branch.IBranchRepository
+}
+
+func NewBorrowerUsecase(borrowerRepo borrower.IBorrowerRepository, kycDocumentRepo borrower.IKYCDocumentRepository, blacklistRepo borrower.IBlacklistRepository, branchRepo branch.IBranchRepository) borrower.IBorrowerUsecase {
+	return &borrowerUsecase{
+		borrowerRepo:    borrowerRepo,
+		kycDocumentRepo: kycDocumentRepo,
+		blacklistRepo:   blacklistRepo,
+		branchRepo:      branchRepo,
+	}
+}
+
+// RegisterBorrower creates an active borrower in one of the caller's branches, whose KYC is pending until their
+// documents are verified
+func (u *borrowerUsecase) RegisterBorrower(ctx context.Context, req borrower.RegisterBorrowerRequest) (*borrower.Borrower, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".RegisterBorrower")
+	defer span.End()
+
+	errs := []string{}
+	if err := borrower.ValidateNIK(req.IDCardNumber); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	phoneNumber, err := borrower.NormalizePhoneNumber(req.PhoneNumber)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if len(errs) > 0 {
+		return nil, httpError.NewBadRequestError("invalid request body", errs...)
+	}
+
+	if !branch.ScopeFromContext(ctx).Allows(req.BranchID) {
+		return nil, httpError.NewForbiddenError("borrowers can only be registered in the employee's branches")
+	}
+
+	validBranch, err := u.branchRepo.GetByID(ctx, req.BranchID)
+	if err != nil {
+		return nil, err
+	} else if validBranch.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("branch not found")
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	existing, err := u.borrowerRepo.GetByIdentifiers(ctx, req.IDCardNumber, phoneNumber, email)
+	if err != nil {
+		return nil, err
+	} else if conflicts := borrower.IdentifierConflicts(existing, uuid.Nil, req.IDCardNumber, phoneNumber, email); len(conflicts) > 0 {
+		return nil, httpError.NewBadRequestError("borrower is already registered", conflicts...)
+	}
+
+	newBorrower, err := u.borrowerRepo.Create(ctx, borrower.Borrower{
+		FullName:     req.FullName,
+		IDCardNumber: req.IDCardNumber,
+		Address:      req.Address,
+		PhoneNumber:  phoneNumber,
+		Email:        email,
+		Status:       borrower.StatusActive,
+		KYCStatus:    borrower.KYCStatusPending,
+		Segment:      req.Segment,
+		BranchID:     validBranch.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &newBorrower, nil
+}
+
+func (u *borrowerUsecase) UpdateBorrower(ctx context.Context, borrowerID uuid.UUID, req borrower.UpdateBorrowerRequest) (*borrower.Borrower, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".UpdateBorrower")
+	defer span.End()
+
+	validBorrower, err := u.getBorrower(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	phoneNumber, err := borrower.NormalizePhoneNumber(req.PhoneNumber)
+	if err != nil {
+		return nil, httpError.NewBadRequestError("invalid request body", err.Error())
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	existing, err := u.borrowerRepo.GetByIdentifiers(ctx, validBorrower.IDCardNumber, phoneNumber, email)
+	if err != nil {
+		return nil, err
+	} else if conflicts := borrower.IdentifierConflicts(existing, validBorrower.ID, validBorrower.IDCardNumber, phoneNumber, email); len(conflicts) > 0 {
+		return nil, httpError.NewBadRequestError("borrower is already registered", conflicts...)
+	}
+
+	updatedBorrower, err := u.borrowerRepo.UpdateWithMap(ctx, borrowerID, map[string]any{
+		"full_name":    req.FullName,
+		"address":      req.Address,
+		"phone_number": phoneNumber,
+		"email":        email,
+		"segment":      req.Segment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedBorrower, nil
+}
+
+// DeactivateBorrower stops the borrower from taking new loans. Running loans are not affected.
+func (u *borrowerUsecase) DeactivateBorrower(ctx context.Context, borrowerID uuid.UUID) (*borrower.Borrower, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".DeactivateBorrower")
+	defer span.End()
+
+	validBorrower, err := u.getBorrower(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	} else if validBorrower.Status == borrower.StatusInactive {
+		return nil, httpError.NewBadRequestError("borrower is already inactive")
+	}
+
+	updatedBorrower, err := u.borrowerRepo.UpdateWithMap(ctx, borrowerID, map[string]any{
+		"status": borrower.StatusInactive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedBorrower, nil
+}
+
+func (u *borrowerUsecase) SearchBorrower(ctx context.Context, req borrower.SearchBorrowerRequest, page int, limit int) (repository.Pagination[borrower.Borrower], error) {
+	ctx, span := tracer.Start(ctx, tracerName+".SearchBorrower")
+	defer span.End()
+
+	borrowers, err := u.borrowerRepo.Search(ctx, req, page, limit)
+	if err != nil {
+		return repository.Pagination[borrower.Borrower]{}, err
+	}
+
+	return borrowers, nil
+}
+
+func (u *borrowerUsecase) DetailBorrower(ctx context.Context, borrowerID uuid.UUID) (*borrower.Borrower, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".DetailBorrower")
+	defer span.End()
+
+	validBorrower, err := u.getBorrower(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	validBorrower.KYCDocuments, err = u.kycDocumentRepo.GetByBorrowerID(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	validBorrower.Blacklist, err = u.blacklistRepo.GetActiveByBorrowerID(ctx, borrowerID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &validBorrower, nil
+}
+
+// SubmitKYCDocument adds a document pending review. It supersedes earlier documents of the same type, so the borrower's
+// KYC goes back to pending until it is reviewed.
+func (u *borrowerUsecase) SubmitKYCDocument(ctx context.Context, borrowerID uuid.UUID, employeeID uuid.UUID, req borrower.SubmitKYCDocumentRequest) (res *borrower.KYCDocument, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".SubmitKYCDocument")
+	defer span.End()
+
+	_, err = u.getBorrower(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.kycDocumentRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.kycDocumentRepo.Rollback(trx)
+			return
+		}
+
+		u.kycDocumentRepo.Commit(trx)
+	}()
+
+	lockedBorrower, err := u.borrowerRepo.GetByIDLockTx(ctx, borrowerID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	newDocument, err := u.kycDocumentRepo.CreateWithTx(ctx, borrower.KYCDocument{
+		BorrowerID:  borrowerID,
+		Type:        req.Type,
+		FileURL:     req.FileURL,
+		Status:      borrower.KYCStatusPending,
+		SubmittedBy: employeeID,
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.syncKYCStatusWithTx(ctx, lockedBorrower, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newDocument, nil
+}
+
+func (u *borrowerUsecase) VerifyKYCDocument(ctx context.Context, borrowerID uuid.UUID, documentID uuid.UUID, employeeID uuid.UUID) (*borrower.KYCDocument, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".VerifyKYCDocument")
+	defer span.End()
+
+	return u.reviewKYCDocument(ctx, borrowerID, documentID, employeeID, borrower.KYCEventVerify, "")
+}
+
+func (u *borrowerUsecase) RejectKYCDocument(ctx context.Context, borrowerID uuid.UUID, documentID uuid.UUID, employeeID uuid.UUID, rejectReason string) (*borrower.KYCDocument, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".RejectKYCDocument")
+	defer span.End()
+
+	return u.reviewKYCDocument(ctx, borrowerID, documentID, employeeID, borrower.KYCEventReject, rejectReason)
+}
+
+func (u *borrowerUsecase) reviewKYCDocument(ctx context.Context, borrowerID uuid.UUID, documentID uuid.UUID, employeeID uuid.UUID, event borrower.KYCEvent, reason string) (res *borrower.KYCDocument, err error) {
+	_, err = u.getBorrower(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.kycDocumentRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.kycDocumentRepo.Rollback(trx)
+			return
+		}
+
+		u.kycDocumentRepo.Commit(trx)
+	}()
+
+	lockedBorrower, err := u.borrowerRepo.GetByIDLockTx(ctx, borrowerID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := u.kycDocumentRepo.GetByIDLockTx(ctx, documentID, trx)
+	if err != nil {
+		return nil, err
+	} else if document.ID == uuid.Nil || document.BorrowerID != borrowerID {
+		return nil, httpError.NewNotFoundError("KYC document not found")
+	}
+
+	data, err := document.Review(event, employeeID, reason)
+	if errors.Is(err, borrower.ErrSelfReview) {
+		return nil, httpError.NewForbiddenError(err.Error())
+	} else if err != nil {
+		return nil, httpError.NewBadRequestError(err.Error())
+	}
+
+	updatedDocument, err := u.kycDocumentRepo.UpdateWithMapTx(ctx, documentID, data, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.syncKYCStatusWithTx(ctx, lockedBorrower, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedDocument, nil
+}
+
+// BlacklistBorrower bars the borrower from new loans. Running loans are not affected.
+func (u *borrowerUsecase) BlacklistBorrower(ctx context.Context, borrowerID uuid.UUID, employeeID uuid.UUID, req borrower.BlacklistBorrowerRequest) (*borrower.BlacklistEntry, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".BlacklistBorrower")
+	defer span.End()
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, httpError.NewBadRequestError("invalid request body", "expires_at must be in the future")
+	}
+
+	_, err := u.getBorrower(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	newEntry, err := u.blacklistRepo.Create(ctx, borrower.BlacklistEntry{
+		BorrowerID:    borrowerID,
+		Reason:        req.Reason,
+		ExpiresAt:     req.ExpiresAt,
+		BlacklistedBy: employeeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &newEntry, nil
+}
+
+func (u *borrowerUsecase) LiftBlacklist(ctx context.Context, borrowerID uuid.UUID, entryID uuid.UUID, employeeID uuid.UUID) (*borrower.BlacklistEntry, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".LiftBlacklist")
+	defer span.End()
+
+	_, err := u.getBorrower(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := u.blacklistRepo.GetByID(ctx, entryID)
+	if err != nil {
+		return nil, err
+	} else if entry.ID == uuid.Nil || entry.BorrowerID != borrowerID {
+		return nil, httpError.NewNotFoundError("blacklist entry not found")
+	}
+
+	now := time.Now()
+	if !entry.ActiveAt(now) {
+		return nil, httpError.NewBadRequestError("blacklist entry is no longer active")
+	}
+
+	updatedEntry, err := u.blacklistRepo.UpdateWithMap(ctx, entryID, map[string]any{
+		"lifted_by": employeeID,
+		"lifted_at": now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedEntry, nil
+}
+
+// syncKYCStatusWithTx stores the KYC status derived from the borrower's documents. The borrower must be locked within
+// trx so concurrent reviews do not overwrite each other.
+func (u *borrowerUsecase) syncKYCStatusWithTx(ctx context.Context, lockedBorrower borrower.Borrower, trx *gorm.DB) error {
+	documents, err := u.kycDocumentRepo.GetByBorrowerIDWithTx(ctx, lockedBorrower.ID, trx)
+	if err != nil {
+		return err
+	}
+
+	kycStatus := borrower.KYCStatusOf(documents)
+	if kycStatus == lockedBorrower.KYCStatus {
+		return nil
+	}
+
+	_, err = u.borrowerRepo.UpdateWithMapTx(ctx, lockedBorrower.ID, map[string]any{
+		"kyc_status": kycStatus,
+	}, trx)
+	return err
+}
+
+func (u *borrowerUsecase) getBorrower(ctx context.Context, borrowerID uuid.UUID) (borrower.Borrower, error) {
+	validBorrower, err := u.borrowerRepo.GetByID(ctx, borrowerID)
+	if err != nil {
+		return borrower.Borrower{}, err
+	} else if validBorrower.ID == uuid.Nil {
+		return borrower.Borrower{}, httpError.NewNotFoundError("borrower not found")
+	}
+
+	return validBorrower, nil
+}
@@ -0,0 +1,309 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/application/borrower/usecase"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	borrowerMock "github.com/BagusAK95/amarta_test/internal/domain/borrower/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	branchMock "github.com/BagusAK95/amarta_test/internal/domain/branch/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestRegisterBorrower(t *testing.T) {
+	branchID := uuid.New()
+	ctx := branch.WithScope(context.Background(), branch.Scope{BranchIDs: []uuid.UUID{branchID}})
+	req := borrower.RegisterBorrowerRequest{
+		FullName:     "Siti Aminah",
+		IDCardNumber: "3174015505900001",
+		Address:      "Jl. Melati 1",
+		PhoneNumber:  "+62 812-3456-7890",
+		Email:        "Siti@Example.com",
+		Segment:      borrower.SegmentMicro,
+		BranchID:     branchID,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		kycDocumentRepo := new(borrowerMock.MockIKYCDocumentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+
+		branchRepo.On("GetByID", mock.Anything, branchID).Return(branch.Branch{BaseModel: model.BaseModel{ID: branchID}}, nil)
+		borrowerRepo.On("GetByIdentifiers", mock.Anything, req.IDCardNumber, "081234567890", "siti@example.com").Return([]borrower.Borrower{}, nil)
+		borrowerRepo.On("Create", mock.Anything, borrower.Borrower{
+			FullName:     req.FullName,
+			IDCardNumber: req.IDCardNumber,
+			Address:      req.Address,
+			PhoneNumber:  "081234567890",
+			Email:        "siti@example.com",
+			Status:       borrower.StatusActive,
+			KYCStatus:    borrower.KYCStatusPending,
+			Segment:      req.Segment,
+			BranchID:     branchID,
+		}).Return(borrower.Borrower{BaseModel: model.BaseModel{ID: uuid.New()}}, nil)
+
+		uc := usecase.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+		res, err := uc.RegisterBorrower(ctx, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		borrowerRepo.AssertExpectations(t)
+		branchRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid NIK and phone number", func(t *testing.T) {
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		kycDocumentRepo := new(borrowerMock.MockIKYCDocumentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+
+		invalidReq := req
+		invalidReq.IDCardNumber = "31740155059"
+		invalidReq.PhoneNumber = "0215551234"
+
+		uc := usecase.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+		res, err := uc.RegisterBorrower(ctx, invalidReq)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("invalid request body",
+			"id_card_number must be 16 digits",
+			"phone_number must be an Indonesian mobile number starting with 08, 628 or +628",
+		), err)
+		borrowerRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("branch outside of scope", func(t *testing.T) {
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		kycDocumentRepo := new(borrowerMock.MockIKYCDocumentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+
+		otherBranchReq := req
+		otherBranchReq.BranchID = uuid.New()
+
+		uc := usecase.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+		res, err := uc.RegisterBorrower(ctx, otherBranchReq)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewForbiddenError("borrowers can only be registered in the employee's branches"), err)
+		borrowerRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("already registered", func(t *testing.T) {
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		kycDocumentRepo := new(borrowerMock.MockIKYCDocumentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+
+		branchRepo.On("GetByID", mock.Anything, branchID).Return(branch.Branch{BaseModel: model.BaseModel{ID: branchID}}, nil)
+		borrowerRepo.On("GetByIdentifiers", mock.Anything, req.IDCardNumber, "081234567890", "siti@example.com").Return([]borrower.Borrower{
+			{BaseModel: model.BaseModel{ID: uuid.New()}, IDCardNumber: req.IDCardNumber, PhoneNumber: "081111111111", Email: "other@example.com"},
+		}, nil)
+
+		uc := usecase.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+		res, err := uc.RegisterBorrower(ctx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("borrower is already registered", "id_card_number is already registered"), err)
+		borrowerRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeactivateBorrower(t *testing.T) {
+	ctx := context.Background()
+	borrowerID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		kycDocumentRepo := new(borrowerMock.MockIKYCDocumentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrower.Borrower{BaseModel: model.BaseModel{ID: borrowerID}, Status: borrower.StatusActive}, nil)
+		borrowerRepo.On("UpdateWithMap", mock.Anything, borrowerID, map[string]any{"status": borrower.StatusInactive}).
+			Return(borrower.Borrower{BaseModel: model.BaseModel{ID: borrowerID}, Status: borrower.StatusInactive}, nil)
+
+		uc := usecase.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+		res, err := uc.DeactivateBorrower(ctx, borrowerID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, borrower.StatusInactive, res.Status)
+		borrowerRepo.AssertExpectations(t)
+	})
+
+	t.Run("already inactive", func(t *testing.T) {
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		kycDocumentRepo := new(borrowerMock.MockIKYCDocumentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrower.Borrower{BaseModel: model.BaseModel{ID: borrowerID}, Status: borrower.StatusInactive}, nil)
+
+		uc := usecase.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+		res, err := uc.DeactivateBorrower(ctx, borrowerID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("borrower is already inactive"), err)
+		borrowerRepo.AssertNotCalled(t, "UpdateWithMap", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestVerifyKYCDocument(t *testing.T) {
+	ctx := context.Background()
+	borrowerID := uuid.New()
+	documentID := uuid.New()
+	submitterID := uuid.New()
+	reviewerID := uuid.New()
+	borrowerData := borrower.Borrower{BaseModel: model.BaseModel{ID: borrowerID}, KYCStatus: borrower.KYCStatusPending}
+	documentData := borrower.KYCDocument{
+		BaseModel:   model.BaseModel{ID: documentID},
+		BorrowerID:  borrowerID,
+		Type:        borrower.DocumentSelfie,
+		Status:      borrower.KYCStatusPending,
+		SubmittedBy: submitterID,
+	}
+
+	t.Run("last required document verifies the borrower", func(t *testing.T) {
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		kycDocumentRepo := new(borrowerMock.MockIKYCDocumentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+
+		verifiedDocument := documentData
+		verifiedDocument.Status = borrower.KYCStatusVerified
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
+		kycDocumentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		borrowerRepo.On("GetByIDLockTx", mock.Anything, borrowerID, mock.Anything).Return(borrowerData, nil)
+		kycDocumentRepo.On("GetByIDLockTx", mock.Anything, documentID, mock.Anything).Return(documentData, nil)
+		kycDocumentRepo.On("UpdateWithMapTx", mock.Anything, documentID, mock.MatchedBy(func(data map[string]any) bool {
+			return data["status"] == borrower.KYCStatusVerified && data["reviewed_by"] == reviewerID
+		}), mock.Anything).Return(verifiedDocument, nil)
+		kycDocumentRepo.On("GetByBorrowerIDWithTx", mock.Anything, borrowerID, mock.Anything).Return([]borrower.KYCDocument{
+			{Type: borrower.DocumentIDCard, Status: borrower.KYCStatusVerified},
+			verifiedDocument,
+		}, nil)
+		borrowerRepo.On("UpdateWithMapTx", mock.Anything, borrowerID, map[string]any{"kyc_status": borrower.KYCStatusVerified}, mock.Anything).Return(borrowerData, nil)
+		kycDocumentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+		res, err := uc.VerifyKYCDocument(ctx, borrowerID, documentID, reviewerID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, borrower.KYCStatusVerified, res.Status)
+		borrowerRepo.AssertExpectations(t)
+		kycDocumentRepo.AssertExpectations(t)
+	})
+
+	t.Run("reviewed by submitter", func(t *testing.T) {
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		kycDocumentRepo := new(borrowerMock.MockIKYCDocumentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
+		kycDocumentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		borrowerRepo.On("GetByIDLockTx", mock.Anything, borrowerID, mock.Anything).Return(borrowerData, nil)
+		kycDocumentRepo.On("GetByIDLockTx", mock.Anything, documentID, mock.Anything).Return(documentData, nil)
+		kycDocumentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+		res, err := uc.VerifyKYCDocument(ctx, borrowerID, documentID, submitterID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewForbiddenError(borrower.ErrSelfReview.Error()), err)
+		kycDocumentRepo.AssertNotCalled(t, "UpdateWithMapTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("document of another borrower", func(t *testing.T) {
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		kycDocumentRepo := new(borrowerMock.MockIKYCDocumentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+
+		otherDocument := documentData
+		otherDocument.BorrowerID = uuid.New()
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
+		kycDocumentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		borrowerRepo.On("GetByIDLockTx", mock.Anything, borrowerID, mock.Anything).Return(borrowerData, nil)
+		kycDocumentRepo.On("GetByIDLockTx", mock.Anything, documentID, mock.Anything).Return(otherDocument, nil)
+		kycDocumentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+		res, err := uc.VerifyKYCDocument(ctx, borrowerID, documentID, reviewerID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("KYC document not found"), err)
+	})
+}
+
+func TestLiftBlacklist(t *testing.T) {
+	ctx := context.Background()
+	borrowerID := uuid.New()
+	entryID := uuid.New()
+	employeeID := uuid.New()
+	borrowerData := borrower.Borrower{BaseModel: model.BaseModel{ID: borrowerID}, Status: borrower.StatusActive}
+	entryData := borrower.BlacklistEntry{
+		BaseModel:     model.BaseModel{ID: entryID},
+		BorrowerID:    borrowerID,
+		Reason:        "fraudulent documents",
+		BlacklistedBy: uuid.New(),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		kycDocumentRepo := new(borrowerMock.MockIKYCDocumentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+
+		liftedEntry := entryData
+		liftedEntry.LiftedBy = &employeeID
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
+		blacklistRepo.On("GetByID", mock.Anything, entryID).Return(entryData, nil)
+		blacklistRepo.On("UpdateWithMap", mock.Anything, entryID, mock.MatchedBy(func(data map[string]any) bool {
+			return data["lifted_by"] == employeeID && data["lifted_at"] != nil
+		})).Return(liftedEntry, nil)
+
+		uc := usecase.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+		res, err := uc.LiftBlacklist(ctx, borrowerID, entryID, employeeID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &employeeID, res.LiftedBy)
+		blacklistRepo.AssertExpectations(t)
+	})
+
+	t.Run("already expired", func(t *testing.T) {
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		kycDocumentRepo := new(borrowerMock.MockIKYCDocumentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+
+		expiredAt := time.Now().AddDate(0, 0, -1)
+		expiredEntry := entryData
+		expiredEntry.ExpiresAt = &expiredAt
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
+		blacklistRepo.On("GetByID", mock.Anything, entryID).Return(expiredEntry, nil)
+
+		uc := usecase.NewBorrowerUsecase(borrowerRepo, kycDocumentRepo, blacklistRepo, branchRepo)
+		res, err := uc.LiftBlacklist(ctx, borrowerID, entryID, employeeID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("blacklist entry is no longer active"), err)
+		blacklistRepo.AssertNotCalled(t, "UpdateWithMap", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
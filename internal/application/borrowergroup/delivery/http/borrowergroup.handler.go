@@ -0,0 +1,209 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrowergroup"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/BagusAK95/amarta_test/internal/utils/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type borrowerGroupHandler struct {
+	usecase   borrowergroup.IBorrowerGroupUsecase
+	validator *validator.CustomValidator
+}
+
+func NewBorrowerGroupHandler(usecase borrowergroup.IBorrowerGroupUsecase) *borrowerGroupHandler {
+	return &borrowerGroupHandler{
+		usecase:   usecase,
+		validator: validator.NewValidator(),
+	}
+}
+
+func (h *borrowerGroupHandler) CreateGroup(c *gin.Context) {
+	var body borrowergroup.CreateGroupRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	res, err := h.usecase.CreateGroup(c.Request.Context(), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *borrowerGroupHandler) DetailGroup(c *gin.Context) {
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	res, err := h.usecase.DetailGroup(c.Request.Context(), groupID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *borrowerGroupHandler) GetGroupArrears(c *gin.Context) {
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	res, err := h.usecase.GetGroupArrears(c.Request.Context(), groupID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *borrowerGroupHandler) CreateGroupLoan(c *gin.Context) {
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body borrowergroup.CreateGroupLoanRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.CreateGroupLoan(c.Request.Context(), groupID, employeeID.(uuid.UUID), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *borrowerGroupHandler) DetailGroupLoan(c *gin.Context) {
+	groupLoanID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	res, err := h.usecase.DetailGroupLoan(c.Request.Context(), groupLoanID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *borrowerGroupHandler) RejectGroupLoan(c *gin.Context) {
+	groupLoanID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body loan.RejectLoanRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.RejectGroupLoan(c.Request.Context(), groupLoanID, employeeID.(uuid.UUID), body.RejectReason)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *borrowerGroupHandler) ApproveGroupLoan(c *gin.Context) {
+	groupLoanID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body loan.ApproveLoanRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.ApproveGroupLoan(c.Request.Context(), groupLoanID, employeeID.(uuid.UUID), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *borrowerGroupHandler) DisburseGroupLoan(c *gin.Context) {
+	groupLoanID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body loan.DisburseLoanRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.DisburseGroupLoan(c.Request.Context(), groupLoanID, employeeID.(uuid.UUID), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrowergroup"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var groupTracerName = "GroupRepository"
+var groupTracer = otel.Tracer(groupTracerName)
+
+type groupRepo struct {
+	repository.BaseRepo[borrowergroup.Group]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewGroupRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) borrowergroup.IGroupRepository {
+	baseRepo := repository.NewBaseRepo[borrowergroup.Group](dbMaster, dbSlave)
+
+	return &groupRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+// GetByID is limited to the branches of the request's scope, so a group of another branch is not found
+func (r *groupRepo) GetByID(ctx context.Context, ID uuid.UUID) (groupData borrowergroup.Group, err error) {
+	ctx, span := groupTracer.Start(ctx, groupTracerName+".GetByID")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(groupData.TableName()).
+		Where(sq.Eq{
+			"id":         ID,
+			"deleted_at": nil,
+		})
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&groupData).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrowergroup"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var groupLoanTracerName = "GroupLoanRepository"
+var groupLoanTracer = otel.Tracer(groupLoanTracerName)
+
+type groupLoanRepo struct {
+	repository.BaseRepo[borrowergroup.GroupLoan]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewGroupLoanRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) borrowergroup.IGroupLoanRepository {
+	baseRepo := repository.NewBaseRepo[borrowergroup.GroupLoan](dbMaster, dbSlave)
+
+	return &groupLoanRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+// GetByID is limited to the branches of the request's scope, so a group loan of another branch is not found
+func (r *groupLoanRepo) GetByID(ctx context.Context, ID uuid.UUID) (groupLoanData borrowergroup.GroupLoan, err error) {
+	ctx, span := groupLoanTracer.Start(ctx, groupLoanTracerName+".GetByID")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(groupLoanData.TableName()).
+		Where(sq.Eq{
+			"id":         ID,
+			"deleted_at": nil,
+		})
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&groupLoanData).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetByIDLockTx is limited like GetByID and serializes the transitions of a group loan
+func (r *groupLoanRepo) GetByIDLockTx(ctx context.Context, ID uuid.UUID, trx *gorm.DB) (groupLoanData borrowergroup.GroupLoan, err error) {
+	ctx, span := groupLoanTracer.Start(ctx, groupLoanTracerName+".GetByIDLockTx")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(groupLoanData.TableName()).
+		Where(sq.Eq{
+			"id":         ID,
+			"deleted_at": nil,
+		}).
+		Suffix("FOR UPDATE")
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Raw(qry, args...).Scan(&groupLoanData).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (r *groupLoanRepo) GetByGroupID(ctx context.Context, groupID uuid.UUID) (groupLoans []borrowergroup.GroupLoan, err error) {
+	ctx, span := groupLoanTracer.Start(ctx, groupLoanTracerName+".GetByGroupID")
+	defer span.End()
+
+	var model borrowergroup.GroupLoan
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"group_id":   groupID,
+			"deleted_at": nil,
+		}).
+		OrderBy("created_at ASC", "id ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&groupLoans).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrowergroup"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var memberTracerName = "MemberRepository"
+var memberTracer = otel.Tracer(memberTracerName)
+
+type memberRepo struct {
+	repository.BaseRepo[borrowergroup.Member]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewMemberRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) borrowergroup.IMemberRepository {
+	baseRepo := repository.NewBaseRepo[borrowergroup.Member](dbMaster, dbSlave)
+
+	return &memberRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *memberRepo) GetByGroupID(ctx context.Context, groupID uuid.UUID) (members []borrowergroup.Member, err error) {
+	ctx, span := memberTracer.Start(ctx, memberTracerName+".GetByGroupID")
+	defer span.End()
+
+	var model borrowergroup.Member
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"group_id":   groupID,
+			"deleted_at": nil,
+		}).
+		OrderBy("created_at ASC", "id ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&members).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetByBorrowerIDs returns the active memberships of the borrowers, of whichever group
+func (r *memberRepo) GetByBorrowerIDs(ctx context.Context, borrowerIDs []uuid.UUID) (members []borrowergroup.Member, err error) {
+	ctx, span := memberTracer.Start(ctx, memberTracerName+".GetByBorrowerIDs")
+	defer span.End()
+
+	var model borrowergroup.Member
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"borrower_id": borrowerIDs,
+			"deleted_at":  nil,
+		})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&members).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,518 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrowergroup"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/amortization"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/eligibility"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/pricing"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	"github.com/BagusAK95/amarta_test/internal/infrastructure/bus"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var tracerName = "BorrowerGroupUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type borrowerGroupUsecase struct {
+	groupRepo          borrowergroup.IGroupRepository
+	memberRepo         borrowergroup.IMemberRepository
+	groupLoanRepo      borrowergroup.IGroupLoanRepository
+	borrowerRepo       borrower.IBorrowerRepository
+	loanRepo           loan.ILoanRepository
+	installmentRepo    installment.IInstallmentRepository
+	eligibilityUsecase eligibility.IEligibilityUsecase
+	scoringUsecase     scoring.IScoringUsecase
+	pricingUsecase     pricing.IPricingUsecase
+	loanUsecase        loan.ILoanUsecase
+	loanBus            bus.Bus[loan.LoanApprovedEvent]
+}
+
+func NewBorrowerGroupUsecase(groupRepo borrowergroup.IGroupRepository, memberRepo borrowergroup.IMemberRepository, groupLoanRepo borrowergroup.IGroupLoanRepository, borrowerRepo borrower.IBorrowerRepository, loanRepo loan.ILoanRepository, installmentRepo installment.IInstallmentRepository, eligibilityUsecase eligibility.IEligibilityUsecase, scoringUsecase scoring.IScoringUsecase, pricingUsecase pricing.IPricingUsecase, loanUsecase loan.ILoanUsecase, loanBus bus.Bus[loan.LoanApprovedEvent]) borrowergroup.IBorrowerGroupUsecase {
+	return &borrowerGroupUsecase{
+		groupRepo:          groupRepo,
+		memberRepo:         memberRepo,
+		groupLoanRepo:      groupLoanRepo,
+		borrowerRepo:       borrowerRepo,
+		loanRepo:           loanRepo,
+		installmentRepo:    installmentRepo,
+		eligibilityUsecase: eligibilityUsecase,
+		scoringUsecase:     scoringUsecase,
+		pricingUsecase:     pricingUsecase,
+		loanUsecase:        loanUsecase,
+		loanBus:            loanBus,
+	}
+}
+
+// CreateGroup forms a group in the branch of its leader. A borrower can only be a member of one group at a time.
+func (u *borrowerGroupUsecase) CreateGroup(ctx context.Context, req borrowergroup.CreateGroupRequest) (res *borrowergroup.Group, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".CreateGroup")
+	defer span.End()
+
+	leader, err := u.borrowerRepo.GetByID(ctx, req.LeaderBorrowerID)
+	if err != nil {
+		return nil, err
+	} else if leader.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("leader borrower not found")
+	}
+
+	borrowers, err := u.borrowerRepo.GetByIDs(ctx, req.MemberBorrowerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	borrowerBranches := map[uuid.UUID]uuid.UUID{}
+	for _, b := range borrowers {
+		borrowerBranches[b.ID] = b.BranchID
+	}
+
+	unknown := []string{}
+	otherBranch := []string{}
+	leaderIsMember := false
+	for _, borrowerID := range req.MemberBorrowerIDs {
+		branchID, ok := borrowerBranches[borrowerID]
+		if !ok {
+			unknown = append(unknown, borrowerID.String())
+		} else if branchID != leader.BranchID {
+			otherBranch = append(otherBranch, borrowerID.String())
+		}
+
+		if borrowerID == leader.ID {
+			leaderIsMember = true
+		}
+	}
+
+	if len(unknown) > 0 {
+		return nil, httpError.NewBadRequestError("unknown borrowers", unknown...)
+	} else if len(otherBranch) > 0 {
+		return nil, httpError.NewBadRequestError("members must belong to the branch of the leader", otherBranch...)
+	} else if !leaderIsMember {
+		return nil, httpError.NewBadRequestError("leader must be one of the members")
+	}
+
+	memberships, err := u.memberRepo.GetByBorrowerIDs(ctx, req.MemberBorrowerIDs)
+	if err != nil {
+		return nil, err
+	} else if len(memberships) > 0 {
+		grouped := make([]string, 0, len(memberships))
+		for _, membership := range memberships {
+			grouped = append(grouped, membership.BorrowerID.String())
+		}
+
+		return nil, httpError.NewBadRequestError("borrowers already belong to a group", grouped...)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.groupRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.groupRepo.Rollback(trx)
+			return
+		}
+
+		u.groupRepo.Commit(trx)
+	}()
+
+	newGroup, err := u.groupRepo.CreateWithTx(ctx, borrowergroup.Group{
+		Name:             req.Name,
+		BranchID:         leader.BranchID,
+		LeaderBorrowerID: leader.ID,
+		MeetingDay:       req.MeetingDay,
+		MeetingTime:      req.MeetingTime,
+		MeetingLocation:  req.MeetingLocation,
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]borrowergroup.Member, 0, len(req.MemberBorrowerIDs))
+	for _, borrowerID := range req.MemberBorrowerIDs {
+		members = append(members, borrowergroup.Member{
+			GroupID:    newGroup.ID,
+			BorrowerID: borrowerID,
+		})
+	}
+
+	newGroup.Members, err = u.memberRepo.CreateBulkAndReturnWithTx(ctx, members, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newGroup, nil
+}
+
+func (u *borrowerGroupUsecase) DetailGroup(ctx context.Context, groupID uuid.UUID) (*borrowergroup.Group, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".DetailGroup")
+	defer span.End()
+
+	validGroup, err := u.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &validGroup, nil
+}
+
+func (u *borrowerGroupUsecase) GetGroupArrears(ctx context.Context, groupID uuid.UUID) (*borrowergroup.GroupArrears, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetGroupArrears")
+	defer span.End()
+
+	validGroup, err := u.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	arrears, err := u.getArrears(ctx, validGroup, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &arrears, nil
+}
+
+// CreateGroupLoan proposes one loan per requesting member, bundled in a group loan. Because members are jointly liable,
+// a group with overdue installments has to settle them first.
+func (u *borrowerGroupUsecase) CreateGroupLoan(ctx context.Context, groupID uuid.UUID, employeeID uuid.UUID, req borrowergroup.CreateGroupLoanRequest) (res *borrowergroup.GroupLoan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".CreateGroupLoan")
+	defer span.End()
+
+	validGroup, err := u.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	notMembers := []string{}
+	for _, member := range req.Members {
+		if !validGroup.HasMember(member.BorrowerID) {
+			notMembers = append(notMembers, member.BorrowerID.String())
+		}
+	}
+
+	if len(notMembers) > 0 {
+		return nil, httpError.NewBadRequestError("borrowers are not members of the group", notMembers...)
+	}
+
+	borrowerIDs := make([]uuid.UUID, 0, len(req.Members))
+	for _, member := range req.Members {
+		borrowerIDs = append(borrowerIDs, member.BorrowerID)
+	}
+
+	borrowers, err := u.borrowerRepo.GetByIDs(ctx, borrowerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	principals := map[uuid.UUID]money.Money{}
+	for _, member := range req.Members {
+		principals[member.BorrowerID] = member.PrincipalAmount
+	}
+
+	checks := map[uuid.UUID]loan.EligibilityChecks{}
+	ineligible := []string{}
+	for _, b := range borrowers {
+		checks[b.ID], err = u.eligibilityUsecase.Evaluate(ctx, b, principals[b.ID])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, failure := range checks[b.ID].Failures() {
+			ineligible = append(ineligible, b.ID.String()+": "+failure)
+		}
+	}
+
+	if len(ineligible) > 0 {
+		return nil, httpError.NewBadRequestError("borrowers are not eligible for the loan", ineligible...)
+	}
+
+	arrears, err := u.getArrears(ctx, validGroup, time.Now())
+	if err != nil {
+		return nil, err
+	} else if arrears.InArrears() {
+		return nil, httpError.NewBadRequestError("group has overdue installments that must be settled before a new group loan")
+	}
+
+	// Each member's loan is scored and priced on its own, the group loan has no score of its own
+	assessments := map[uuid.UUID]loan.RiskAssessment{}
+	quotes := map[uuid.UUID]pricing.Quote{}
+	for _, b := range borrowers {
+		score, err := u.scoringUsecase.Score(ctx, b, principals[b.ID])
+		if err != nil {
+			return nil, err
+		}
+
+		assessments[b.ID] = score.Assessment(time.Now())
+		quotes[b.ID], err = u.pricingUsecase.Price(ctx, score.Grade, pricing.Input{
+			PrincipalAmount:    principals[b.ID],
+			Tenor:              req.Tenor,
+			RepaymentFrequency: req.RepaymentFrequency,
+			Rate:               req.Rate,
+			ROI:                req.ROI,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.groupLoanRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.groupLoanRepo.Rollback(trx)
+			return
+		}
+
+		u.groupLoanRepo.Commit(trx)
+	}()
+
+	newGroupLoan, err := u.groupLoanRepo.CreateWithTx(ctx, borrowergroup.GroupLoan{
+		GroupID:  validGroup.ID,
+		BranchID: validGroup.BranchID,
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	loans := make([]loan.Loan, 0, len(req.Members))
+	for _, member := range req.Members {
+		loans = append(loans, loan.Loan{
+			BorrowerID:         member.BorrowerID,
+			BranchID:           validGroup.BranchID,
+			GroupLoanID:        &newGroupLoan.ID,
+			PrincipalAmount:    member.PrincipalAmount,
+			Rate:               quotes[member.BorrowerID].Rate,
+			ROI:                quotes[member.BorrowerID].ROI,
+			Tenor:              req.Tenor,
+			RepaymentFrequency: req.RepaymentFrequency,
+			AmortizationMethod: amortization.MethodFlat,
+			AgreementLetterURL: req.AgreementLetterURL,
+			State:              loan.StateProposed,
+			ProposedBy:         &employeeID,
+			EligibilityChecks:  checks[member.BorrowerID],
+			RiskAssessment:     assessments[member.BorrowerID],
+		})
+	}
+
+	loans, err = u.loanRepo.CreateBulkAndReturnWithTx(ctx, loans, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	newGroupLoan = newGroupLoan.WithLoans(loans)
+	return &newGroupLoan, nil
+}
+
+func (u *borrowerGroupUsecase) DetailGroupLoan(ctx context.Context, groupLoanID uuid.UUID) (*borrowergroup.GroupLoan, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".DetailGroupLoan")
+	defer span.End()
+
+	validGroupLoan, err := u.groupLoanRepo.GetByID(ctx, groupLoanID)
+	if err != nil {
+		return nil, err
+	} else if validGroupLoan.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("group loan not found")
+	}
+
+	loans, err := u.loanRepo.GetByGroupLoanIDs(ctx, []uuid.UUID{validGroupLoan.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	validGroupLoan = validGroupLoan.WithLoans(loans)
+	return &validGroupLoan, nil
+}
+
+func (u *borrowerGroupUsecase) RejectGroupLoan(ctx context.Context, groupLoanID uuid.UUID, employeeID uuid.UUID, rejectReason string) (res *borrowergroup.GroupLoan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".RejectGroupLoan")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.groupLoanRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.groupLoanRepo.Rollback(trx)
+			return
+		}
+
+		u.groupLoanRepo.Commit(trx)
+	}()
+
+	validGroupLoan, loans, err := u.getGroupLoanLockTx(ctx, groupLoanID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	loans, err = u.loanUsecase.RejectLoansWithTx(ctx, loans, employeeID, rejectReason, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	validGroupLoan = validGroupLoan.WithLoans(loans)
+	return &validGroupLoan, nil
+}
+
+// ApproveGroupLoan records the validator's vote on every member loan. The approval policy applies to the total amount of
+// the group loan, and the loans are approved together once it is satisfied.
+func (u *borrowerGroupUsecase) ApproveGroupLoan(ctx context.Context, groupLoanID uuid.UUID, employeeID uuid.UUID, req loan.ApproveLoanRequest) (res *borrowergroup.GroupLoan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ApproveGroupLoan")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	approved := false
+	trx := u.groupLoanRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.groupLoanRepo.Rollback(trx)
+			return
+		}
+
+		u.groupLoanRepo.Commit(trx)
+
+		if !approved {
+			return
+		}
+
+		for _, l := range res.Loans {
+			u.loanBus.Publish("loan.approved", loan.LoanApprovedEvent{
+				LoanID: l.ID,
+			})
+		}
+	}()
+
+	validGroupLoan, loans, err := u.getGroupLoanLockTx(ctx, groupLoanID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	loans, approved, err = u.loanUsecase.ApproveLoansWithTx(ctx, loans, employeeID, req, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	validGroupLoan = validGroupLoan.WithLoans(loans)
+	return &validGroupLoan, nil
+}
+
+func (u *borrowerGroupUsecase) DisburseGroupLoan(ctx context.Context, groupLoanID uuid.UUID, employeeID uuid.UUID, req loan.DisburseLoanRequest) (res *borrowergroup.GroupLoan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".DisburseGroupLoan")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.groupLoanRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.groupLoanRepo.Rollback(trx)
+			return
+		}
+
+		u.groupLoanRepo.Commit(trx)
+	}()
+
+	validGroupLoan, loans, err := u.getGroupLoanLockTx(ctx, groupLoanID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	loans, err = u.loanUsecase.DisburseLoansWithTx(ctx, loans, employeeID, req, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	validGroupLoan = validGroupLoan.WithLoans(loans)
+	return &validGroupLoan, nil
+}
+
+func (u *borrowerGroupUsecase) getGroup(ctx context.Context, groupID uuid.UUID) (borrowergroup.Group, error) {
+	validGroup, err := u.groupRepo.GetByID(ctx, groupID)
+	if err != nil {
+		return borrowergroup.Group{}, err
+	} else if validGroup.ID == uuid.Nil {
+		return borrowergroup.Group{}, httpError.NewNotFoundError("group not found")
+	}
+
+	validGroup.Members, err = u.memberRepo.GetByGroupID(ctx, groupID)
+	if err != nil {
+		return borrowergroup.Group{}, err
+	}
+
+	return validGroup, nil
+}
+
+func (u *borrowerGroupUsecase) getGroupLoanLockTx(ctx context.Context, groupLoanID uuid.UUID, trx *gorm.DB) (borrowergroup.GroupLoan, []loan.Loan, error) {
+	validGroupLoan, err := u.groupLoanRepo.GetByIDLockTx(ctx, groupLoanID, trx)
+	if err != nil {
+		return borrowergroup.GroupLoan{}, nil, err
+	} else if validGroupLoan.ID == uuid.Nil {
+		return borrowergroup.GroupLoan{}, nil, httpError.NewNotFoundError("group loan not found")
+	}
+
+	loans, err := u.loanRepo.GetByGroupLoanIDLockTx(ctx, groupLoanID, trx)
+	if err != nil {
+		return borrowergroup.GroupLoan{}, nil, err
+	} else if len(loans) == 0 {
+		return borrowergroup.GroupLoan{}, nil, httpError.NewNotFoundError("group loan has no loans")
+	}
+
+	return validGroupLoan, loans, nil
+}
+
+// getArrears looks up the overdue installments of every loan the group has taken
+func (u *borrowerGroupUsecase) getArrears(ctx context.Context, validGroup borrowergroup.Group, asOf time.Time) (borrowergroup.GroupArrears, error) {
+	borrowerIDs := make([]uuid.UUID, 0, len(validGroup.Members))
+	for _, member := range validGroup.Members {
+		borrowerIDs = append(borrowerIDs, member.BorrowerID)
+	}
+
+	borrowers, err := u.borrowerRepo.GetByIDs(ctx, borrowerIDs)
+	if err != nil {
+		return borrowergroup.GroupArrears{}, err
+	}
+
+	groupLoans, err := u.groupLoanRepo.GetByGroupID(ctx, validGroup.ID)
+	if err != nil {
+		return borrowergroup.GroupArrears{}, err
+	} else if len(groupLoans) == 0 {
+		return borrowergroup.NewGroupArrears(validGroup, borrowers, nil, nil, asOf), nil
+	}
+
+	groupLoanIDs := make([]uuid.UUID, 0, len(groupLoans))
+	for _, groupLoan := range groupLoans {
+		groupLoanIDs = append(groupLoanIDs, groupLoan.ID)
+	}
+
+	loans, err := u.loanRepo.GetByGroupLoanIDs(ctx, groupLoanIDs)
+	if err != nil {
+		return borrowergroup.GroupArrears{}, err
+	}
+
+	loanIDs := make([]uuid.UUID, 0, len(loans))
+	for _, l := range loans {
+		loanIDs = append(loanIDs, l.ID)
+	}
+
+	overdue, err := u.installmentRepo.GetOverdueByLoanIDs(ctx, loanIDs, asOf)
+	if err != nil {
+		return borrowergroup.GroupArrears{}, err
+	}
+
+	return borrowergroup.NewGroupArrears(validGroup, borrowers, loans, overdue, asOf), nil
+}
@@ -0,0 +1,431 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/application/borrowergroup/usecase"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	borrowerMock "github.com/BagusAK95/amarta_test/internal/domain/borrower/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrowergroup"
+	borrowerGroupMock "github.com/BagusAK95/amarta_test/internal/domain/borrowergroup/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	eligibilityMock "github.com/BagusAK95/amarta_test/internal/domain/eligibility/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	installmentMock "github.com/BagusAK95/amarta_test/internal/domain/installment/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	loanMock "github.com/BagusAK95/amarta_test/internal/domain/loan/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/pricing"
+	pricingMock "github.com/BagusAK95/amarta_test/internal/domain/pricing/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	scoringMock "github.com/BagusAK95/amarta_test/internal/domain/scoring/mock"
+	busMock "github.com/BagusAK95/amarta_test/internal/infrastructure/bus/mock"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateGroup(t *testing.T) {
+	ctx := context.Background()
+	branchID := uuid.New()
+	borrowers := []borrower.Borrower{
+		{BaseModel: model.BaseModel{ID: uuid.New()}, FullName: "Siti", BranchID: branchID},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, FullName: "Ani", BranchID: branchID},
+	}
+	req := borrowergroup.CreateGroupRequest{
+		Name:              "Majelis Mawar",
+		LeaderBorrowerID:  borrowers[0].ID,
+		MemberBorrowerIDs: []uuid.UUID{borrowers[0].ID, borrowers[1].ID},
+		MeetingDay:        borrowergroup.MeetingDayTuesday,
+		MeetingTime:       "09:00",
+		MeetingLocation:   "Rumah Bu Siti",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		groupRepo := new(borrowerGroupMock.MockIGroupRepository)
+		memberRepo := new(borrowerGroupMock.MockIMemberRepository)
+		groupLoanRepo := new(borrowerGroupMock.MockIGroupLoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		loanUsecase := new(loanMock.MockILoanUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		groupID := uuid.New()
+		members := []borrowergroup.Member{
+			{GroupID: groupID, BorrowerID: borrowers[0].ID},
+			{GroupID: groupID, BorrowerID: borrowers[1].ID},
+		}
+		borrowerRepo.On("GetByID", mock.Anything, borrowers[0].ID).Return(borrowers[0], nil)
+		borrowerRepo.On("GetByIDs", mock.Anything, req.MemberBorrowerIDs).Return(borrowers, nil)
+		memberRepo.On("GetByBorrowerIDs", mock.Anything, req.MemberBorrowerIDs).Return([]borrowergroup.Member{}, nil)
+		groupRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		groupRepo.On("CreateWithTx", mock.Anything, borrowergroup.Group{
+			Name:             req.Name,
+			BranchID:         branchID,
+			LeaderBorrowerID: borrowers[0].ID,
+			MeetingDay:       req.MeetingDay,
+			MeetingTime:      req.MeetingTime,
+			MeetingLocation:  req.MeetingLocation,
+		}, mock.Anything).Return(borrowergroup.Group{BaseModel: model.BaseModel{ID: groupID}, BranchID: branchID}, nil)
+		memberRepo.On("CreateBulkAndReturnWithTx", mock.Anything, members, mock.Anything).Return(members, nil)
+		groupRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewBorrowerGroupUsecase(groupRepo, memberRepo, groupLoanRepo, borrowerRepo, loanRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, loanUsecase, loanBus)
+		res, err := uc.CreateGroup(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, groupID, res.ID)
+		assert.Equal(t, members, res.Members)
+		groupRepo.AssertExpectations(t)
+		memberRepo.AssertExpectations(t)
+	})
+
+	t.Run("member of another branch", func(t *testing.T) {
+		groupRepo := new(borrowerGroupMock.MockIGroupRepository)
+		memberRepo := new(borrowerGroupMock.MockIMemberRepository)
+		groupLoanRepo := new(borrowerGroupMock.MockIGroupLoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		loanUsecase := new(loanMock.MockILoanUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		otherBranchBorrower := borrowers[1]
+		otherBranchBorrower.BranchID = uuid.New()
+		borrowerRepo.On("GetByID", mock.Anything, borrowers[0].ID).Return(borrowers[0], nil)
+		borrowerRepo.On("GetByIDs", mock.Anything, req.MemberBorrowerIDs).Return([]borrower.Borrower{borrowers[0], otherBranchBorrower}, nil)
+
+		uc := usecase.NewBorrowerGroupUsecase(groupRepo, memberRepo, groupLoanRepo, borrowerRepo, loanRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, loanUsecase, loanBus)
+		res, err := uc.CreateGroup(ctx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("members must belong to the branch of the leader", borrowers[1].ID.String()), err)
+		groupRepo.AssertNotCalled(t, "BeginTransaction", mock.Anything)
+	})
+
+	t.Run("borrower already in a group", func(t *testing.T) {
+		groupRepo := new(borrowerGroupMock.MockIGroupRepository)
+		memberRepo := new(borrowerGroupMock.MockIMemberRepository)
+		groupLoanRepo := new(borrowerGroupMock.MockIGroupLoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		loanUsecase := new(loanMock.MockILoanUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		borrowerRepo.On("GetByID", mock.Anything, borrowers[0].ID).Return(borrowers[0], nil)
+		borrowerRepo.On("GetByIDs", mock.Anything, req.MemberBorrowerIDs).Return(borrowers, nil)
+		memberRepo.On("GetByBorrowerIDs", mock.Anything, req.MemberBorrowerIDs).Return([]borrowergroup.Member{
+			{GroupID: uuid.New(), BorrowerID: borrowers[1].ID},
+		}, nil)
+
+		uc := usecase.NewBorrowerGroupUsecase(groupRepo, memberRepo, groupLoanRepo, borrowerRepo, loanRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, loanUsecase, loanBus)
+		res, err := uc.CreateGroup(ctx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("borrowers already belong to a group", borrowers[1].ID.String()), err)
+		groupRepo.AssertNotCalled(t, "BeginTransaction", mock.Anything)
+	})
+}
+
+func TestCreateGroupLoan(t *testing.T) {
+	ctx := context.Background()
+	employeeID := uuid.New()
+	groupID := uuid.New()
+	branchID := uuid.New()
+	borrowers := []borrower.Borrower{
+		{BaseModel: model.BaseModel{ID: uuid.New()}, FullName: "Siti", BranchID: branchID, Status: borrower.StatusActive, KYCStatus: borrower.KYCStatusVerified},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, FullName: "Ani", BranchID: branchID, Status: borrower.StatusActive, KYCStatus: borrower.KYCStatusVerified},
+	}
+	borrowerIDs := []uuid.UUID{borrowers[0].ID, borrowers[1].ID}
+	groupData := borrowergroup.Group{
+		BaseModel:   model.BaseModel{ID: groupID},
+		BranchID:    branchID,
+		MeetingDay:  borrowergroup.MeetingDayTuesday,
+		MeetingTime: "09:00",
+	}
+	members := []borrowergroup.Member{
+		{GroupID: groupID, BorrowerID: borrowers[0].ID},
+		{GroupID: groupID, BorrowerID: borrowers[1].ID},
+	}
+	req := borrowergroup.CreateGroupLoanRequest{
+		Tenor:              25,
+		RepaymentFrequency: loan.FrequencyWeekly,
+		AgreementLetterURL: "https://example.com/agreement.pdf",
+		Members: []borrowergroup.GroupLoanMember{
+			{BorrowerID: borrowers[0].ID, PrincipalAmount: 3_000_000},
+			{BorrowerID: borrowers[1].ID, PrincipalAmount: 2_000_000},
+		},
+	}
+	eligibleChecks := loan.EligibilityChecks{
+		{Rule: "borrower_status", Passed: true, Reason: "borrower is active and KYC is verified"},
+	}
+	scoreResult := scoring.Result{Score: 66, Grade: scoring.GradeB, ScorecardVersion: 1}
+	quote := pricing.Quote{Terms: pricing.Terms{Rate: 20, ROI: 16}, RiskGrade: scoring.GradeB}
+	pastGroupLoanID := uuid.New()
+	pastLoan := loan.Loan{
+		BaseModel:   model.BaseModel{ID: uuid.New()},
+		BorrowerID:  borrowers[1].ID,
+		GroupLoanID: &pastGroupLoanID,
+		State:       loan.StateDisbursed,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		groupRepo := new(borrowerGroupMock.MockIGroupRepository)
+		memberRepo := new(borrowerGroupMock.MockIMemberRepository)
+		groupLoanRepo := new(borrowerGroupMock.MockIGroupLoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		loanUsecase := new(loanMock.MockILoanUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		groupLoanID := uuid.New()
+		groupRepo.On("GetByID", mock.Anything, groupID).Return(groupData, nil)
+		memberRepo.On("GetByGroupID", mock.Anything, groupID).Return(members, nil)
+		borrowerRepo.On("GetByIDs", mock.Anything, borrowerIDs).Return(borrowers, nil)
+		eligibilityUsecase.On("Evaluate", mock.Anything, borrowers[0], req.Members[0].PrincipalAmount).Return(eligibleChecks, nil)
+		eligibilityUsecase.On("Evaluate", mock.Anything, borrowers[1], req.Members[1].PrincipalAmount).Return(eligibleChecks, nil)
+		groupLoanRepo.On("GetByGroupID", mock.Anything, groupID).Return([]borrowergroup.GroupLoan{{BaseModel: model.BaseModel{ID: pastGroupLoanID}}}, nil)
+		loanRepo.On("GetByGroupLoanIDs", mock.Anything, []uuid.UUID{pastGroupLoanID}).Return([]loan.Loan{pastLoan}, nil)
+		installmentRepo.On("GetOverdueByLoanIDs", mock.Anything, []uuid.UUID{pastLoan.ID}, mock.Anything).Return([]installment.Installment{}, nil)
+		scoringUsecase.On("Score", mock.Anything, borrowers[0], req.Members[0].PrincipalAmount).Return(scoreResult, nil)
+		scoringUsecase.On("Score", mock.Anything, borrowers[1], req.Members[1].PrincipalAmount).Return(scoreResult, nil)
+		pricingUsecase.On("Price", mock.Anything, scoring.GradeB, mock.AnythingOfType("pricing.Input")).Return(quote, nil)
+		groupLoanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		groupLoanRepo.On("CreateWithTx", mock.Anything, borrowergroup.GroupLoan{GroupID: groupID, BranchID: branchID}, mock.Anything).
+			Return(borrowergroup.GroupLoan{BaseModel: model.BaseModel{ID: groupLoanID}, GroupID: groupID, BranchID: branchID}, nil)
+		loanRepo.On("CreateBulkAndReturnWithTx", mock.Anything, mock.MatchedBy(func(loans []loan.Loan) bool {
+			return len(loans) == 2 &&
+				loans[0].BorrowerID == borrowers[0].ID && loans[0].PrincipalAmount == 3_000_000 &&
+				*loans[0].GroupLoanID == groupLoanID && loans[0].BranchID == branchID && len(loans[0].EligibilityChecks) == 1 &&
+				*loans[0].RiskAssessment.RiskGrade == "B" && *loans[0].RiskAssessment.CreditScore == 66 &&
+				loans[0].Rate == 20 && loans[0].ROI == 16 &&
+				loans[1].BorrowerID == borrowers[1].ID && loans[1].State == loan.StateProposed
+		}), mock.Anything).Return(func(ctx context.Context, loans []loan.Loan, trx *gorm.DB) []loan.Loan {
+			return loans
+		}, nil)
+		groupLoanRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewBorrowerGroupUsecase(groupRepo, memberRepo, groupLoanRepo, borrowerRepo, loanRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, loanUsecase, loanBus)
+		res, err := uc.CreateGroupLoan(ctx, groupID, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, groupLoanID, res.ID)
+		assert.Equal(t, loan.StateProposed, res.State)
+		assert.Equal(t, 5_000_000, int(res.PrincipalAmount))
+		assert.Len(t, res.Loans, 2)
+		groupLoanRepo.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+		scoringUsecase.AssertExpectations(t)
+	})
+
+	t.Run("group in arrears", func(t *testing.T) {
+		groupRepo := new(borrowerGroupMock.MockIGroupRepository)
+		memberRepo := new(borrowerGroupMock.MockIMemberRepository)
+		groupLoanRepo := new(borrowerGroupMock.MockIGroupLoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		loanUsecase := new(loanMock.MockILoanUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		groupRepo.On("GetByID", mock.Anything, groupID).Return(groupData, nil)
+		memberRepo.On("GetByGroupID", mock.Anything, groupID).Return(members, nil)
+		borrowerRepo.On("GetByIDs", mock.Anything, borrowerIDs).Return(borrowers, nil)
+		eligibilityUsecase.On("Evaluate", mock.Anything, borrowers[0], req.Members[0].PrincipalAmount).Return(eligibleChecks, nil)
+		eligibilityUsecase.On("Evaluate", mock.Anything, borrowers[1], req.Members[1].PrincipalAmount).Return(eligibleChecks, nil)
+		groupLoanRepo.On("GetByGroupID", mock.Anything, groupID).Return([]borrowergroup.GroupLoan{{BaseModel: model.BaseModel{ID: pastGroupLoanID}}}, nil)
+		loanRepo.On("GetByGroupLoanIDs", mock.Anything, []uuid.UUID{pastGroupLoanID}).Return([]loan.Loan{pastLoan}, nil)
+		installmentRepo.On("GetOverdueByLoanIDs", mock.Anything, []uuid.UUID{pastLoan.ID}, mock.Anything).Return([]installment.Installment{
+			{LoanID: pastLoan.ID, Sequence: 3, DueDate: time.Now().AddDate(0, 0, -8), PrincipalAmount: 100_000, InterestAmount: 10_000},
+		}, nil)
+
+		uc := usecase.NewBorrowerGroupUsecase(groupRepo, memberRepo, groupLoanRepo, borrowerRepo, loanRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, loanUsecase, loanBus)
+		res, err := uc.CreateGroupLoan(ctx, groupID, employeeID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("group has overdue installments that must be settled before a new group loan"), err)
+		groupLoanRepo.AssertNotCalled(t, "BeginTransaction", mock.Anything)
+	})
+
+	t.Run("member not eligible", func(t *testing.T) {
+		groupRepo := new(borrowerGroupMock.MockIGroupRepository)
+		memberRepo := new(borrowerGroupMock.MockIMemberRepository)
+		groupLoanRepo := new(borrowerGroupMock.MockIGroupLoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		loanUsecase := new(loanMock.MockILoanUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		groupRepo.On("GetByID", mock.Anything, groupID).Return(groupData, nil)
+		memberRepo.On("GetByGroupID", mock.Anything, groupID).Return(members, nil)
+		borrowerRepo.On("GetByIDs", mock.Anything, borrowerIDs).Return(borrowers, nil)
+		eligibilityUsecase.On("Evaluate", mock.Anything, borrowers[0], req.Members[0].PrincipalAmount).Return(eligibleChecks, nil)
+		eligibilityUsecase.On("Evaluate", mock.Anything, borrowers[1], req.Members[1].PrincipalAmount).Return(loan.EligibilityChecks{
+			{Rule: "borrower_status", Passed: false, Reason: "borrower KYC is not verified"},
+			{Rule: "blacklist", Passed: true, Reason: "borrower is not blacklisted"},
+		}, nil)
+
+		uc := usecase.NewBorrowerGroupUsecase(groupRepo, memberRepo, groupLoanRepo, borrowerRepo, loanRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, loanUsecase, loanBus)
+		res, err := uc.CreateGroupLoan(ctx, groupID, employeeID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("borrowers are not eligible for the loan", borrowers[1].ID.String()+": borrower_status: borrower KYC is not verified"), err)
+		groupLoanRepo.AssertNotCalled(t, "BeginTransaction", mock.Anything)
+	})
+
+	t.Run("borrower not a member", func(t *testing.T) {
+		groupRepo := new(borrowerGroupMock.MockIGroupRepository)
+		memberRepo := new(borrowerGroupMock.MockIMemberRepository)
+		groupLoanRepo := new(borrowerGroupMock.MockIGroupLoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		loanUsecase := new(loanMock.MockILoanUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		groupRepo.On("GetByID", mock.Anything, groupID).Return(groupData, nil)
+		memberRepo.On("GetByGroupID", mock.Anything, groupID).Return(members[:1], nil)
+
+		uc := usecase.NewBorrowerGroupUsecase(groupRepo, memberRepo, groupLoanRepo, borrowerRepo, loanRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, loanUsecase, loanBus)
+		res, err := uc.CreateGroupLoan(ctx, groupID, employeeID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("borrowers are not members of the group", borrowers[1].ID.String()), err)
+		groupLoanRepo.AssertNotCalled(t, "GetByGroupID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestApproveGroupLoan(t *testing.T) {
+	ctx := context.Background()
+	employeeID := uuid.New()
+	groupLoanID := uuid.New()
+	groupLoanData := borrowergroup.GroupLoan{BaseModel: model.BaseModel{ID: groupLoanID}}
+	loans := []loan.Loan{
+		{BaseModel: model.BaseModel{ID: uuid.New()}, GroupLoanID: &groupLoanID, PrincipalAmount: 3_000_000, State: loan.StateProposed},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, GroupLoanID: &groupLoanID, PrincipalAmount: 2_000_000, State: loan.StateProposed},
+	}
+	req := loan.ApproveLoanRequest{
+		ValidatorEmployeeID:  employeeID,
+		VisitProofPictureURL: "https://example.com/visit.jpg",
+	}
+
+	t.Run("approved", func(t *testing.T) {
+		groupRepo := new(borrowerGroupMock.MockIGroupRepository)
+		memberRepo := new(borrowerGroupMock.MockIMemberRepository)
+		groupLoanRepo := new(borrowerGroupMock.MockIGroupLoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		loanUsecase := new(loanMock.MockILoanUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		approvedLoans := []loan.Loan{loans[0], loans[1]}
+		approvedLoans[0].State = loan.StateApproved
+		approvedLoans[1].State = loan.StateApproved
+		groupLoanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		groupLoanRepo.On("GetByIDLockTx", mock.Anything, groupLoanID, mock.Anything).Return(groupLoanData, nil)
+		loanRepo.On("GetByGroupLoanIDLockTx", mock.Anything, groupLoanID, mock.Anything).Return(loans, nil)
+		loanUsecase.On("ApproveLoansWithTx", mock.Anything, loans, employeeID, req, mock.Anything).Return(approvedLoans, true, nil)
+		groupLoanRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+		loanBus.On("Publish", "loan.approved", loan.LoanApprovedEvent{LoanID: loans[0].ID})
+		loanBus.On("Publish", "loan.approved", loan.LoanApprovedEvent{LoanID: loans[1].ID})
+
+		uc := usecase.NewBorrowerGroupUsecase(groupRepo, memberRepo, groupLoanRepo, borrowerRepo, loanRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, loanUsecase, loanBus)
+		res, err := uc.ApproveGroupLoan(ctx, groupLoanID, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, loan.StateApproved, res.State)
+		groupLoanRepo.AssertExpectations(t)
+		loanUsecase.AssertExpectations(t)
+		loanBus.AssertExpectations(t)
+	})
+
+	t.Run("waiting for more approvals", func(t *testing.T) {
+		groupRepo := new(borrowerGroupMock.MockIGroupRepository)
+		memberRepo := new(borrowerGroupMock.MockIMemberRepository)
+		groupLoanRepo := new(borrowerGroupMock.MockIGroupLoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		loanUsecase := new(loanMock.MockILoanUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		groupLoanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		groupLoanRepo.On("GetByIDLockTx", mock.Anything, groupLoanID, mock.Anything).Return(groupLoanData, nil)
+		loanRepo.On("GetByGroupLoanIDLockTx", mock.Anything, groupLoanID, mock.Anything).Return(loans, nil)
+		loanUsecase.On("ApproveLoansWithTx", mock.Anything, loans, employeeID, req, mock.Anything).Return(loans, false, nil)
+		groupLoanRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewBorrowerGroupUsecase(groupRepo, memberRepo, groupLoanRepo, borrowerRepo, loanRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, loanUsecase, loanBus)
+		res, err := uc.ApproveGroupLoan(ctx, groupLoanID, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, loan.StateProposed, res.State)
+		loanBus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("group loan not found", func(t *testing.T) {
+		groupRepo := new(borrowerGroupMock.MockIGroupRepository)
+		memberRepo := new(borrowerGroupMock.MockIMemberRepository)
+		groupLoanRepo := new(borrowerGroupMock.MockIGroupLoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		loanUsecase := new(loanMock.MockILoanUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		groupLoanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		groupLoanRepo.On("GetByIDLockTx", mock.Anything, groupLoanID, mock.Anything).Return(borrowergroup.GroupLoan{}, nil)
+		groupLoanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewBorrowerGroupUsecase(groupRepo, memberRepo, groupLoanRepo, borrowerRepo, loanRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, loanUsecase, loanBus)
+		res, err := uc.ApproveGroupLoan(ctx, groupLoanID, employeeID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("group loan not found"), err)
+		groupLoanRepo.AssertExpectations(t)
+	})
+}
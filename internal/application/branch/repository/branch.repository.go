@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var branchTracerName = "BranchRepository"
+var branchTracer = otel.Tracer(branchTracerName)
+
+type branchRepo struct {
+	repository.BaseRepo[branch.Branch]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewBranchRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) branch.IBranchRepository {
+	baseRepo := repository.NewBaseRepo[branch.Branch](dbMaster, dbSlave)
+
+	return &branchRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *branchRepo) GetByEmployeeID(ctx context.Context, employeeID uuid.UUID) (branches []branch.Branch, err error) {
+	ctx, span := branchTracer.Start(ctx, branchTracerName+".GetByEmployeeID")
+	defer span.End()
+
+	var branchModel branch.Branch
+	var employeeBranchModel branch.EmployeeBranch
+
+	builder := sq.
+		Select("b.*").
+		From(branchModel.TableName() + " b").
+		Join(employeeBranchModel.TableName() + " eb ON eb.branch_id = b.id").
+		Where(sq.Eq{
+			"eb.employee_id": employeeID,
+			"eb.deleted_at":  nil,
+			"b.deleted_at":   nil,
+		}).
+		OrderBy("b.code ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&branches).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
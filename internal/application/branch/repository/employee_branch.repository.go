@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var employeeBranchTracerName = "EmployeeBranchRepository"
+var employeeBranchTracer = otel.Tracer(employeeBranchTracerName)
+
+type employeeBranchRepo struct {
+	repository.BaseRepo[branch.EmployeeBranch]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewEmployeeBranchRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) branch.IEmployeeBranchRepository {
+	baseRepo := repository.NewBaseRepo[branch.EmployeeBranch](dbMaster, dbSlave)
+
+	return &employeeBranchRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *employeeBranchRepo) DeleteByEmployeeIDWithTx(ctx context.Context, employeeID uuid.UUID, trx *gorm.DB) error {
+	ctx, span := employeeBranchTracer.Start(ctx, employeeBranchTracerName+".DeleteByEmployeeIDWithTx")
+	defer span.End()
+
+	var model branch.EmployeeBranch
+
+	return trx.WithContext(ctx).Model(&model).Where("employee_id = ? AND deleted_at IS NULL", employeeID).Update("deleted_at", time.Now()).Error
+}
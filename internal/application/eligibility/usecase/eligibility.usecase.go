@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/eligibility"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracerName = "EligibilityUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type eligibilityUsecase struct {
+	engine          eligibility.Engine
+	loanRepo        loan.ILoanRepository
+	installmentRepo installment.IInstallmentRepository
+	blacklistRepo   borrower.IBlacklistRepository
+}
+
+func NewEligibilityUsecase(engine eligibility.Engine, loanRepo loan.ILoanRepository, installmentRepo installment.IInstallmentRepository, blacklistRepo borrower.IBlacklistRepository) eligibility.IEligibilityUsecase {
+	return &eligibilityUsecase{
+		engine:          engine,
+		loanRepo:        loanRepo,
+		installmentRepo: installmentRepo,
+		blacklistRepo:   blacklistRepo,
+	}
+}
+
+// Evaluate gathers the borrower's loans, their repayment and rejection history and blacklist entries and runs the rules against them
+func (u *eligibilityUsecase) Evaluate(ctx context.Context, b borrower.Borrower, principalAmount money.Money) (loan.EligibilityChecks, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".Evaluate")
+	defer span.End()
+
+	facts := eligibility.Facts{
+		Borrower:        b,
+		PrincipalAmount: principalAmount,
+		Now:             time.Now(),
+	}
+
+	var err error
+	// The caller found the borrower within its scope, and the whole history counts whichever branch lent it
+	facts.Loans, err = u.loanRepo.GetByBorrowerID(branch.WithScope(ctx, branch.AllBranches()), b.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(facts.Loans) > 0 {
+		loanIDs := make([]uuid.UUID, 0, len(facts.Loans))
+		for _, l := range facts.Loans {
+			loanIDs = append(loanIDs, l.ID)
+		}
+
+		facts.Installments, err = u.installmentRepo.GetByLoanIDs(ctx, loanIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rejectedLoanIDs := []uuid.UUID{}
+	for _, l := range facts.Loans {
+		if l.State == loan.StateRejected {
+			rejectedLoanIDs = append(rejectedLoanIDs, l.ID)
+		}
+	}
+
+	if len(rejectedLoanIDs) > 0 {
+		facts.Rejections, err = u.loanRepo.GetStateHistoryByLoanIDs(ctx, rejectedLoanIDs, loan.StateRejected)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	facts.Blacklist, err = u.blacklistRepo.GetActiveByBorrowerID(ctx, b.ID, facts.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.engine.Evaluate(facts), nil
+}
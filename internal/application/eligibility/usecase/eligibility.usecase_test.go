@@ -0,0 +1,113 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/application/eligibility/usecase"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	borrowerMock "github.com/BagusAK95/amarta_test/internal/domain/borrower/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/eligibility"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	installmentMock "github.com/BagusAK95/amarta_test/internal/domain/installment/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	loanMock "github.com/BagusAK95/amarta_test/internal/domain/loan/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEvaluate(t *testing.T) {
+	ctx := context.Background()
+	borrowerData := borrower.Borrower{
+		BaseModel: model.BaseModel{ID: uuid.New()},
+		Status:    borrower.StatusActive,
+		KYCStatus: borrower.KYCStatusVerified,
+	}
+	engine := eligibility.NewEngine(
+		eligibility.BorrowerStatusRule{},
+		eligibility.BlacklistRule{},
+		eligibility.MaxActiveLoansRule{Max: 2},
+		eligibility.CreditLimitRule{BaseLimit: 10_000_000, Step: 5_000_000},
+	)
+
+	t.Run("eligible", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+
+		disbursed := loan.Loan{BaseModel: model.BaseModel{ID: uuid.New()}, BorrowerID: borrowerData.ID, PrincipalAmount: 6_000_000, State: loan.StateDisbursed}
+		loanRepo.On("GetByBorrowerID", mock.Anything, borrowerData.ID).Return([]loan.Loan{disbursed}, nil)
+		installmentRepo.On("GetByLoanIDs", mock.Anything, []uuid.UUID{disbursed.ID}).Return([]installment.Installment{
+			{LoanID: disbursed.ID, DueDate: time.Now().AddDate(0, 1, 0), PrincipalAmount: 6_000_000, PaidPrincipal: 2_000_000, Status: installment.StatusPartial},
+		}, nil)
+		blacklistRepo.On("GetActiveByBorrowerID", mock.Anything, borrowerData.ID, mock.Anything).Return([]borrower.BlacklistEntry{}, nil)
+
+		uc := usecase.NewEligibilityUsecase(engine, loanRepo, installmentRepo, blacklistRepo)
+		checks, err := uc.Evaluate(ctx, borrowerData, 6_000_000)
+
+		assert.NoError(t, err)
+		assert.True(t, checks.Passed())
+		assert.Len(t, checks, 4)
+		assert.Equal(t, "outstanding 4000000 plus requested 6000000 is within the credit limit of 10000000 (0 paid-off loans, 0 late installments)", checks[3].Reason)
+		loanRepo.AssertExpectations(t)
+		installmentRepo.AssertExpectations(t)
+	})
+
+	t.Run("cool-down starts from the recorded rejection", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+
+		updatedAt := time.Now()
+		rejectedAt := time.Now().AddDate(0, 0, -40)
+		rejected := loan.Loan{BaseModel: model.BaseModel{ID: uuid.New(), UpdatedAt: &updatedAt}, BorrowerID: borrowerData.ID, PrincipalAmount: 1_000_000, State: loan.StateRejected}
+		loanRepo.On("GetByBorrowerID", mock.Anything, borrowerData.ID).Return([]loan.Loan{rejected}, nil)
+		installmentRepo.On("GetByLoanIDs", mock.Anything, []uuid.UUID{rejected.ID}).Return([]installment.Installment{}, nil)
+		loanRepo.On("GetStateHistoryByLoanIDs", mock.Anything, []uuid.UUID{rejected.ID}, loan.StateRejected).Return([]loan.StateHistory{
+			{BaseModel: model.BaseModel{ID: uuid.New(), CreatedAt: &rejectedAt}, LoanID: rejected.ID, FromState: loan.StateProposed, ToState: loan.StateRejected},
+		}, nil)
+		blacklistRepo.On("GetActiveByBorrowerID", mock.Anything, borrowerData.ID, mock.Anything).Return([]borrower.BlacklistEntry{}, nil)
+
+		uc := usecase.NewEligibilityUsecase(eligibility.NewEngine(eligibility.RejectionCooldownRule{Period: 30 * 24 * time.Hour}), loanRepo, installmentRepo, blacklistRepo)
+		checks, err := uc.Evaluate(ctx, borrowerData, 1_000_000)
+
+		assert.NoError(t, err)
+		assert.True(t, checks.Passed())
+		loanRepo.AssertExpectations(t)
+	})
+
+	t.Run("blacklisted first-time borrower", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+
+		loanRepo.On("GetByBorrowerID", mock.Anything, borrowerData.ID).Return([]loan.Loan{}, nil)
+		blacklistRepo.On("GetActiveByBorrowerID", mock.Anything, borrowerData.ID, mock.Anything).Return([]borrower.BlacklistEntry{
+			{BorrowerID: borrowerData.ID, Reason: "fraudulent documents"},
+		}, nil)
+
+		uc := usecase.NewEligibilityUsecase(engine, loanRepo, installmentRepo, blacklistRepo)
+		checks, err := uc.Evaluate(ctx, borrowerData, 1_000_000)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"blacklist: borrower is blacklisted: fraudulent documents"}, checks.Failures())
+		installmentRepo.AssertNotCalled(t, "GetByLoanIDs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("get loans error", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		blacklistRepo := new(borrowerMock.MockIBlacklistRepository)
+
+		loanRepo.On("GetByBorrowerID", mock.Anything, borrowerData.ID).Return(nil, assert.AnError)
+
+		uc := usecase.NewEligibilityUsecase(engine, loanRepo, installmentRepo, blacklistRepo)
+		checks, err := uc.Evaluate(ctx, borrowerData, 1_000_000)
+
+		assert.Error(t, err)
+		assert.Nil(t, checks)
+	})
+}
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/employee"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/BagusAK95/amarta_test/internal/utils/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type employeeHandler struct {
+	usecase   employee.IEmployeeUsecase
+	validator *validator.CustomValidator
+}
+
+func NewEmployeeHandler(usecase employee.IEmployeeUsecase) *employeeHandler {
+	return &employeeHandler{
+		usecase:   usecase,
+		validator: validator.NewValidator(),
+	}
+}
+
+func (h *employeeHandler) ListRole(c *gin.Context) {
+	res, err := h.usecase.ListRole(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *employeeHandler) DetailEmployee(c *gin.Context) {
+	employeeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	res, err := h.usecase.DetailEmployee(c.Request.Context(), employeeID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *employeeHandler) AssignRoles(c *gin.Context) {
+	employeeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body employee.AssignRolesRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	res, err := h.usecase.AssignRoles(c.Request.Context(), employeeID, body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *employeeHandler) ListBranch(c *gin.Context) {
+	res, err := h.usecase.ListBranch(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *employeeHandler) AssignBranches(c *gin.Context) {
+	employeeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body employee.AssignBranchesRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	res, err := h.usecase.AssignBranches(c.Request.Context(), employeeID, body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -1,11 +1,19 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
 	"github.com/BagusAK95/amarta_test/internal/domain/employee"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
 	"gorm.io/gorm"
 )
 
+var employeeTracerName = "EmployeeRepository"
+var employeeTracer = otel.Tracer(employeeTracerName)
+
 type employeeRepo struct {
 	repository.BaseRepo[employee.Employee]
 	writeConn *gorm.DB
@@ -21,3 +29,65 @@ func NewEmployeeRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) employee.IEmployeeRepo
 		readConn:  dbSlave,
 	}
 }
+
+func (r *employeeRepo) GetByEmail(ctx context.Context, email string) (employeeData employee.Employee, err error) {
+	ctx, span := employeeTracer.Start(ctx, employeeTracerName+".GetByEmail")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(employeeData.TableName()).
+		Where(sq.Eq{
+			"email":      email,
+			"deleted_at": nil,
+		}).
+		Limit(1)
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&employeeData).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (r *employeeRepo) GetByIDWithRoles(ctx context.Context, ID uuid.UUID) (employeeData employee.Employee, err error) {
+	ctx, span := employeeTracer.Start(ctx, employeeTracerName+".GetByIDWithRoles")
+	defer span.End()
+
+	employeeData, err = r.GetByID(ctx, ID)
+	if err != nil || employeeData.ID == uuid.Nil {
+		return
+	}
+
+	var roleModel employee.Role
+	var employeeRoleModel employee.EmployeeRole
+
+	builder := sq.
+		Select("r.*").
+		From(roleModel.TableName() + " r").
+		Join(employeeRoleModel.TableName() + " er ON er.role_id = r.id").
+		Where(sq.Eq{
+			"er.employee_id": ID,
+			"er.deleted_at":  nil,
+			"r.deleted_at":   nil,
+		}).
+		OrderBy("r.name ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&employeeData.Roles).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
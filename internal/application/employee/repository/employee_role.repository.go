@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/employee"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var employeeRoleTracerName = "EmployeeRoleRepository"
+var employeeRoleTracer = otel.Tracer(employeeRoleTracerName)
+
+type employeeRoleRepo struct {
+	repository.BaseRepo[employee.EmployeeRole]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewEmployeeRoleRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) employee.IEmployeeRoleRepository {
+	baseRepo := repository.NewBaseRepo[employee.EmployeeRole](dbMaster, dbSlave)
+
+	return &employeeRoleRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *employeeRoleRepo) DeleteByEmployeeIDWithTx(ctx context.Context, employeeID uuid.UUID, trx *gorm.DB) error {
+	ctx, span := employeeRoleTracer.Start(ctx, employeeRoleTracerName+".DeleteByEmployeeIDWithTx")
+	defer span.End()
+
+	var model employee.EmployeeRole
+
+	return trx.WithContext(ctx).Model(&model).Where("employee_id = ? AND deleted_at IS NULL", employeeID).Update("deleted_at", time.Now()).Error
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/employee"
+	sq "github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var roleTracerName = "RoleRepository"
+var roleTracer = otel.Tracer(roleTracerName)
+
+type roleRepo struct {
+	repository.BaseRepo[employee.Role]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewRoleRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) employee.IRoleRepository {
+	baseRepo := repository.NewBaseRepo[employee.Role](dbMaster, dbSlave)
+
+	return &roleRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *roleRepo) GetByNames(ctx context.Context, names []string) (roles []employee.Role, err error) {
+	ctx, span := roleTracer.Start(ctx, roleTracerName+".GetByNames")
+	defer span.End()
+
+	var model employee.Role
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"name":       names,
+			"deleted_at": nil,
+		}).
+		OrderBy("name ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&roles).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/employee"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracerName = "EmployeeUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type employeeUsecase struct {
+	employeeRepo       employee.IEmployeeRepository
+	roleRepo           employee.IRoleRepository
+	employeeRoleRepo   employee.IEmployeeRoleRepository
+	branchRepo         branch.IBranchRepository
+	employeeBranchRepo branch.IEmployeeBranchRepository
+}
+
+func NewEmployeeUsecase(employeeRepo employee.IEmployeeRepository, roleRepo employee.IRoleRepository, employeeRoleRepo employee.IEmployeeRoleRepository, branchRepo branch.IBranchRepository, employeeBranchRepo branch.IEmployeeBranchRepository) employee.IEmployeeUsecase {
+	return &employeeUsecase{
+		employeeRepo:       employeeRepo,
+		roleRepo:           roleRepo,
+		employeeRoleRepo:   employeeRoleRepo,
+		branchRepo:         branchRepo,
+		employeeBranchRepo: employeeBranchRepo,
+	}
+}
+
+func (u *employeeUsecase) ListRole(ctx context.Context) ([]employee.Role, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ListRole")
+	defer span.End()
+
+	roles, err := u.roleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (u *employeeUsecase) DetailEmployee(ctx context.Context, employeeID uuid.UUID) (*employee.Employee, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".DetailEmployee")
+	defer span.End()
+
+	validEmployee, err := u.employeeRepo.GetByIDWithRoles(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	} else if validEmployee.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("employee not found")
+	}
+
+	validEmployee.Branches, err = u.branchRepo.GetByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &validEmployee, nil
+}
+
+// AssignRoles replaces every role of the employee with the given ones
+func (u *employeeUsecase) AssignRoles(ctx context.Context, employeeID uuid.UUID, req employee.AssignRolesRequest) (res *employee.Employee, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".AssignRoles")
+	defer span.End()
+
+	validEmployee, err := u.employeeRepo.GetByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	} else if validEmployee.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("employee not found")
+	}
+
+	roles, err := u.roleRepo.GetByNames(ctx, req.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	unknownRoles := []string{}
+	for _, name := range req.Roles {
+		if !slices.ContainsFunc(roles, func(role employee.Role) bool { return role.Name == name }) {
+			unknownRoles = append(unknownRoles, name)
+		}
+	}
+
+	if len(unknownRoles) > 0 {
+		return nil, httpError.NewBadRequestError("unknown roles", unknownRoles...)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.employeeRoleRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.employeeRoleRepo.Rollback(trx)
+			return
+		}
+
+		u.employeeRoleRepo.Commit(trx)
+	}()
+
+	err = u.employeeRoleRepo.DeleteByEmployeeIDWithTx(ctx, employeeID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	employeeRoles := make([]employee.EmployeeRole, 0, len(roles))
+	for _, role := range roles {
+		employeeRoles = append(employeeRoles, employee.EmployeeRole{
+			EmployeeID: employeeID,
+			RoleID:     role.ID,
+		})
+	}
+
+	err = u.employeeRoleRepo.CreateBulkWithTx(ctx, employeeRoles, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	validEmployee.Roles = roles
+
+	return &validEmployee, nil
+}
+
+func (u *employeeUsecase) ListBranch(ctx context.Context) ([]branch.Branch, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ListBranch")
+	defer span.End()
+
+	branches, err := u.branchRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return branches, nil
+}
+
+// AssignBranches replaces every branch of the employee with the given ones
+func (u *employeeUsecase) AssignBranches(ctx context.Context, employeeID uuid.UUID, req employee.AssignBranchesRequest) (res *employee.Employee, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".AssignBranches")
+	defer span.End()
+
+	validEmployee, err := u.employeeRepo.GetByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	} else if validEmployee.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("employee not found")
+	}
+
+	branches, err := u.branchRepo.GetByIDs(ctx, req.BranchIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	unknownBranches := []string{}
+	for _, branchID := range req.BranchIDs {
+		if !slices.ContainsFunc(branches, func(b branch.Branch) bool { return b.ID == branchID }) {
+			unknownBranches = append(unknownBranches, branchID.String())
+		}
+	}
+
+	if len(unknownBranches) > 0 {
+		return nil, httpError.NewBadRequestError("unknown branches", unknownBranches...)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.employeeBranchRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.employeeBranchRepo.Rollback(trx)
+			return
+		}
+
+		u.employeeBranchRepo.Commit(trx)
+	}()
+
+	err = u.employeeBranchRepo.DeleteByEmployeeIDWithTx(ctx, employeeID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	employeeBranches := make([]branch.EmployeeBranch, 0, len(branches))
+	for _, b := range branches {
+		employeeBranches = append(employeeBranches, branch.EmployeeBranch{
+			EmployeeID: employeeID,
+			BranchID:   b.ID,
+		})
+	}
+
+	err = u.employeeBranchRepo.CreateBulkWithTx(ctx, employeeBranches, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	validEmployee.Branches = branches
+
+	return &validEmployee, nil
+}
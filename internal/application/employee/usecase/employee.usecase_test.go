@@ -0,0 +1,159 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BagusAK95/amarta_test/internal/application/employee/usecase"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	branchMock "github.com/BagusAK95/amarta_test/internal/domain/branch/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/employee"
+	employeeMock "github.com/BagusAK95/amarta_test/internal/domain/employee/mock"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestAssignRoles(t *testing.T) {
+	ctx := context.Background()
+	employeeID := uuid.New()
+	employeeData := employee.Employee{
+		BaseModel: model.BaseModel{ID: employeeID},
+		FullName:  "Bob",
+	}
+	roles := []employee.Role{
+		{BaseModel: model.BaseModel{ID: uuid.New()}, Name: "field_agent", Permissions: []string{string(employee.PermissionLoanCreate)}},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, Name: "approver", Permissions: []string{string(employee.PermissionLoanApprove)}},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		roleRepo := new(employeeMock.MockIRoleRepository)
+		employeeRoleRepo := new(employeeMock.MockIEmployeeRoleRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		employeeBranchRepo := new(branchMock.MockIEmployeeBranchRepository)
+
+		req := employee.AssignRolesRequest{Roles: []string{"field_agent", "approver"}}
+		employeeRepo.On("GetByID", mock.Anything, employeeID).Return(employeeData, nil)
+		roleRepo.On("GetByNames", mock.Anything, req.Roles).Return(roles, nil)
+		employeeRoleRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		employeeRoleRepo.On("DeleteByEmployeeIDWithTx", mock.Anything, employeeID, mock.Anything).Return(nil)
+		employeeRoleRepo.On("CreateBulkWithTx", mock.Anything, []employee.EmployeeRole{
+			{EmployeeID: employeeID, RoleID: roles[0].ID},
+			{EmployeeID: employeeID, RoleID: roles[1].ID},
+		}, mock.Anything).Return(nil)
+		employeeRoleRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewEmployeeUsecase(employeeRepo, roleRepo, employeeRoleRepo, branchRepo, employeeBranchRepo)
+		res, err := uc.AssignRoles(ctx, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, roles, res.Roles)
+		assert.True(t, res.HasPermission(employee.PermissionLoanApprove))
+		employeeRepo.AssertExpectations(t)
+		roleRepo.AssertExpectations(t)
+		employeeRoleRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown roles", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		roleRepo := new(employeeMock.MockIRoleRepository)
+		employeeRoleRepo := new(employeeMock.MockIEmployeeRoleRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		employeeBranchRepo := new(branchMock.MockIEmployeeBranchRepository)
+
+		req := employee.AssignRolesRequest{Roles: []string{"approver", "auditor"}}
+		employeeRepo.On("GetByID", mock.Anything, employeeID).Return(employeeData, nil)
+		roleRepo.On("GetByNames", mock.Anything, req.Roles).Return(roles[1:], nil)
+
+		uc := usecase.NewEmployeeUsecase(employeeRepo, roleRepo, employeeRoleRepo, branchRepo, employeeBranchRepo)
+		res, err := uc.AssignRoles(ctx, employeeID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("unknown roles", "auditor"), err)
+		employeeRoleRepo.AssertNotCalled(t, "BeginTransaction", mock.Anything)
+	})
+
+	t.Run("employee not found", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		roleRepo := new(employeeMock.MockIRoleRepository)
+		employeeRoleRepo := new(employeeMock.MockIEmployeeRoleRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		employeeBranchRepo := new(branchMock.MockIEmployeeBranchRepository)
+
+		employeeRepo.On("GetByID", mock.Anything, employeeID).Return(employee.Employee{}, nil)
+
+		uc := usecase.NewEmployeeUsecase(employeeRepo, roleRepo, employeeRoleRepo, branchRepo, employeeBranchRepo)
+		res, err := uc.AssignRoles(ctx, employeeID, employee.AssignRolesRequest{Roles: []string{"approver"}})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("employee not found"), err)
+		roleRepo.AssertNotCalled(t, "GetByNames", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAssignBranches(t *testing.T) {
+	ctx := context.Background()
+	employeeID := uuid.New()
+	employeeData := employee.Employee{
+		BaseModel: model.BaseModel{ID: employeeID},
+		FullName:  "Bob",
+	}
+	branches := []branch.Branch{
+		{BaseModel: model.BaseModel{ID: uuid.New()}, Code: "JKS"},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, Code: "BGR"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		roleRepo := new(employeeMock.MockIRoleRepository)
+		employeeRoleRepo := new(employeeMock.MockIEmployeeRoleRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		employeeBranchRepo := new(branchMock.MockIEmployeeBranchRepository)
+
+		req := employee.AssignBranchesRequest{BranchIDs: []uuid.UUID{branches[0].ID, branches[1].ID}}
+		employeeRepo.On("GetByID", mock.Anything, employeeID).Return(employeeData, nil)
+		branchRepo.On("GetByIDs", mock.Anything, req.BranchIDs).Return(branches, nil)
+		employeeBranchRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		employeeBranchRepo.On("DeleteByEmployeeIDWithTx", mock.Anything, employeeID, mock.Anything).Return(nil)
+		employeeBranchRepo.On("CreateBulkWithTx", mock.Anything, []branch.EmployeeBranch{
+			{EmployeeID: employeeID, BranchID: branches[0].ID},
+			{EmployeeID: employeeID, BranchID: branches[1].ID},
+		}, mock.Anything).Return(nil)
+		employeeBranchRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewEmployeeUsecase(employeeRepo, roleRepo, employeeRoleRepo, branchRepo, employeeBranchRepo)
+		res, err := uc.AssignBranches(ctx, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, branches, res.Branches)
+		branchRepo.AssertExpectations(t)
+		employeeBranchRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown branches", func(t *testing.T) {
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		roleRepo := new(employeeMock.MockIRoleRepository)
+		employeeRoleRepo := new(employeeMock.MockIEmployeeRoleRepository)
+		branchRepo := new(branchMock.MockIBranchRepository)
+		employeeBranchRepo := new(branchMock.MockIEmployeeBranchRepository)
+
+		unknownID := uuid.New()
+		req := employee.AssignBranchesRequest{BranchIDs: []uuid.UUID{branches[0].ID, unknownID}}
+		employeeRepo.On("GetByID", mock.Anything, employeeID).Return(employeeData, nil)
+		branchRepo.On("GetByIDs", mock.Anything, req.BranchIDs).Return(branches[:1], nil)
+
+		uc := usecase.NewEmployeeUsecase(employeeRepo, roleRepo, employeeRoleRepo, branchRepo, employeeBranchRepo)
+		res, err := uc.AssignBranches(ctx, employeeID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("unknown branches", unknownID.String()), err)
+		employeeBranchRepo.AssertNotCalled(t, "BeginTransaction", mock.Anything)
+	})
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var tracerName = "InstallmentRepository"
+var tracer = otel.Tracer(tracerName)
+
+type installmentRepo struct {
+	repository.BaseRepo[installment.Installment]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewInstallmentRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) installment.IInstallmentRepository {
+	baseRepo := repository.NewBaseRepo[installment.Installment](dbMaster, dbSlave)
+
+	return &installmentRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *installmentRepo) GetByLoanID(ctx context.Context, loanID uuid.UUID) (installments []installment.Installment, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByLoanID")
+	defer span.End()
+
+	var model installment.Installment
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"loan_id":    loanID,
+			"deleted_at": nil,
+		}).
+		OrderBy("sequence ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&installments).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (r *installmentRepo) GetUnpaidByLoanIDLockTx(ctx context.Context, loanID uuid.UUID, trx *gorm.DB) (installments []installment.Installment, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetUnpaidByLoanIDLockTx")
+	defer span.End()
+
+	var model installment.Installment
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"loan_id":    loanID,
+			"deleted_at": nil,
+		}).
+		Where(sq.NotEq{"status": installment.StatusPaid}).
+		OrderBy("sequence ASC").
+		Suffix("FOR UPDATE")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Raw(qry, args...).Scan(&installments).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (r *installmentRepo) GetByLoanIDs(ctx context.Context, loanIDs []uuid.UUID) (installments []installment.Installment, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByLoanIDs")
+	defer span.End()
+
+	var model installment.Installment
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"loan_id":    loanIDs,
+			"deleted_at": nil,
+		}).
+		OrderBy("loan_id ASC", "sequence ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&installments).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetOverdueByLoanIDs returns the installments of the loans that are not fully paid and were due before asOf
+func (r *installmentRepo) GetOverdueByLoanIDs(ctx context.Context, loanIDs []uuid.UUID, asOf time.Time) (installments []installment.Installment, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetOverdueByLoanIDs")
+	defer span.End()
+
+	var model installment.Installment
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"loan_id":    loanIDs,
+			"deleted_at": nil,
+		}).
+		Where(sq.NotEq{"status": installment.StatusPaid}).
+		Where(sq.Lt{"due_date": asOf}).
+		OrderBy("due_date ASC", "sequence ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&installments).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/BagusAK95/amarta_test/internal/domain/investment"
 	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
@@ -46,6 +47,76 @@ func (h *investmentHandler) AddInvestment(c *gin.Context) {
 	c.JSON(http.StatusCreated, res)
 }
 
+func (h *investmentHandler) CancelInvestment(c *gin.Context) {
+	investmentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.CancelInvestment(c.Request.Context(), investorID.(uuid.UUID), investmentID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *investmentHandler) ListInvestment(c *gin.Context) {
+	var page int = 1
+	if pageStr := c.Query("page"); pageStr != "" {
+		page, _ = strconv.Atoi(pageStr)
+	}
+
+	var limit int = 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, _ = strconv.Atoi(limitStr)
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.ListInvestment(c.Request.Context(), investorID.(uuid.UUID), page, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *investmentHandler) DetailInvestment(c *gin.Context) {
+	investmentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.DetailInvestment(c.Request.Context(), investorID.(uuid.UUID), investmentID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *investmentHandler) GetPortfolioSummary(c *gin.Context) {
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.GetPortfolioSummary(c.Request.Context(), investorID.(uuid.UUID))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
 func (h *investmentHandler) GetInvestmentAgreementFile(c *gin.Context) {
 	investmentID, err := uuid.Parse(c.Param("investment_id"))
 	if err != nil {
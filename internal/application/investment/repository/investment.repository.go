@@ -2,9 +2,13 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
 	"github.com/BagusAK95/amarta_test/internal/domain/investment"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/repayment"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
@@ -30,7 +34,7 @@ func NewInvestmentRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) investment.IInvestme
 	}
 }
 
-func (r *investmentRepo) GetTotalInvestmentByLoanID(ctx context.Context, loanID uuid.UUID) (total float64, err error) {
+func (r *investmentRepo) GetTotalInvestmentByLoanID(ctx context.Context, loanID uuid.UUID) (total money.Money, err error) {
 	ctx, span := tracer.Start(ctx, tracerName+".GetTotalInvestmentByLoanID")
 	defer span.End()
 
@@ -56,3 +60,159 @@ func (r *investmentRepo) GetTotalInvestmentByLoanID(ctx context.Context, loanID
 
 	return
 }
+
+// GetTotalInvestmentByLoanIDs sums the investments of each loan, loans without investments are left out
+func (r *investmentRepo) GetTotalInvestmentByLoanIDs(ctx context.Context, loanIDs []uuid.UUID) (totals map[uuid.UUID]money.Money, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetTotalInvestmentByLoanIDs")
+	defer span.End()
+
+	var model investment.Investment
+	var rows []struct {
+		LoanID uuid.UUID
+		Total  money.Money
+	}
+
+	builder := sq.
+		Select(
+			"loan_id",
+			"COALESCE(SUM(amount), 0) AS total",
+		).
+		From(model.TableName()).
+		Where(sq.Eq{
+			"loan_id":    loanIDs,
+			"deleted_at": nil,
+		}).
+		GroupBy("loan_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&rows).Error
+	if err != nil {
+		return
+	}
+
+	totals = make(map[uuid.UUID]money.Money, len(rows))
+	for _, row := range rows {
+		totals[row.LoanID] = row.Total
+	}
+
+	return
+}
+
+func (r *investmentRepo) GetByLoanIDLockTx(ctx context.Context, loanID uuid.UUID, trx *gorm.DB) (investments []investment.Investment, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByLoanIDLockTx")
+	defer span.End()
+
+	var model investment.Investment
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"loan_id":    loanID,
+			"deleted_at": nil,
+		}).
+		OrderBy("id ASC").
+		Suffix("FOR UPDATE")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Raw(qry, args...).Scan(&investments).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (r *investmentRepo) GetByInvestorID(ctx context.Context, investorID uuid.UUID) (investments []investment.Investment, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByInvestorID")
+	defer span.End()
+
+	var model investment.Investment
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"investor_id": investorID,
+			"deleted_at":  nil,
+		}).
+		OrderBy("id DESC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&investments).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (r *investmentRepo) GetExposureTx(ctx context.Context, investorID uuid.UUID, loanID uuid.UUID, borrowerID uuid.UUID, trx *gorm.DB) (exposure investment.Exposure, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetExposureTx")
+	defer span.End()
+
+	var model investment.Investment
+	var loanModel loan.Loan
+	var distributionModel repayment.Distribution
+
+	// The principal already repaid to an investment is no longer at risk, so a disbursed loan counts only what is
+	// outstanding
+	repaid := sq.
+		Select("COALESCE(SUM(principal_amount), 0) AS principal_amount").
+		From(distributionModel.TableName()).
+		Where("investment_id = i.id").
+		Where(sq.Eq{"deleted_at": nil})
+
+	builder := sq.
+		Select().
+		Column(sq.Expr("COALESCE(SUM(i.amount - d.principal_amount) FILTER (WHERE i.loan_id = ?), 0) AS loan", loanID)).
+		Column(sq.Expr("COALESCE(SUM(i.amount - d.principal_amount) FILTER (WHERE l.borrower_id = ?), 0) AS borrower", borrowerID)).
+		Column("COALESCE(SUM(i.amount - d.principal_amount), 0) AS total").
+		From(model.TableName() + " i").
+		Join(loanModel.TableName() + " l ON l.id = i.loan_id").
+		JoinClause(repaid.Prefix("LEFT JOIN LATERAL (").Suffix(") d ON true")).
+		Where(sq.Eq{
+			"i.investor_id": investorID,
+			"i.deleted_at":  nil,
+			"l.state":       []loan.State{loan.StateApproved, loan.StateInvested, loan.StateDisbursed},
+		})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Raw(qry, args...).Scan(&exposure).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// DeleteWithTx soft deletes the investment, so a cancelled investment stays referenced by its ledger entries
+func (r *investmentRepo) DeleteWithTx(ctx context.Context, ID uuid.UUID, trx *gorm.DB) error {
+	ctx, span := tracer.Start(ctx, tracerName+".DeleteWithTx")
+	defer span.End()
+
+	var model investment.Investment
+
+	err := trx.WithContext(ctx).Model(&model).Where("id = ? AND deleted_at IS NULL", ID).Update("deleted_at", time.Now()).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
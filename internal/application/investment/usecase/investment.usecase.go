@@ -2,14 +2,21 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/BagusAK95/amarta_test/internal/config"
 	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/amortization"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
 	"github.com/BagusAK95/amarta_test/internal/domain/investment"
 	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
 	"github.com/BagusAK95/amarta_test/internal/domain/loan"
 	"github.com/BagusAK95/amarta_test/internal/domain/mail"
+	"github.com/BagusAK95/amarta_test/internal/domain/repayment"
 	"github.com/BagusAK95/amarta_test/internal/infrastructure/bus"
 	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
 	"github.com/google/uuid"
@@ -21,20 +28,24 @@ var tracerName = "InvestmentUsecase"
 var tracer = otel.Tracer(tracerName)
 
 type investmentUsecase struct {
-	investmentRepo investment.IInvestmentRepository
-	investorRepo   investor.IInvestorRepository
-	loanRepo       loan.ILoanRepository
-	borrowerRepo   borrower.IBorrowerRepository
-	mailBus        bus.Bus[mail.MailSendRequest]
+	investmentRepo   investment.IInvestmentRepository
+	investorRepo     investor.IInvestorRepository
+	loanRepo         loan.ILoanRepository
+	borrowerRepo     borrower.IBorrowerRepository
+	distributionRepo repayment.IDistributionRepository
+	ledgerUsecase    ledger.ILedgerUsecase
+	mailBus          bus.Bus[mail.MailSendRequest]
 }
 
-func NewInvestmentUsecase(investmentRepo investment.IInvestmentRepository, investorRepo investor.IInvestorRepository, loanRepo loan.ILoanRepository, borrowerRepo borrower.IBorrowerRepository, mailBus bus.Bus[mail.MailSendRequest]) investment.IInvestmentUsecase {
+func NewInvestmentUsecase(investmentRepo investment.IInvestmentRepository, investorRepo investor.IInvestorRepository, loanRepo loan.ILoanRepository, borrowerRepo borrower.IBorrowerRepository, distributionRepo repayment.IDistributionRepository, ledgerUsecase ledger.ILedgerUsecase, mailBus bus.Bus[mail.MailSendRequest]) investment.IInvestmentUsecase {
 	return &investmentUsecase{
-		investmentRepo: investmentRepo,
-		investorRepo:   investorRepo,
-		loanRepo:       loanRepo,
-		borrowerRepo:   borrowerRepo,
-		mailBus:        mailBus,
+		investmentRepo:   investmentRepo,
+		investorRepo:     investorRepo,
+		loanRepo:         loanRepo,
+		borrowerRepo:     borrowerRepo,
+		distributionRepo: distributionRepo,
+		ledgerUsecase:    ledgerUsecase,
+		mailBus:          mailBus,
 	}
 }
 
@@ -83,6 +94,11 @@ func (u *investmentUsecase) AddInvestment(ctx context.Context, investorID uuid.U
 		return nil, httpError.NewBadRequestError("total investment would exceed loan principal amount")
 	}
 
+	err = u.checkConcentration(ctx, validLoan, validInvestor, req.Amount, trx)
+	if err != nil {
+		return nil, err
+	}
+
 	newInvestment, err := u.investmentRepo.CreateWithTx(ctx, investment.Investment{
 		LoanID:     req.LoanID,
 		InvestorID: investorID,
@@ -92,14 +108,20 @@ func (u *investmentUsecase) AddInvestment(ctx context.Context, investorID uuid.U
 		return nil, err
 	}
 
-	_, err = u.investorRepo.UpdateWithMapTx(ctx, investorID, map[string]any{
-		"balance": validInvestor.Balance - req.Amount,
+	_, err = u.ledgerUsecase.PostWithTx(ctx, ledger.JournalEntry{
+		ReferenceType: ledger.ReferenceInvestment,
+		ReferenceID:   newInvestment.ID,
+		Description:   "Investment in loan " + validLoan.ID.String(),
+		Postings: []ledger.Posting{
+			ledger.Debit(ledger.AccountInvestorCash, &investorID, req.Amount),
+			ledger.Credit(ledger.AccountLoanFunding, &validLoan.ID, req.Amount),
+		},
 	}, trx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = u.checkLoanInvested(ctx, validLoan, lastInvestment, trx)
+	err = u.checkLoanInvested(ctx, validLoan, investorID, lastInvestment, trx)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +147,35 @@ func (u *investmentUsecase) AddInvestment(ctx context.Context, investorID uuid.U
 	return &newInvestment, nil
 }
 
-func (u *investmentUsecase) checkLoanInvested(ctx context.Context, validLoan loan.Loan, lastInvestment float64, trx *gorm.DB) (err error) {
+// checkConcentration enforces the per-investor diversification limits. The borrower share is measured against the
+// investor's whole portfolio, their balance plus the principal outstanding in open and running loans, which investing
+// itself does not change. Every limit that would be broken is reported in the error details.
+func (u *investmentUsecase) checkConcentration(ctx context.Context, validLoan loan.Loan, validInvestor investor.Investor, amount money.Money, trx *gorm.DB) error {
+	ctx, span := tracer.Start(ctx, tracerName+".CheckConcentration")
+	defer span.End()
+
+	limits := investment.ConcentrationLimits{
+		MaxAmountPerLoan: config.INVESTMENT_MAX_AMOUNT_PER_LOAN,
+		MaxLoanShare:     config.INVESTMENT_MAX_LOAN_SHARE,
+		MaxBorrowerShare: config.INVESTMENT_MAX_BORROWER_SHARE,
+	}
+	if !limits.Enabled() {
+		return nil
+	}
+
+	exposure, err := u.investmentRepo.GetExposureTx(ctx, validInvestor.ID, validLoan.ID, validLoan.BorrowerID, trx)
+	if err != nil {
+		return err
+	}
+
+	if violations := limits.Violations(exposure, validInvestor.Balance, validLoan.PrincipalAmount, amount); len(violations) > 0 {
+		return httpError.NewBadRequestError("investment exceeds concentration limits", violations...)
+	}
+
+	return nil
+}
+
+func (u *investmentUsecase) checkLoanInvested(ctx context.Context, validLoan loan.Loan, investorID uuid.UUID, lastInvestment money.Money, trx *gorm.DB) (err error) {
 	ctx, span := tracer.Start(ctx, tracerName+".CheckLoanInvested")
 	defer span.End()
 
@@ -133,9 +183,15 @@ func (u *investmentUsecase) checkLoanInvested(ctx context.Context, validLoan loa
 		return
 	}
 
-	_, err = u.loanRepo.UpdateWithMapTx(ctx, validLoan.ID, map[string]any{
-		"state": loan.StateInvested,
-	}, trx)
+	change, err := validLoan.Transition(loan.EventFund, loan.TransitionInput{
+		Actor:    loan.InvestorActor(investorID),
+		Invested: lastInvestment,
+	})
+	if err != nil {
+		return httpError.NewBadRequestError(err.Error())
+	}
+
+	_, err = u.loanRepo.TransitionWithTx(ctx, validLoan.ID, change, nil, trx)
 	if err != nil {
 		return err
 	}
@@ -164,6 +220,239 @@ func (u *investmentUsecase) checkLoanInvested(ctx context.Context, validLoan loa
 	return nil
 }
 
+// CancelInvestment withdraws an investment while its loan is still open for funding and the cooling-off period has
+// not passed. Rows are locked in the same order as AddInvestment, loan before investor, so the two cannot deadlock.
+func (u *investmentUsecase) CancelInvestment(ctx context.Context, investorID uuid.UUID, investmentID uuid.UUID) (res *investment.Investment, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".CancelInvestment")
+	defer span.End()
+
+	inv, err := u.investmentRepo.GetByID(ctx, investmentID)
+	if err != nil {
+		return nil, err
+	} else if inv.ID == uuid.Nil || inv.InvestorID != investorID {
+		return nil, httpError.NewNotFoundError("investment not found")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.investmentRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.investmentRepo.Rollback(trx)
+			return
+		}
+
+		u.investmentRepo.Commit(trx)
+	}()
+
+	validLoan, err := u.loanRepo.GetByIDLockTx(ctx, inv.LoanID, trx)
+	if err != nil {
+		return nil, err
+	} else if validLoan.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("loan not found")
+	} else if validLoan.State != loan.StateApproved {
+		return nil, httpError.NewBadRequestError("loan is not in approved")
+	}
+
+	validInvestor, err := u.investorRepo.GetByIDLockTx(ctx, investorID, trx)
+	if err != nil {
+		return nil, err
+	} else if validInvestor.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("investor not found")
+	}
+
+	inv, err = u.investmentRepo.GetByIDLockTx(ctx, investmentID, trx)
+	if err != nil {
+		return nil, err
+	} else if inv.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("investment not found")
+	} else if inv.CreatedAt == nil || time.Since(*inv.CreatedAt) > config.INVESTMENT_COOLING_OFF_PERIOD {
+		return nil, httpError.NewBadRequestError("cooling-off period has ended")
+	}
+
+	err = u.investmentRepo.DeleteWithTx(ctx, investmentID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.ledgerUsecase.PostWithTx(ctx, ledger.JournalEntry{
+		ReferenceType: ledger.ReferenceCancellation,
+		ReferenceID:   investmentID,
+		Description:   "Cancellation of investment in loan " + validLoan.ID.String(),
+		Postings: []ledger.Posting{
+			ledger.Debit(ledger.AccountLoanFunding, &validLoan.ID, inv.Amount),
+			ledger.Credit(ledger.AccountInvestorCash, &investorID, inv.Amount),
+		},
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	mailRequest := mail.MailSendRequest{
+		To:       validInvestor.Email,
+		Subject:  "Your Investment Has Been Cancelled",
+		Template: "investment_cancelled.html",
+		Data: map[string]any{
+			"InvestmentID":     inv.ID.String(),
+			"LoanID":           validLoan.ID.String(),
+			"InvestorName":     validInvestor.FullName,
+			"InvestmentAmount": inv.Amount,
+			"AppUrl":           config.APP_URL,
+			"Year":             time.Now().Year(),
+		},
+	}
+
+	u.mailBus.Publish("mail.send", mailRequest)
+
+	return &inv, nil
+}
+
+// ExpireLoans moves approved loans whose funding window closed before approvedBefore to the expired state and refunds
+// their investments. Each loan is expired in its own transaction so one failing loan does not hold back the others.
+func (u *investmentUsecase) ExpireLoans(ctx context.Context, approvedBefore time.Time) (expired int, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ExpireLoans")
+	defer span.End()
+
+	loans, err := u.loanRepo.GetApprovedBefore(ctx, approvedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	for _, l := range loans {
+		investments, ok, expireErr := u.expireLoan(ctx, l.ID, approvedBefore)
+		if expireErr != nil {
+			errs = append(errs, fmt.Errorf("expire loan %s: %w", l.ID, expireErr))
+			continue
+		} else if !ok {
+			continue
+		}
+
+		expired++
+
+		notifyErr := u.notifyLoanExpired(ctx, l, investments)
+		if notifyErr != nil {
+			errs = append(errs, fmt.Errorf("notify expired loan %s: %w", l.ID, notifyErr))
+		}
+	}
+
+	return expired, errors.Join(errs...)
+}
+
+// expireLoan re-checks the loan under lock, marks it expired and returns every investment to the investor cash
+// account in a single journal entry. It reports false when the loan was funded or changed state in the meantime.
+func (u *investmentUsecase) expireLoan(ctx context.Context, loanID uuid.UUID, approvedBefore time.Time) (investments []investment.Investment, expired bool, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ExpireLoan")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.investmentRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.investmentRepo.Rollback(trx)
+			return
+		}
+
+		u.investmentRepo.Commit(trx)
+	}()
+
+	validLoan, err := u.loanRepo.GetByIDLockTx(ctx, loanID, trx)
+	if err != nil {
+		return nil, false, err
+	} else if validLoan.ID == uuid.Nil || validLoan.State != loan.StateApproved {
+		return nil, false, nil
+	} else if validLoan.ApprovalDetails.ApprovalDate == nil || !validLoan.ApprovalDetails.ApprovalDate.Before(approvedBefore) {
+		return nil, false, nil
+	}
+
+	change, err := validLoan.Transition(loan.EventExpire, loan.TransitionInput{
+		Actor:  loan.SystemActor(),
+		Reason: "funding window closed",
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	_, err = u.loanRepo.TransitionWithTx(ctx, loanID, change, nil, trx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	investments, err = u.investmentRepo.GetByLoanIDLockTx(ctx, loanID, trx)
+	if err != nil {
+		return nil, false, err
+	} else if len(investments) == 0 {
+		return nil, true, nil
+	}
+
+	postings := make([]ledger.Posting, 0, len(investments)*2)
+	for i := range investments {
+		postings = append(postings,
+			ledger.Debit(ledger.AccountLoanFunding, &validLoan.ID, investments[i].Amount),
+			ledger.Credit(ledger.AccountInvestorCash, &investments[i].InvestorID, investments[i].Amount),
+		)
+	}
+
+	_, err = u.ledgerUsecase.PostWithTx(ctx, ledger.JournalEntry{
+		ReferenceType: ledger.ReferenceLoanExpiry,
+		ReferenceID:   loanID,
+		Description:   "Refund of expired loan " + loanID.String(),
+		Postings:      postings,
+	}, trx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return investments, true, nil
+}
+
+// notifyLoanExpired sends one email per investor with the total refunded from the expired loan.
+func (u *investmentUsecase) notifyLoanExpired(ctx context.Context, expiredLoan loan.Loan, investments []investment.Investment) (err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".NotifyLoanExpired")
+	defer span.End()
+
+	refunds := map[uuid.UUID]money.Money{}
+	investorIDs := make([]uuid.UUID, 0, len(investments))
+	for _, inv := range investments {
+		if _, ok := refunds[inv.InvestorID]; !ok {
+			investorIDs = append(investorIDs, inv.InvestorID)
+		}
+
+		refunds[inv.InvestorID] += inv.Amount
+	}
+
+	if len(investorIDs) == 0 {
+		return nil
+	}
+
+	investors, err := u.investorRepo.GetByIDs(ctx, investorIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, inv := range investors {
+		mailRequest := mail.MailSendRequest{
+			To:       inv.Email,
+			Subject:  "Your Investment Has Been Refunded",
+			Template: "loan_expired.html",
+			Data: map[string]any{
+				"InvestorName": inv.FullName,
+				"LoanID":       expiredLoan.ID.String(),
+				"RefundAmount": refunds[inv.ID],
+				"AppUrl":       config.APP_URL,
+				"Year":         time.Now().Year(),
+			},
+		}
+
+		u.mailBus.Publish("mail.send", mailRequest)
+	}
+
+	return nil
+}
+
 func (u *investmentUsecase) GetInvestmentAgreementDetail(ctx context.Context, investmentID uuid.UUID) (*investment.InvestmentAgreementResponse, error) {
 	ctx, span := tracer.Start(ctx, tracerName+".GetInvestmentAgreementDetail")
 	defer span.End()
@@ -202,7 +491,175 @@ func (u *investmentUsecase) GetInvestmentAgreementDetail(ctx context.Context, in
 		InvestmentAmount: inv.Amount,
 		ROI:              loanData.ROI,
 		LoanID:           loanData.ID,
+		LoanTerm:         loanData.Tenor,
 		InvestorName:     investorData.FullName,
 		BorrowerName:     borrowerData.FullName,
+		RiskGrade:        loanData.RiskAssessment.RiskGrade,
+		CreditScore:      loanData.RiskAssessment.CreditScore,
 	}, nil
 }
+
+func (u *investmentUsecase) ListInvestment(ctx context.Context, investorID uuid.UUID, page int, limit int) (investment.InvestmentPagination, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ListInvestment")
+	defer span.End()
+
+	investments, err := u.investmentRepo.Pagination(ctx, map[string]any{
+		"investor_id": investorID,
+	}, page, limit)
+	if err != nil {
+		return investment.InvestmentPagination{}, err
+	}
+
+	data, err := u.toInvestmentResponses(ctx, investments.Data)
+	if err != nil {
+		return investment.InvestmentPagination{}, err
+	}
+
+	return investment.InvestmentPagination{
+		Data:    data,
+		HasNext: investments.HasNext,
+		HasPrev: investments.HasPrev,
+	}, nil
+}
+
+func (u *investmentUsecase) DetailInvestment(ctx context.Context, investorID uuid.UUID, investmentID uuid.UUID) (*investment.InvestmentResponse, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".DetailInvestment")
+	defer span.End()
+
+	inv, err := u.investmentRepo.GetByID(ctx, investmentID)
+	if err != nil {
+		return nil, err
+	} else if inv.ID == uuid.Nil || inv.InvestorID != investorID {
+		return nil, httpError.NewNotFoundError("investment not found")
+	}
+
+	data, err := u.toInvestmentResponses(ctx, []investment.Investment{inv})
+	if err != nil {
+		return nil, err
+	}
+
+	return &data[0], nil
+}
+
+func (u *investmentUsecase) GetPortfolioSummary(ctx context.Context, investorID uuid.UUID) (*investment.PortfolioSummaryResponse, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetPortfolioSummary")
+	defer span.End()
+
+	investments, err := u.investmentRepo.GetByInvestorID(ctx, investorID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := u.toInvestmentResponses(ctx, investments)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &investment.PortfolioSummaryResponse{
+		ByLoanState: []investment.PortfolioStateTotal{},
+	}
+
+	stateIndex := map[loan.State]int{}
+	for _, item := range data {
+		res.Total.Add(item)
+
+		i, ok := stateIndex[item.LoanState]
+		if !ok {
+			i = len(res.ByLoanState)
+			stateIndex[item.LoanState] = i
+			res.ByLoanState = append(res.ByLoanState, investment.PortfolioStateTotal{LoanState: item.LoanState})
+		}
+
+		res.ByLoanState[i].Add(item)
+	}
+
+	sort.Slice(res.ByLoanState, func(i, j int) bool {
+		return res.ByLoanState[i].LoanState < res.ByLoanState[j].LoanState
+	})
+
+	return res, nil
+}
+
+// toInvestmentResponses enriches investments with their loan and with what has been repaid on them so far.
+func (u *investmentUsecase) toInvestmentResponses(ctx context.Context, investments []investment.Investment) ([]investment.InvestmentResponse, error) {
+	res := make([]investment.InvestmentResponse, 0, len(investments))
+	if len(investments) == 0 {
+		return res, nil
+	}
+
+	investmentIDs := make([]uuid.UUID, 0, len(investments))
+	loanIDs := make([]uuid.UUID, 0, len(investments))
+	seenLoans := map[uuid.UUID]bool{}
+	for _, inv := range investments {
+		investmentIDs = append(investmentIDs, inv.ID)
+		if !seenLoans[inv.LoanID] {
+			seenLoans[inv.LoanID] = true
+			loanIDs = append(loanIDs, inv.LoanID)
+		}
+	}
+
+	loanList, err := u.loanRepo.GetByIDs(ctx, loanIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	loans := make(map[uuid.UUID]loan.Loan, len(loanList))
+	for _, l := range loanList {
+		loans[l.ID] = l
+	}
+
+	totalList, err := u.distributionRepo.GetTotalsByInvestmentIDs(ctx, investmentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[uuid.UUID]repayment.InvestmentTotal, len(totalList))
+	for _, total := range totalList {
+		totals[total.InvestmentID] = total
+	}
+
+	for _, inv := range investments {
+		l := loans[inv.LoanID]
+		total := totals[inv.ID]
+
+		outstanding := inv.Amount - total.PrincipalAmount
+		if l.State == loan.StateExpired {
+			// Expired loans refund every investment in full, so nothing remains at risk.
+			outstanding = 0
+		}
+
+		res = append(res, investment.InvestmentResponse{
+			ID:                  inv.ID,
+			LoanID:              inv.LoanID,
+			LoanState:           l.State,
+			Amount:              inv.Amount,
+			ROI:                 l.ROI,
+			Tenor:               l.Tenor,
+			ExpectedReturn:      expectedReturn(inv.Amount, l),
+			RepaidPrincipal:     total.PrincipalAmount,
+			ReturnReceived:      total.ReturnAmount,
+			OutstandingExposure: outstanding,
+			CreatedAt:           inv.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// expectedReturn is the return an investment earns over the whole tenor: the interest of its share of the loan
+// amortized with the loan method at the ROI, which is an annual percentage.
+func expectedReturn(amount money.Money, l loan.Loan) money.Money {
+	if l.State == loan.StateExpired || l.Tenor == 0 || amount <= 0 {
+		return 0
+	}
+
+	terms := l.AmortizationTerms()
+	terms.Principal = amount
+	terms.AnnualRate = float64(l.ROI)
+	schedule, err := amortization.New(terms)
+	if err != nil {
+		return 0
+	}
+
+	return schedule.TotalInterest()
+}
@@ -6,16 +6,24 @@ import (
 	"time"
 
 	"github.com/BagusAK95/amarta_test/internal/application/investment/usecase"
+	"github.com/BagusAK95/amarta_test/internal/config"
 	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
 	borrowerMock "github.com/BagusAK95/amarta_test/internal/domain/borrower/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/amortization"
 	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
 	"github.com/BagusAK95/amarta_test/internal/domain/investment"
 	investmentMock "github.com/BagusAK95/amarta_test/internal/domain/investment/mock"
 	"github.com/BagusAK95/amarta_test/internal/domain/investor"
 	investorMock "github.com/BagusAK95/amarta_test/internal/domain/investor/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	ledgerMock "github.com/BagusAK95/amarta_test/internal/domain/ledger/mock"
 	"github.com/BagusAK95/amarta_test/internal/domain/loan"
 	loanMock "github.com/BagusAK95/amarta_test/internal/domain/loan/mock"
 	"github.com/BagusAK95/amarta_test/internal/domain/mail"
+	"github.com/BagusAK95/amarta_test/internal/domain/repayment"
+	repaymentMock "github.com/BagusAK95/amarta_test/internal/domain/repayment/mock"
 	busMock "github.com/BagusAK95/amarta_test/internal/infrastructure/bus/mock"
 	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
 	"github.com/google/uuid"
@@ -53,18 +61,25 @@ func TestAddInvestment(t *testing.T) {
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
 		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
 		investorRepo.On("GetByIDLockTx", mock.Anything, investorID, mock.Anything).Return(investorData, nil)
-		investmentRepo.On("GetTotalInvestmentByLoanID", mock.Anything, loanID).Return(float64(0), nil)
+		investmentRepo.On("GetTotalInvestmentByLoanID", mock.Anything, loanID).Return(money.Money(0), nil)
 		investmentRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("investment.Investment"), mock.Anything).Return(investmentData, nil)
-		investorRepo.On("UpdateWithMapTx", mock.Anything, investorID, mock.Anything, mock.Anything).Return(investor.Investor{}, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return entry.ReferenceType == ledger.ReferenceInvestment && assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountInvestorCash, &investorID, req.Amount),
+				ledger.Credit(ledger.AccountLoanFunding, &loanID, req.Amount),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
 		investmentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
 		mailBus.On("Publish", "mail.send", mock.Anything)
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.AddInvestment(ctx, investorID, req)
 
 		assert.NoError(t, err)
@@ -80,13 +95,15 @@ func TestAddInvestment(t *testing.T) {
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
 		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loan.Loan{}, nil)
 		investmentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.AddInvestment(ctx, investorID, req)
 
 		assert.Error(t, err)
@@ -100,6 +117,8 @@ func TestAddInvestment(t *testing.T) {
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		loanData.State = loan.StateProposed
@@ -107,7 +126,7 @@ func TestAddInvestment(t *testing.T) {
 		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
 		investmentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.AddInvestment(ctx, investorID, req)
 
 		assert.Error(t, err)
@@ -121,6 +140,8 @@ func TestAddInvestment(t *testing.T) {
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		loanData.State = loan.StateApproved
@@ -129,7 +150,7 @@ func TestAddInvestment(t *testing.T) {
 		investorRepo.On("GetByIDLockTx", mock.Anything, investorID, mock.Anything).Return(investor.Investor{}, nil)
 		investmentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.AddInvestment(ctx, investorID, req)
 
 		assert.Error(t, err)
@@ -144,6 +165,8 @@ func TestAddInvestment(t *testing.T) {
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		investorData.Balance = 500
@@ -152,7 +175,7 @@ func TestAddInvestment(t *testing.T) {
 		investorRepo.On("GetByIDLockTx", mock.Anything, investorID, mock.Anything).Return(investorData, nil)
 		investmentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.AddInvestment(ctx, investorID, req)
 
 		assert.Error(t, err)
@@ -167,16 +190,18 @@ func TestAddInvestment(t *testing.T) {
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		investorData.Balance = 5000
 		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
 		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
 		investorRepo.On("GetByIDLockTx", mock.Anything, investorID, mock.Anything).Return(investorData, nil)
-		investmentRepo.On("GetTotalInvestmentByLoanID", mock.Anything, loanID).Return(float64(1500), nil)
+		investmentRepo.On("GetTotalInvestmentByLoanID", mock.Anything, loanID).Return(money.Money(1500), nil)
 		investmentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.AddInvestment(ctx, investorID, req)
 
 		assert.Error(t, err)
@@ -187,26 +212,119 @@ func TestAddInvestment(t *testing.T) {
 		investmentRepo.AssertExpectations(t)
 	})
 
+	t.Run("investment exceeds concentration limits", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		config.INVESTMENT_MAX_LOAN_SHARE = 25
+		config.INVESTMENT_MAX_AMOUNT_PER_LOAN = 800
+		config.INVESTMENT_MAX_BORROWER_SHARE = 10
+		t.Cleanup(func() {
+			config.INVESTMENT_MAX_LOAN_SHARE = 0
+			config.INVESTMENT_MAX_AMOUNT_PER_LOAN = 0
+			config.INVESTMENT_MAX_BORROWER_SHARE = 0
+		})
+
+		investorData.Balance = 5000
+		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		investorRepo.On("GetByIDLockTx", mock.Anything, investorID, mock.Anything).Return(investorData, nil)
+		investmentRepo.On("GetTotalInvestmentByLoanID", mock.Anything, loanID).Return(money.Money(0), nil)
+		investmentRepo.On("GetExposureTx", mock.Anything, investorID, loanID, loanData.BorrowerID, mock.Anything).Return(investment.Exposure{
+			Loan:     0,
+			Borrower: 200,
+			Total:    1000,
+		}, nil)
+		investmentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		res, err := uc.AddInvestment(ctx, investorID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("investment exceeds concentration limits",
+			"investment in this loan would be 1000, above the maximum of 800 per investor",
+			"investment in this loan would be 1000, above 25% of its principal amount (500)",
+			"investment in this borrower would be 1200, above 10% of your portfolio (600)",
+		), err)
+		investmentRepo.AssertExpectations(t)
+		investmentRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("investment within concentration limits", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		config.INVESTMENT_MAX_LOAN_SHARE = 50
+		config.INVESTMENT_MAX_AMOUNT_PER_LOAN = 1000
+		config.INVESTMENT_MAX_BORROWER_SHARE = 20
+		t.Cleanup(func() {
+			config.INVESTMENT_MAX_LOAN_SHARE = 0
+			config.INVESTMENT_MAX_AMOUNT_PER_LOAN = 0
+			config.INVESTMENT_MAX_BORROWER_SHARE = 0
+		})
+
+		investorData.Balance = 5000
+		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		investorRepo.On("GetByIDLockTx", mock.Anything, investorID, mock.Anything).Return(investorData, nil)
+		investmentRepo.On("GetTotalInvestmentByLoanID", mock.Anything, loanID).Return(money.Money(0), nil)
+		investmentRepo.On("GetExposureTx", mock.Anything, investorID, loanID, loanData.BorrowerID, mock.Anything).Return(investment.Exposure{
+			Borrower: 200,
+			Total:    1000,
+		}, nil)
+		investmentRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("investment.Investment"), mock.Anything).Return(investmentData, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.Anything, mock.Anything).Return(ledger.JournalEntry{}, nil)
+		investmentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+		mailBus.On("Publish", "mail.send", mock.Anything)
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		res, err := uc.AddInvestment(ctx, investorID, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		investmentRepo.AssertExpectations(t)
+	})
+
 	t.Run("check loan invested", func(t *testing.T) {
 		investmentRepo := new(investmentMock.MockIInvestmentRepository)
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		loanData.PrincipalAmount = 1000
 		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
 		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
 		investorRepo.On("GetByIDLockTx", mock.Anything, investorID, mock.Anything).Return(investorData, nil)
-		investmentRepo.On("GetTotalInvestmentByLoanID", mock.Anything, loanID).Return(float64(0), nil)
+		investmentRepo.On("GetTotalInvestmentByLoanID", mock.Anything, loanID).Return(money.Money(0), nil)
 		investmentRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("investment.Investment"), mock.Anything).Return(investmentData, nil)
-		investorRepo.On("UpdateWithMapTx", mock.Anything, investorID, mock.Anything, mock.Anything).Return(investor.Investor{}, nil)
-		loanRepo.On("UpdateWithMapTx", mock.Anything, loanID, mock.Anything, mock.Anything).Return(loan.Loan{}, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return entry.ReferenceType == ledger.ReferenceInvestment && assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountInvestorCash, &investorID, req.Amount),
+				ledger.Credit(ledger.AccountLoanFunding, &loanID, req.Amount),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		loanRepo.On("TransitionWithTx", mock.Anything, loanID, mock.MatchedBy(func(change loan.StateChange) bool {
+			return change.From == loan.StateApproved && change.To == loan.StateInvested && *change.Actor.ID == investorID
+		}), mock.Anything, mock.Anything).Return(loan.Loan{}, nil)
 		borrowerRepo.On("GetByID", mock.Anything, mock.Anything).Return(borrower.Borrower{}, nil)
 		investmentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
 		mailBus.On("Publish", "mail.send", mock.Anything).Times(2)
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.AddInvestment(ctx, investorID, req)
 
 		assert.NoError(t, err)
@@ -248,6 +366,8 @@ func TestGetInvestmentAgreementDetail(t *testing.T) {
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
@@ -255,7 +375,7 @@ func TestGetInvestmentAgreementDetail(t *testing.T) {
 		investorRepo.On("GetByID", mock.Anything, investorID).Return(investorData, nil)
 		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.GetInvestmentAgreementDetail(ctx, investmentID)
 
 		assert.NoError(t, err)
@@ -271,11 +391,13 @@ func TestGetInvestmentAgreementDetail(t *testing.T) {
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investment.Investment{}, nil)
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.GetInvestmentAgreementDetail(ctx, investmentID)
 
 		assert.Error(t, err)
@@ -289,12 +411,14 @@ func TestGetInvestmentAgreementDetail(t *testing.T) {
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
 		loanRepo.On("GetByID", mock.Anything, loanID).Return(loan.Loan{}, nil)
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.GetInvestmentAgreementDetail(ctx, investmentID)
 
 		assert.Error(t, err)
@@ -309,13 +433,15 @@ func TestGetInvestmentAgreementDetail(t *testing.T) {
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
 		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
 		investorRepo.On("GetByID", mock.Anything, investorID).Return(investor.Investor{}, nil)
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.GetInvestmentAgreementDetail(ctx, investmentID)
 
 		assert.Error(t, err)
@@ -331,6 +457,8 @@ func TestGetInvestmentAgreementDetail(t *testing.T) {
 		investorRepo := new(investorMock.MockIInvestorRepository)
 		loanRepo := new(loanMock.MockILoanRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
 		mailBus := new(busMock.MockBus[mail.MailSendRequest])
 
 		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
@@ -338,7 +466,7 @@ func TestGetInvestmentAgreementDetail(t *testing.T) {
 		investorRepo.On("GetByID", mock.Anything, investorID).Return(investorData, nil)
 		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrower.Borrower{}, nil)
 
-		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, mailBus)
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
 		res, err := uc.GetInvestmentAgreementDetail(ctx, investmentID)
 
 		assert.Error(t, err)
@@ -350,3 +478,494 @@ func TestGetInvestmentAgreementDetail(t *testing.T) {
 		borrowerRepo.AssertExpectations(t)
 	})
 }
+
+func TestExpireLoans(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	cutoff := now.AddDate(0, 0, -14)
+	approvalDate := cutoff.AddDate(0, 0, -1)
+	loanID := uuid.New()
+	investorA := uuid.New()
+	investorB := uuid.New()
+	loanData := loan.Loan{
+		BaseModel:       model.BaseModel{ID: loanID},
+		State:           loan.StateApproved,
+		PrincipalAmount: 5000,
+		ApprovalDetails: loan.ApprovalDetails{ApprovalDate: &approvalDate},
+	}
+	investments := []investment.Investment{
+		{BaseModel: model.BaseModel{ID: uuid.New()}, LoanID: loanID, InvestorID: investorA, Amount: 1000},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, LoanID: loanID, InvestorID: investorB, Amount: 1500},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, LoanID: loanID, InvestorID: investorA, Amount: 500},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		loanRepo.On("GetApprovedBefore", mock.Anything, cutoff).Return([]loan.Loan{loanData}, nil)
+		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		loanRepo.On("TransitionWithTx", mock.Anything, loanID, loan.StateChange{
+			From:   loan.StateApproved,
+			To:     loan.StateExpired,
+			Actor:  loan.SystemActor(),
+			Reason: "funding window closed",
+		}, mock.Anything, mock.Anything).Return(loan.Loan{}, nil)
+		investmentRepo.On("GetByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return(investments, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return entry.ReferenceType == ledger.ReferenceLoanExpiry && entry.ReferenceID == loanID && assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountLoanFunding, &loanID, 1000),
+				ledger.Credit(ledger.AccountInvestorCash, &investorA, 1000),
+				ledger.Debit(ledger.AccountLoanFunding, &loanID, 1500),
+				ledger.Credit(ledger.AccountInvestorCash, &investorB, 1500),
+				ledger.Debit(ledger.AccountLoanFunding, &loanID, 500),
+				ledger.Credit(ledger.AccountInvestorCash, &investorA, 500),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		investmentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+		investorRepo.On("GetByIDs", mock.Anything, []uuid.UUID{investorA, investorB}).Return([]investor.Investor{
+			{BaseModel: model.BaseModel{ID: investorA}, Email: "a@example.com"},
+			{BaseModel: model.BaseModel{ID: investorB}, Email: "b@example.com"},
+		}, nil)
+		mailBus.On("Publish", "mail.send", mock.MatchedBy(func(req mail.MailSendRequest) bool {
+			return req.To == "a@example.com" && req.Template == "loan_expired.html" && req.Data["RefundAmount"] == money.Money(1500)
+		})).Once()
+		mailBus.On("Publish", "mail.send", mock.MatchedBy(func(req mail.MailSendRequest) bool {
+			return req.To == "b@example.com" && req.Template == "loan_expired.html" && req.Data["RefundAmount"] == money.Money(1500)
+		})).Once()
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		expired, err := uc.ExpireLoans(ctx, cutoff)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, expired)
+		investmentRepo.AssertExpectations(t)
+		investorRepo.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+		ledgerUsecase.AssertExpectations(t)
+		mailBus.AssertExpectations(t)
+	})
+
+	t.Run("no investments", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		loanRepo.On("GetApprovedBefore", mock.Anything, cutoff).Return([]loan.Loan{loanData}, nil)
+		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		loanRepo.On("TransitionWithTx", mock.Anything, loanID, mock.Anything, mock.Anything, mock.Anything).Return(loan.Loan{}, nil)
+		investmentRepo.On("GetByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return([]investment.Investment{}, nil)
+		investmentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		expired, err := uc.ExpireLoans(ctx, cutoff)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, expired)
+		ledgerUsecase.AssertNotCalled(t, "PostWithTx", mock.Anything, mock.Anything, mock.Anything)
+		mailBus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("loan funded in the meantime", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		investedLoan := loanData
+		investedLoan.State = loan.StateInvested
+		loanRepo.On("GetApprovedBefore", mock.Anything, cutoff).Return([]loan.Loan{loanData}, nil)
+		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(investedLoan, nil)
+		investmentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		expired, err := uc.ExpireLoans(ctx, cutoff)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, expired)
+		loanRepo.AssertNotCalled(t, "TransitionWithTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("refund fails", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		loanRepo.On("GetApprovedBefore", mock.Anything, cutoff).Return([]loan.Loan{loanData}, nil)
+		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		loanRepo.On("TransitionWithTx", mock.Anything, loanID, mock.Anything, mock.Anything, mock.Anything).Return(loan.Loan{}, nil)
+		investmentRepo.On("GetByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return(investments, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.Anything, mock.Anything).Return(ledger.JournalEntry{}, assert.AnError)
+		investmentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		expired, err := uc.ExpireLoans(ctx, cutoff)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 0, expired)
+		investmentRepo.AssertExpectations(t)
+		mailBus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListInvestment(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	loanID := uuid.New()
+	investmentData := investment.Investment{
+		BaseModel:  model.BaseModel{ID: uuid.New()},
+		LoanID:     loanID,
+		InvestorID: investorID,
+		Amount:     1200000,
+	}
+	loanData := loan.Loan{
+		BaseModel:          model.BaseModel{ID: loanID},
+		State:              loan.StateDisbursed,
+		ROI:                10,
+		Tenor:              12,
+		RepaymentFrequency: loan.FrequencyMonthly,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		investmentRepo.On("Pagination", mock.Anything, map[string]any{"investor_id": investorID}, 1, 10).Return(repository.Pagination[investment.Investment]{
+			Data:    []investment.Investment{investmentData},
+			HasNext: true,
+		}, nil)
+		loanRepo.On("GetByIDs", mock.Anything, []uuid.UUID{loanID}).Return([]loan.Loan{loanData}, nil)
+		distributionRepo.On("GetTotalsByInvestmentIDs", mock.Anything, []uuid.UUID{investmentData.ID}).Return([]repayment.InvestmentTotal{
+			{InvestmentID: investmentData.ID, PrincipalAmount: 300000, ReturnAmount: 30000},
+		}, nil)
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		res, err := uc.ListInvestment(ctx, investorID, 1, 10)
+
+		assert.NoError(t, err)
+		assert.True(t, res.HasNext)
+		assert.Equal(t, []investment.InvestmentResponse{{
+			ID:                  investmentData.ID,
+			LoanID:              loanID,
+			LoanState:           loan.StateDisbursed,
+			Amount:              1200000,
+			ROI:                 10,
+			Tenor:               12,
+			ExpectedReturn:      120000,
+			RepaidPrincipal:     300000,
+			ReturnReceived:      30000,
+			OutstandingExposure: 900000,
+		}}, res.Data)
+		investmentRepo.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+		distributionRepo.AssertExpectations(t)
+	})
+
+	t.Run("annuity loan", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		annuityLoan := loanData
+		annuityLoan.AmortizationMethod = amortization.MethodAnnuity
+		investmentRepo.On("Pagination", mock.Anything, map[string]any{"investor_id": investorID}, 1, 10).Return(repository.Pagination[investment.Investment]{
+			Data: []investment.Investment{investmentData},
+		}, nil)
+		loanRepo.On("GetByIDs", mock.Anything, []uuid.UUID{loanID}).Return([]loan.Loan{annuityLoan}, nil)
+		distributionRepo.On("GetTotalsByInvestmentIDs", mock.Anything, []uuid.UUID{investmentData.ID}).Return([]repayment.InvestmentTotal{}, nil)
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		res, err := uc.ListInvestment(ctx, investorID, 1, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.Money(65990), res.Data[0].ExpectedReturn)
+	})
+}
+
+func TestDetailInvestment(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	investmentID := uuid.New()
+	loanID := uuid.New()
+	investmentData := investment.Investment{
+		BaseModel:  model.BaseModel{ID: investmentID},
+		LoanID:     loanID,
+		InvestorID: investorID,
+		Amount:     1000,
+	}
+
+	t.Run("expired loan has no exposure", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
+		loanRepo.On("GetByIDs", mock.Anything, []uuid.UUID{loanID}).Return([]loan.Loan{{
+			BaseModel: model.BaseModel{ID: loanID},
+			State:     loan.StateExpired,
+			ROI:       10,
+			Tenor:     12,
+		}}, nil)
+		distributionRepo.On("GetTotalsByInvestmentIDs", mock.Anything, []uuid.UUID{investmentID}).Return([]repayment.InvestmentTotal{}, nil)
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		res, err := uc.DetailInvestment(ctx, investorID, investmentID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, loan.StateExpired, res.LoanState)
+		assert.Equal(t, money.Money(0), res.ExpectedReturn)
+		assert.Equal(t, money.Money(0), res.OutstandingExposure)
+	})
+
+	t.Run("investment of another investor", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		res, err := uc.DetailInvestment(ctx, uuid.New(), investmentID)
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("investment not found"), err)
+		loanRepo.AssertNotCalled(t, "GetByIDs", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetPortfolioSummary(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	disbursedLoan := loan.Loan{
+		BaseModel:          model.BaseModel{ID: uuid.New()},
+		State:              loan.StateDisbursed,
+		ROI:                12,
+		Tenor:              6,
+		RepaymentFrequency: loan.FrequencyMonthly,
+	}
+	approvedLoan := loan.Loan{
+		BaseModel:          model.BaseModel{ID: uuid.New()},
+		State:              loan.StateApproved,
+		ROI:                6,
+		Tenor:              12,
+		RepaymentFrequency: loan.FrequencyMonthly,
+	}
+	investments := []investment.Investment{
+		{BaseModel: model.BaseModel{ID: uuid.New()}, LoanID: disbursedLoan.ID, InvestorID: investorID, Amount: 1000000},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, LoanID: approvedLoan.ID, InvestorID: investorID, Amount: 500000},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, LoanID: disbursedLoan.ID, InvestorID: investorID, Amount: 2000000},
+	}
+
+	investmentRepo := new(investmentMock.MockIInvestmentRepository)
+	investorRepo := new(investorMock.MockIInvestorRepository)
+	loanRepo := new(loanMock.MockILoanRepository)
+	borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+	distributionRepo := new(repaymentMock.MockIDistributionRepository)
+	ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+	mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+	investmentRepo.On("GetByInvestorID", mock.Anything, investorID).Return(investments, nil)
+	loanRepo.On("GetByIDs", mock.Anything, []uuid.UUID{disbursedLoan.ID, approvedLoan.ID}).Return([]loan.Loan{disbursedLoan, approvedLoan}, nil)
+	distributionRepo.On("GetTotalsByInvestmentIDs", mock.Anything, mock.Anything).Return([]repayment.InvestmentTotal{
+		{InvestmentID: investments[0].ID, PrincipalAmount: 100000, ReturnAmount: 10000},
+		{InvestmentID: investments[2].ID, PrincipalAmount: 200000, ReturnAmount: 20000},
+	}, nil)
+
+	uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+	res, err := uc.GetPortfolioSummary(ctx, investorID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, investment.PortfolioTotal{
+		InvestmentCount:     3,
+		Amount:              3500000,
+		ExpectedReturn:      210000,
+		RepaidPrincipal:     300000,
+		ReturnReceived:      30000,
+		OutstandingExposure: 3200000,
+	}, res.Total)
+	assert.Equal(t, []investment.PortfolioStateTotal{
+		{LoanState: loan.StateApproved, PortfolioTotal: investment.PortfolioTotal{
+			InvestmentCount:     1,
+			Amount:              500000,
+			ExpectedReturn:      30000,
+			OutstandingExposure: 500000,
+		}},
+		{LoanState: loan.StateDisbursed, PortfolioTotal: investment.PortfolioTotal{
+			InvestmentCount:     2,
+			Amount:              3000000,
+			ExpectedReturn:      180000,
+			RepaidPrincipal:     300000,
+			ReturnReceived:      30000,
+			OutstandingExposure: 2700000,
+		}},
+	}, res.ByLoanState)
+}
+
+func TestCancelInvestment(t *testing.T) {
+	ctx := context.Background()
+	config.INVESTMENT_COOLING_OFF_PERIOD = time.Hour
+	investorID := uuid.New()
+	investmentID := uuid.New()
+	loanID := uuid.New()
+	createdAt := time.Now().Add(-10 * time.Minute)
+	investmentData := investment.Investment{
+		BaseModel:  model.BaseModel{ID: investmentID, CreatedAt: &createdAt},
+		LoanID:     loanID,
+		InvestorID: investorID,
+		Amount:     1000,
+	}
+	loanData := loan.Loan{
+		BaseModel: model.BaseModel{ID: loanID},
+		State:     loan.StateApproved,
+	}
+	investorData := investor.Investor{
+		BaseModel: model.BaseModel{ID: investorID},
+		Email:     "investor@example.com",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
+		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		investorRepo.On("GetByIDLockTx", mock.Anything, investorID, mock.Anything).Return(investorData, nil)
+		investmentRepo.On("GetByIDLockTx", mock.Anything, investmentID, mock.Anything).Return(investmentData, nil)
+		investmentRepo.On("DeleteWithTx", mock.Anything, investmentID, mock.Anything).Return(nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return entry.ReferenceType == ledger.ReferenceCancellation && assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountLoanFunding, &loanID, 1000),
+				ledger.Credit(ledger.AccountInvestorCash, &investorID, 1000),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		investmentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+		mailBus.On("Publish", "mail.send", mock.MatchedBy(func(req mail.MailSendRequest) bool {
+			return req.To == investorData.Email && req.Template == "investment_cancelled.html"
+		}))
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		res, err := uc.CancelInvestment(ctx, investorID, investmentID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, investmentID, res.ID)
+		investmentRepo.AssertExpectations(t)
+		investorRepo.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+		ledgerUsecase.AssertExpectations(t)
+		mailBus.AssertExpectations(t)
+	})
+
+	t.Run("investment of another investor", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		res, err := uc.CancelInvestment(ctx, uuid.New(), investmentID)
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("investment not found"), err)
+		investmentRepo.AssertNotCalled(t, "BeginTransaction", mock.Anything)
+	})
+
+	t.Run("loan no longer approved", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		investedLoan := loanData
+		investedLoan.State = loan.StateInvested
+		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
+		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(investedLoan, nil)
+		investmentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		res, err := uc.CancelInvestment(ctx, investorID, investmentID)
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("loan is not in approved"), err)
+		investmentRepo.AssertNotCalled(t, "DeleteWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("cooling-off period ended", func(t *testing.T) {
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		mailBus := new(busMock.MockBus[mail.MailSendRequest])
+
+		oldCreatedAt := time.Now().Add(-2 * time.Hour)
+		oldInvestment := investmentData
+		oldInvestment.CreatedAt = &oldCreatedAt
+		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(oldInvestment, nil)
+		investmentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		investorRepo.On("GetByIDLockTx", mock.Anything, investorID, mock.Anything).Return(investorData, nil)
+		investmentRepo.On("GetByIDLockTx", mock.Anything, investmentID, mock.Anything).Return(oldInvestment, nil)
+		investmentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewInvestmentUsecase(investmentRepo, investorRepo, loanRepo, borrowerRepo, distributionRepo, ledgerUsecase, mailBus)
+		res, err := uc.CancelInvestment(ctx, investorID, investmentID)
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("cooling-off period has ended"), err)
+		investmentRepo.AssertNotCalled(t, "DeleteWithTx", mock.Anything, mock.Anything, mock.Anything)
+		ledgerUsecase.AssertNotCalled(t, "PostWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
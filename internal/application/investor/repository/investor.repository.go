@@ -1,11 +1,18 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
 	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	sq "github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
 	"gorm.io/gorm"
 )
 
+var tracerName = "InvestorRepository"
+var tracer = otel.Tracer(tracerName)
+
 type investorRepo struct {
 	repository.BaseRepo[investor.Investor]
 	writeConn *gorm.DB
@@ -21,3 +28,29 @@ func NewInvestorRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) investor.IInvestorRepo
 		readConn:  dbSlave,
 	}
 }
+
+func (r *investorRepo) GetByEmail(ctx context.Context, email string) (investorData investor.Investor, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByEmail")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(investorData.TableName()).
+		Where(sq.Eq{
+			"email":      email,
+			"deleted_at": nil,
+		}).
+		Limit(1)
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&investorData).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
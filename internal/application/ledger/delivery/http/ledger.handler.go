@@ -0,0 +1,42 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ledgerHandler struct {
+	usecase ledger.ILedgerUsecase
+}
+
+func NewLedgerHandler(usecase ledger.ILedgerUsecase) *ledgerHandler {
+	return &ledgerHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *ledgerHandler) ListInvestorLedger(c *gin.Context) {
+	var page int = 1
+	if pageStr := c.Query("page"); pageStr != "" {
+		page, _ = strconv.Atoi(pageStr)
+	}
+
+	var limit int = 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, _ = strconv.Atoi(limitStr)
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.ListInvestorLedger(c.Request.Context(), investorID.(uuid.UUID), page, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
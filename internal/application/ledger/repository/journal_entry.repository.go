@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	"gorm.io/gorm"
+)
+
+type journalEntryRepo struct {
+	repository.BaseRepo[ledger.JournalEntry]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewJournalEntryRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) ledger.IJournalEntryRepository {
+	baseRepo := repository.NewBaseRepo[ledger.JournalEntry](dbMaster, dbSlave)
+
+	return &journalEntryRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
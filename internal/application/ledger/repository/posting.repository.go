@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	"gorm.io/gorm"
+)
+
+type postingRepo struct {
+	repository.BaseRepo[ledger.Posting]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewPostingRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) ledger.IPostingRepository {
+	baseRepo := repository.NewBaseRepo[ledger.Posting](dbMaster, dbSlave)
+
+	return &postingRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
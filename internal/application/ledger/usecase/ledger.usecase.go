@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var tracerName = "LedgerUsecase"
+var tracer = otel.Tracer(tracerName)
+
+var (
+	ErrEmptyJournalEntry      = errors.New("journal entry needs at least two postings")
+	ErrNegativePosting        = errors.New("posting amount must not be negative")
+	ErrMissingPostingOwner    = errors.New("investor cash posting needs an owner")
+	ErrUnbalancedJournalEntry = errors.New("journal entry debits and credits do not balance")
+)
+
+type ledgerUsecase struct {
+	journalEntryRepo ledger.IJournalEntryRepository
+	postingRepo      ledger.IPostingRepository
+	investorRepo     investor.IInvestorRepository
+}
+
+func NewLedgerUsecase(journalEntryRepo ledger.IJournalEntryRepository, postingRepo ledger.IPostingRepository, investorRepo investor.IInvestorRepository) ledger.ILedgerUsecase {
+	return &ledgerUsecase{
+		journalEntryRepo: journalEntryRepo,
+		postingRepo:      postingRepo,
+		investorRepo:     investorRepo,
+	}
+}
+
+// PostWithTx persists a balanced journal entry inside the caller's transaction and refreshes the cached balance of
+// every investor whose cash account it touches. Zero-amount postings are dropped so callers can build entries freely.
+func (u *ledgerUsecase) PostWithTx(ctx context.Context, entry ledger.JournalEntry, trx *gorm.DB) (res ledger.JournalEntry, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".PostWithTx")
+	defer span.End()
+
+	postings := make([]ledger.Posting, 0, len(entry.Postings))
+	debit, credit := money.Money(0), money.Money(0)
+	for _, posting := range entry.Postings {
+		if posting.Amount < 0 {
+			return res, ErrNegativePosting
+		} else if posting.Amount == 0 {
+			continue
+		} else if posting.Account == ledger.AccountInvestorCash && posting.OwnerID == nil {
+			return res, ErrMissingPostingOwner
+		}
+
+		if posting.Direction == ledger.DirectionDebit {
+			debit += posting.Amount
+		} else {
+			credit += posting.Amount
+		}
+
+		postings = append(postings, posting)
+	}
+
+	if len(postings) < 2 {
+		return res, ErrEmptyJournalEntry
+	} else if debit != credit {
+		return res, ErrUnbalancedJournalEntry
+	}
+
+	entry.Postings = nil
+	res, err = u.journalEntryRepo.CreateWithTx(ctx, entry, trx)
+	if err != nil {
+		return res, err
+	}
+
+	deltas := map[uuid.UUID]money.Money{}
+	for i := range postings {
+		postings[i].JournalEntryID = res.ID
+
+		if postings[i].Account == ledger.AccountInvestorCash {
+			deltas[*postings[i].OwnerID] += postings[i].SignedAmount()
+		}
+	}
+
+	err = u.postingRepo.CreateBulkWithTx(ctx, postings, trx)
+	if err != nil {
+		return res, err
+	}
+
+	// Update investors in a stable order so concurrent entries touching the same investors cannot deadlock.
+	investorIDs := make([]uuid.UUID, 0, len(deltas))
+	for investorID := range deltas {
+		investorIDs = append(investorIDs, investorID)
+	}
+	sort.Slice(investorIDs, func(i, j int) bool {
+		return investorIDs[i].String() < investorIDs[j].String()
+	})
+
+	for _, investorID := range investorIDs {
+		_, err = u.investorRepo.UpdateWithMapTx(ctx, investorID, map[string]any{
+			"balance": gorm.Expr("balance + ?", deltas[investorID]),
+		}, trx)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	res.Postings = postings
+	return res, nil
+}
+
+func (u *ledgerUsecase) ListInvestorLedger(ctx context.Context, investorID uuid.UUID, page int, limit int) (ledger.InvestorLedgerPagination, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ListInvestorLedger")
+	defer span.End()
+
+	postings, err := u.postingRepo.Pagination(ctx, map[string]any{
+		"account":  ledger.AccountInvestorCash,
+		"owner_id": investorID,
+	}, page, limit)
+	if err != nil {
+		return ledger.InvestorLedgerPagination{}, err
+	}
+
+	entryIDs := make([]uuid.UUID, 0, len(postings.Data))
+	for _, posting := range postings.Data {
+		entryIDs = append(entryIDs, posting.JournalEntryID)
+	}
+
+	entries, err := u.journalEntryRepo.GetByIDs(ctx, entryIDs)
+	if err != nil {
+		return ledger.InvestorLedgerPagination{}, err
+	}
+
+	entryByID := make(map[uuid.UUID]ledger.JournalEntry, len(entries))
+	for _, entry := range entries {
+		entryByID[entry.ID] = entry
+	}
+
+	res := ledger.InvestorLedgerPagination{
+		Data:    make([]ledger.InvestorLedgerResponse, 0, len(postings.Data)),
+		HasNext: postings.HasNext,
+		HasPrev: postings.HasPrev,
+	}
+
+	for _, posting := range postings.Data {
+		entry := entryByID[posting.JournalEntryID]
+		res.Data = append(res.Data, ledger.InvestorLedgerResponse{
+			ID:             posting.ID,
+			JournalEntryID: posting.JournalEntryID,
+			ReferenceType:  entry.ReferenceType,
+			ReferenceID:    entry.ReferenceID,
+			Description:    entry.Description,
+			Direction:      posting.Direction,
+			Amount:         posting.Amount,
+			CreatedAt:      posting.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,163 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BagusAK95/amarta_test/internal/application/ledger/usecase"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	investorMock "github.com/BagusAK95/amarta_test/internal/domain/investor/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	ledgerMock "github.com/BagusAK95/amarta_test/internal/domain/ledger/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestPostWithTx(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	loanID := uuid.New()
+	entryID := uuid.New()
+
+	t.Run("success updates investor balance", func(t *testing.T) {
+		journalEntryRepo := new(ledgerMock.MockIJournalEntryRepository)
+		postingRepo := new(ledgerMock.MockIPostingRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+
+		entry := ledger.JournalEntry{
+			ReferenceType: ledger.ReferenceInvestment,
+			ReferenceID:   uuid.New(),
+			Postings: []ledger.Posting{
+				ledger.Debit(ledger.AccountInvestorCash, &investorID, 1000),
+				ledger.Credit(ledger.AccountLoanFunding, &loanID, 1000),
+				ledger.Credit(ledger.AccountPlatformFee, nil, 0),
+			},
+		}
+
+		journalEntryRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("ledger.JournalEntry"), mock.Anything).
+			Return(func(_ context.Context, e ledger.JournalEntry, _ *gorm.DB) (ledger.JournalEntry, error) {
+				e.ID = entryID
+				return e, nil
+			})
+		postingRepo.On("CreateBulkWithTx", mock.Anything, mock.MatchedBy(func(postings []ledger.Posting) bool {
+			return len(postings) == 2 && postings[0].JournalEntryID == entryID && postings[1].JournalEntryID == entryID
+		}), mock.Anything).Return(nil)
+		investorRepo.On("UpdateWithMapTx", mock.Anything, investorID, map[string]any{
+			"balance": gorm.Expr("balance + ?", money.Money(-1000)),
+		}, mock.Anything).Return(investor.Investor{}, nil)
+
+		uc := usecase.NewLedgerUsecase(journalEntryRepo, postingRepo, investorRepo)
+		res, err := uc.PostWithTx(ctx, entry, &gorm.DB{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, entryID, res.ID)
+		assert.Len(t, res.Postings, 2)
+		journalEntryRepo.AssertExpectations(t)
+		postingRepo.AssertExpectations(t)
+		investorRepo.AssertExpectations(t)
+	})
+
+	t.Run("unbalanced entry", func(t *testing.T) {
+		journalEntryRepo := new(ledgerMock.MockIJournalEntryRepository)
+		postingRepo := new(ledgerMock.MockIPostingRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+
+		entry := ledger.JournalEntry{
+			Postings: []ledger.Posting{
+				ledger.Debit(ledger.AccountInvestorCash, &investorID, 1000),
+				ledger.Credit(ledger.AccountLoanFunding, &loanID, 999),
+			},
+		}
+
+		uc := usecase.NewLedgerUsecase(journalEntryRepo, postingRepo, investorRepo)
+		_, err := uc.PostWithTx(ctx, entry, &gorm.DB{})
+
+		assert.Equal(t, usecase.ErrUnbalancedJournalEntry, err)
+		journalEntryRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("investor cash without owner", func(t *testing.T) {
+		journalEntryRepo := new(ledgerMock.MockIJournalEntryRepository)
+		postingRepo := new(ledgerMock.MockIPostingRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+
+		entry := ledger.JournalEntry{
+			Postings: []ledger.Posting{
+				ledger.Debit(ledger.AccountInvestorCash, nil, 1000),
+				ledger.Credit(ledger.AccountLoanFunding, &loanID, 1000),
+			},
+		}
+
+		uc := usecase.NewLedgerUsecase(journalEntryRepo, postingRepo, investorRepo)
+		_, err := uc.PostWithTx(ctx, entry, &gorm.DB{})
+
+		assert.Equal(t, usecase.ErrMissingPostingOwner, err)
+	})
+
+	t.Run("no non-zero postings", func(t *testing.T) {
+		journalEntryRepo := new(ledgerMock.MockIJournalEntryRepository)
+		postingRepo := new(ledgerMock.MockIPostingRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+
+		entry := ledger.JournalEntry{
+			Postings: []ledger.Posting{
+				ledger.Debit(ledger.AccountSettlementCash, nil, 0),
+				ledger.Credit(ledger.AccountLoanFunding, &loanID, 0),
+			},
+		}
+
+		uc := usecase.NewLedgerUsecase(journalEntryRepo, postingRepo, investorRepo)
+		_, err := uc.PostWithTx(ctx, entry, &gorm.DB{})
+
+		assert.Equal(t, usecase.ErrEmptyJournalEntry, err)
+	})
+}
+
+func TestListInvestorLedger(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	entryID := uuid.New()
+	investmentID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		journalEntryRepo := new(ledgerMock.MockIJournalEntryRepository)
+		postingRepo := new(ledgerMock.MockIPostingRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+
+		posting := ledger.Debit(ledger.AccountInvestorCash, &investorID, 1000)
+		posting.ID = uuid.New()
+		posting.JournalEntryID = entryID
+
+		postingRepo.On("Pagination", mock.Anything, map[string]any{
+			"account":  ledger.AccountInvestorCash,
+			"owner_id": investorID,
+		}, 1, 10).Return(repository.Pagination[ledger.Posting]{Data: []ledger.Posting{posting}, HasNext: true}, nil)
+		journalEntryRepo.On("GetByIDs", mock.Anything, []uuid.UUID{entryID}).Return([]ledger.JournalEntry{
+			{
+				BaseModel:     model.BaseModel{ID: entryID},
+				ReferenceType: ledger.ReferenceInvestment,
+				ReferenceID:   investmentID,
+				Description:   "Investment",
+			},
+		}, nil)
+
+		uc := usecase.NewLedgerUsecase(journalEntryRepo, postingRepo, investorRepo)
+		res, err := uc.ListInvestorLedger(ctx, investorID, 1, 10)
+
+		assert.NoError(t, err)
+		assert.True(t, res.HasNext)
+		assert.Len(t, res.Data, 1)
+		assert.Equal(t, posting.ID, res.Data[0].ID)
+		assert.Equal(t, ledger.ReferenceInvestment, res.Data[0].ReferenceType)
+		assert.Equal(t, investmentID, res.Data[0].ReferenceID)
+		assert.Equal(t, ledger.DirectionDebit, res.Data[0].Direction)
+		assert.Equal(t, money.Money(1000), res.Data[0].Amount)
+		postingRepo.AssertExpectations(t)
+		journalEntryRepo.AssertExpectations(t)
+	})
+}
@@ -36,7 +36,9 @@ func (h *loanHandler) CreateLoan(c *gin.Context) {
 		return
 	}
 
-	res, err := h.usecase.CreateLoan(c.Request.Context(), body)
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.CreateLoan(c.Request.Context(), employeeID.(uuid.UUID), body)
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -45,6 +47,27 @@ func (h *loanHandler) CreateLoan(c *gin.Context) {
 	c.JSON(http.StatusCreated, res)
 }
 
+func (h *loanHandler) QuoteLoan(c *gin.Context) {
+	var body loan.QuoteLoanRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	res, err := h.usecase.QuoteLoan(c.Request.Context(), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
 func (h *loanHandler) RejectLoan(c *gin.Context) {
 	loanID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -63,7 +86,9 @@ func (h *loanHandler) RejectLoan(c *gin.Context) {
 		return
 	}
 
-	res, err := h.usecase.RejectLoan(c.Request.Context(), loanID, body.RejectReason)
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.RejectLoan(c.Request.Context(), loanID, employeeID.(uuid.UUID), body.RejectReason)
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -90,7 +115,9 @@ func (h *loanHandler) ApproveLoan(c *gin.Context) {
 		return
 	}
 
-	res, err := h.usecase.ApproveLoan(c.Request.Context(), loanID, body)
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.ApproveLoan(c.Request.Context(), loanID, employeeID.(uuid.UUID), body)
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -117,7 +144,9 @@ func (h *loanHandler) DisburseLoan(c *gin.Context) {
 		return
 	}
 
-	res, err := h.usecase.DisburseLoan(c.Request.Context(), loanID, body)
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.DisburseLoan(c.Request.Context(), loanID, employeeID.(uuid.UUID), body)
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -167,6 +196,38 @@ func (h *loanHandler) DetailLoan(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
+func (h *loanHandler) GetLoanSchedule(c *gin.Context) {
+	loanID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	res, err := h.usecase.GetLoanSchedule(c.Request.Context(), loanID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *loanHandler) GetLoanHistory(c *gin.Context) {
+	loanID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	res, err := h.usecase.GetLoanHistory(c.Request.Context(), loanID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
 func (h *loanHandler) GetLoanAgreementFile(c *gin.Context) {
 	loanID, err := uuid.Parse(c.Param("loan_id"))
 	if err != nil {
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	sq "github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var approvalPolicyTracerName = "ApprovalPolicyRepository"
+var approvalPolicyTracer = otel.Tracer(approvalPolicyTracerName)
+
+type approvalPolicyRepo struct {
+	repository.BaseRepo[loan.ApprovalPolicy]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewApprovalPolicyRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) loan.IApprovalPolicyRepository {
+	baseRepo := repository.NewBaseRepo[loan.ApprovalPolicy](dbMaster, dbSlave)
+
+	return &approvalPolicyRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+// GetByAmount returns the policy with the highest threshold below the amount, or the zero value when none applies
+func (r *approvalPolicyRepo) GetByAmount(ctx context.Context, amount money.Money) (policy loan.ApprovalPolicy, err error) {
+	ctx, span := approvalPolicyTracer.Start(ctx, approvalPolicyTracerName+".GetByAmount")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(policy.TableName()).
+		Where(sq.Lt{"min_amount": amount}).
+		Where(sq.Eq{"deleted_at": nil}).
+		OrderBy("min_amount DESC").
+		Limit(1)
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&policy).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
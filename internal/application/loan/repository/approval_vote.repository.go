@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var approvalVoteTracerName = "ApprovalVoteRepository"
+var approvalVoteTracer = otel.Tracer(approvalVoteTracerName)
+
+type approvalVoteRepo struct {
+	repository.BaseRepo[loan.ApprovalVote]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewApprovalVoteRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) loan.IApprovalVoteRepository {
+	baseRepo := repository.NewBaseRepo[loan.ApprovalVote](dbMaster, dbSlave)
+
+	return &approvalVoteRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *approvalVoteRepo) GetByLoanID(ctx context.Context, loanID uuid.UUID) (votes []loan.ApprovalVote, err error) {
+	ctx, span := approvalVoteTracer.Start(ctx, approvalVoteTracerName+".GetByLoanID")
+	defer span.End()
+
+	return r.getByLoanID(ctx, loanID, r.readConn)
+}
+
+// GetByLoanIDWithTx reads the votes inside the transaction that holds the loan lock, so concurrent votes are counted
+func (r *approvalVoteRepo) GetByLoanIDWithTx(ctx context.Context, loanID uuid.UUID, trx *gorm.DB) (votes []loan.ApprovalVote, err error) {
+	ctx, span := approvalVoteTracer.Start(ctx, approvalVoteTracerName+".GetByLoanIDWithTx")
+	defer span.End()
+
+	return r.getByLoanID(ctx, loanID, trx)
+}
+
+func (r *approvalVoteRepo) getByLoanID(ctx context.Context, loanID uuid.UUID, conn *gorm.DB) (votes []loan.ApprovalVote, err error) {
+	var model loan.ApprovalVote
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"loan_id":    loanID,
+			"deleted_at": nil,
+		}).
+		OrderBy("created_at ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = conn.WithContext(ctx).Raw(qry, args...).Scan(&votes).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
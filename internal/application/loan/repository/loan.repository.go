@@ -1,11 +1,21 @@
 package repository
 
 import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
 	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
 	"gorm.io/gorm"
 )
 
+var tracerName = "LoanRepository"
+var tracer = otel.Tracer(tracerName)
+
 type loanRepo struct {
 	repository.BaseRepo[loan.Loan]
 	writeConn *gorm.DB
@@ -21,3 +31,399 @@ func NewLoanRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) loan.ILoanRepository {
 		readConn:  dbSlave,
 	}
 }
+
+// GetByID is limited to the branches of the request's scope, so a loan of another branch is not found
+func (r *loanRepo) GetByID(ctx context.Context, ID uuid.UUID) (loanData loan.Loan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByID")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(loanData.TableName()).
+		Where(sq.Eq{
+			"id":         ID,
+			"deleted_at": nil,
+		})
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&loanData).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetByIDLockTx is limited like GetByID, which keeps every loan transition within the caller's branches
+func (r *loanRepo) GetByIDLockTx(ctx context.Context, ID uuid.UUID, trx *gorm.DB) (loanData loan.Loan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByIDLockTx")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(loanData.TableName()).
+		Where(sq.Eq{
+			"id":         ID,
+			"deleted_at": nil,
+		}).
+		Suffix("FOR UPDATE")
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Raw(qry, args...).Scan(&loanData).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetByIDs is limited to the branches of the request's scope like GetByID
+func (r *loanRepo) GetByIDs(ctx context.Context, IDs []uuid.UUID) (loans []loan.Loan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByIDs")
+	defer span.End()
+
+	var model loan.Loan
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"id":         IDs,
+			"deleted_at": nil,
+		})
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&loans).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// UpdateWithMap only updates a loan of the request's branches, otherwise the returned loan is empty
+func (r *loanRepo) UpdateWithMap(ctx context.Context, ID uuid.UUID, payload map[string]any) (loanData loan.Loan, err error) {
+	return r.UpdateWithMapTx(ctx, ID, payload, r.writeConn)
+}
+
+// UpdateWithMapTx only updates a loan of the request's branches, otherwise the returned loan is empty
+func (r *loanRepo) UpdateWithMapTx(ctx context.Context, ID uuid.UUID, payload map[string]any, trx *gorm.DB) (loanData loan.Loan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".UpdateWithMapTx")
+	defer span.End()
+
+	scope, args, err := branch.ScopeCondition(ctx, "branch_id").ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Model(&loanData).Where("id = ?", ID).Where(scope, args...).Updates(payload).Scan(&loanData).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (r *loanRepo) Pagination(ctx context.Context, filter map[string]any, page int, limit int) (repository.Pagination[loan.Loan], error) {
+	scope := branch.ScopeFromContext(ctx)
+	if scope.All {
+		return r.BaseRepo.Pagination(ctx, filter, page, limit)
+	}
+
+	scopedFilter := map[string]any{"branch_id": scope.BranchIDs}
+	for key, value := range filter {
+		scopedFilter[key] = value
+	}
+
+	return r.BaseRepo.Pagination(ctx, scopedFilter, page, limit)
+}
+
+func (r *loanRepo) GetApprovedBefore(ctx context.Context, approvedBefore time.Time) (loans []loan.Loan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetApprovedBefore")
+	defer span.End()
+
+	var model loan.Loan
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"state":      loan.StateApproved,
+			"deleted_at": nil,
+		}).
+		Where(sq.Lt{"approval_date": approvedBefore}).
+		OrderBy("approval_date ASC")
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&loans).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// PaginationOpen lists approved loans that are still open for funding, newest approval first.
+func (r *loanRepo) PaginationOpen(ctx context.Context, filter loan.ListOpenLoanFilter, page int, limit int) (res repository.Pagination[loan.Loan], err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".PaginationOpen")
+	defer span.End()
+
+	var model loan.Loan
+	var loans []loan.Loan
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"state":      loan.StateApproved,
+			"deleted_at": nil,
+		})
+
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	if filter.MinROI != nil {
+		builder = builder.Where(sq.GtOrEq{"roi": *filter.MinROI})
+	}
+	if filter.MaxROI != nil {
+		builder = builder.Where(sq.LtOrEq{"roi": *filter.MaxROI})
+	}
+	if filter.MinAmount != nil {
+		builder = builder.Where(sq.GtOrEq{"principal_amount": *filter.MinAmount})
+	}
+	if filter.MaxAmount != nil {
+		builder = builder.Where(sq.LtOrEq{"principal_amount": *filter.MaxAmount})
+	}
+
+	builder = builder.
+		OrderBy("approval_date DESC", "id DESC").
+		Limit(uint64(limit + 1)).
+		Offset(uint64((page - 1) * limit))
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&loans).Error
+	if err != nil {
+		return
+	}
+
+	if len(loans) > limit {
+		res.HasNext = true
+		loans = loans[:limit]
+	}
+
+	if page > 1 {
+		res.HasPrev = true
+	}
+
+	res.Data = loans
+	return
+}
+
+// TransitionWithTx moves the loan to the new state, together with any other columns in data, and records the change in
+// the state history. The loan must already be locked by the caller.
+func (r *loanRepo) TransitionWithTx(ctx context.Context, loanID uuid.UUID, change loan.StateChange, data map[string]any, trx *gorm.DB) (res loan.Loan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".TransitionWithTx")
+	defer span.End()
+
+	payload := map[string]any{
+		"state": change.To,
+	}
+	for key, value := range data {
+		payload[key] = value
+	}
+
+	res, err = r.UpdateWithMapTx(ctx, loanID, payload, trx)
+	if err != nil {
+		return
+	}
+
+	history := loan.StateHistory{
+		LoanID:    loanID,
+		FromState: change.From,
+		ToState:   change.To,
+		ActorType: change.Actor.Type,
+		ActorID:   change.Actor.ID,
+	}
+	if change.Reason != "" {
+		history.Reason = &change.Reason
+	}
+
+	err = trx.WithContext(ctx).Create(&history).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (r *loanRepo) GetStateHistory(ctx context.Context, loanID uuid.UUID) (histories []loan.StateHistory, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetStateHistory")
+	defer span.End()
+
+	var model loan.StateHistory
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"loan_id":    loanID,
+			"deleted_at": nil,
+		}).
+		OrderBy("id ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&histories).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetStateHistoryByLoanIDs returns the changes of the loans into toState, oldest first
+func (r *loanRepo) GetStateHistoryByLoanIDs(ctx context.Context, loanIDs []uuid.UUID, toState loan.State) (histories []loan.StateHistory, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetStateHistoryByLoanIDs")
+	defer span.End()
+
+	var model loan.StateHistory
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"loan_id":    loanIDs,
+			"to_state":   toState,
+			"deleted_at": nil,
+		}).
+		OrderBy("created_at ASC", "id ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&histories).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetByGroupLoanIDs is limited to the branches of the request's scope like GetByID
+func (r *loanRepo) GetByGroupLoanIDs(ctx context.Context, groupLoanIDs []uuid.UUID) (loans []loan.Loan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByGroupLoanIDs")
+	defer span.End()
+
+	var model loan.Loan
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"group_loan_id": groupLoanIDs,
+			"deleted_at":    nil,
+		}).
+		OrderBy("created_at ASC", "id ASC")
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&loans).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetByGroupLoanIDLockTx locks every loan of the group loan, in a stable order so concurrent transitions do not deadlock
+func (r *loanRepo) GetByGroupLoanIDLockTx(ctx context.Context, groupLoanID uuid.UUID, trx *gorm.DB) (loans []loan.Loan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByGroupLoanIDLockTx")
+	defer span.End()
+
+	var model loan.Loan
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"group_loan_id": groupLoanID,
+			"deleted_at":    nil,
+		}).
+		OrderBy("id ASC").
+		Suffix("FOR UPDATE")
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Raw(qry, args...).Scan(&loans).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetByBorrowerID is limited to the branches of the request's scope like GetByID
+func (r *loanRepo) GetByBorrowerID(ctx context.Context, borrowerID uuid.UUID) (loans []loan.Loan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByBorrowerID")
+	defer span.End()
+
+	var model loan.Loan
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Eq{
+			"borrower_id": borrowerID,
+			"deleted_at":  nil,
+		}).
+		OrderBy("created_at ASC", "id ASC")
+	builder = branch.ApplyScope(ctx, builder, "branch_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&loans).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
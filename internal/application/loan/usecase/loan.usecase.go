@@ -2,35 +2,63 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/BagusAK95/amarta_test/internal/config"
 	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/amortization"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/eligibility"
 	"github.com/BagusAK95/amarta_test/internal/domain/employee"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
 	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/pricing"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	"github.com/BagusAK95/amarta_test/internal/infrastructure/bus"
 	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
 )
 
 var tracerName = "LoanUsecase"
 var tracer = otel.Tracer(tracerName)
 
 type loanUsecase struct {
-	loanRepo     loan.ILoanRepository
-	borrowerRepo borrower.IBorrowerRepository
-	employeeRepo employee.IEmployeeRepository
+	loanRepo           loan.ILoanRepository
+	approvalPolicyRepo loan.IApprovalPolicyRepository
+	approvalVoteRepo   loan.IApprovalVoteRepository
+	borrowerRepo       borrower.IBorrowerRepository
+	employeeRepo       employee.IEmployeeRepository
+	installmentRepo    installment.IInstallmentRepository
+	eligibilityUsecase eligibility.IEligibilityUsecase
+	scoringUsecase     scoring.IScoringUsecase
+	pricingUsecase     pricing.IPricingUsecase
+	ledgerUsecase      ledger.ILedgerUsecase
+	loanBus            bus.Bus[loan.LoanApprovedEvent]
 }
 
-func NewLoanUsecase(loanRepo loan.ILoanRepository, borrowerRepo borrower.IBorrowerRepository, employeeRepo employee.IEmployeeRepository) loan.ILoanUsecase {
+func NewLoanUsecase(loanRepo loan.ILoanRepository, approvalPolicyRepo loan.IApprovalPolicyRepository, approvalVoteRepo loan.IApprovalVoteRepository, borrowerRepo borrower.IBorrowerRepository, employeeRepo employee.IEmployeeRepository, installmentRepo installment.IInstallmentRepository, eligibilityUsecase eligibility.IEligibilityUsecase, scoringUsecase scoring.IScoringUsecase, pricingUsecase pricing.IPricingUsecase, ledgerUsecase ledger.ILedgerUsecase, loanBus bus.Bus[loan.LoanApprovedEvent]) loan.ILoanUsecase {
 	return &loanUsecase{
-		loanRepo:     loanRepo,
-		borrowerRepo: borrowerRepo,
-		employeeRepo: employeeRepo,
+		loanRepo:           loanRepo,
+		approvalPolicyRepo: approvalPolicyRepo,
+		approvalVoteRepo:   approvalVoteRepo,
+		borrowerRepo:       borrowerRepo,
+		employeeRepo:       employeeRepo,
+		installmentRepo:    installmentRepo,
+		eligibilityUsecase: eligibilityUsecase,
+		scoringUsecase:     scoringUsecase,
+		pricingUsecase:     pricingUsecase,
+		ledgerUsecase:      ledgerUsecase,
+		loanBus:            loanBus,
 	}
 }
 
-func (u *loanUsecase) CreateLoan(ctx context.Context, req loan.CreateLoanRequest) (*loan.Loan, error) {
+func (u *loanUsecase) CreateLoan(ctx context.Context, employeeID uuid.UUID, req loan.CreateLoanRequest) (*loan.Loan, error) {
 	ctx, span := tracer.Start(ctx, tracerName+".CreateLoan")
 	defer span.End()
 
@@ -41,13 +69,49 @@ func (u *loanUsecase) CreateLoan(ctx context.Context, req loan.CreateLoanRequest
 		return nil, httpError.NewNotFoundError("borrower not found")
 	}
 
-	newLoan, err := u.loanRepo.Create(ctx, loan.Loan{
-		BorrowerID:         req.BorrowerID,
+	checks, err := u.eligibilityUsecase.Evaluate(ctx, borrower, req.PrincipalAmount)
+	if err != nil {
+		return nil, err
+	} else if !checks.Passed() {
+		return nil, httpError.NewBadRequestError("borrower is not eligible for the loan", checks.Failures()...)
+	}
+
+	score, err := u.scoringUsecase.Score(ctx, borrower, req.PrincipalAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	method := req.AmortizationMethod
+	if method == "" {
+		method = amortization.MethodFlat
+	}
+
+	quote, err := u.pricingUsecase.Price(ctx, score.Grade, pricing.Input{
 		PrincipalAmount:    req.PrincipalAmount,
+		Tenor:              req.Tenor,
+		RepaymentFrequency: req.RepaymentFrequency,
+		AmortizationMethod: method,
 		Rate:               req.Rate,
 		ROI:                req.ROI,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	newLoan, err := u.loanRepo.Create(ctx, loan.Loan{
+		BorrowerID:         req.BorrowerID,
+		BranchID:           borrower.BranchID,
+		PrincipalAmount:    req.PrincipalAmount,
+		Rate:               quote.Rate,
+		ROI:                quote.ROI,
+		Tenor:              req.Tenor,
+		RepaymentFrequency: req.RepaymentFrequency,
+		AmortizationMethod: method,
 		AgreementLetterURL: req.AgreementLetterURL,
 		State:              loan.StateProposed,
+		ProposedBy:         &employeeID,
+		EligibilityChecks:  checks,
+		RiskAssessment:     score.Assessment(time.Now()),
 	})
 	if err != nil {
 		return nil, err
@@ -56,74 +120,339 @@ func (u *loanUsecase) CreateLoan(ctx context.Context, req loan.CreateLoanRequest
 	return &newLoan, nil
 }
 
-func (u *loanUsecase) RejectLoan(ctx context.Context, loanID uuid.UUID, rejectReason string) (*loan.Loan, error) {
-	ctx, span := tracer.Start(ctx, tracerName+".RejectLoan")
+// QuoteLoan scores the borrower and prices the loan the same way CreateLoan does, without the eligibility rules
+func (u *loanUsecase) QuoteLoan(ctx context.Context, req loan.QuoteLoanRequest) (*loan.LoanQuoteResponse, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".QuoteLoan")
 	defer span.End()
 
-	validLoan, err := u.loanRepo.GetByID(ctx, loanID)
+	borrower, err := u.borrowerRepo.GetByID(ctx, req.BorrowerID)
 	if err != nil {
 		return nil, err
-	} else if validLoan.ID == uuid.Nil {
-		return nil, httpError.NewNotFoundError("loan not found")
-	} else if validLoan.State != loan.StateProposed {
-		return nil, httpError.NewBadRequestError("loan is not in proposed state")
+	} else if borrower.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("borrower not found")
 	}
 
-	updatedLoan, err := u.loanRepo.UpdateWithMap(ctx, loanID, map[string]any{
-		"state":         loan.StateRejected,
-		"reject_reason": rejectReason,
+	score, err := u.scoringUsecase.Score(ctx, borrower, req.PrincipalAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	method := req.AmortizationMethod
+	if method == "" {
+		method = amortization.MethodFlat
+	}
+
+	quote, err := u.pricingUsecase.Price(ctx, score.Grade, pricing.Input{
+		PrincipalAmount:    req.PrincipalAmount,
+		Tenor:              req.Tenor,
+		RepaymentFrequency: req.RepaymentFrequency,
+		AmortizationMethod: method,
+		Rate:               req.Rate,
+		ROI:                req.ROI,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &updatedLoan, nil
+	return &loan.LoanQuoteResponse{
+		BorrowerID:         req.BorrowerID,
+		PrincipalAmount:    req.PrincipalAmount,
+		Tenor:              req.Tenor,
+		RepaymentFrequency: req.RepaymentFrequency,
+		AmortizationMethod: method,
+		CreditScore:        score.Score,
+		RiskGrade:          string(score.Grade),
+		ScorecardVersion:   score.ScorecardVersion,
+		Rate:               quote.Rate,
+		ROI:                quote.ROI,
+		SuggestedRate:      quote.Suggested.Rate,
+		SuggestedROI:       quote.Suggested.ROI,
+		Spread:             quote.Spread,
+		TotalInterest:      quote.TotalInterest,
+		InvestorReturn:     quote.InvestorReturn,
+		PlatformRevenue:    quote.PlatformRevenue,
+		APR:                quote.APR,
+		EIR:                quote.EIR,
+	}, nil
+}
+
+func (u *loanUsecase) RejectLoan(ctx context.Context, loanID uuid.UUID, employeeID uuid.UUID, rejectReason string) (res *loan.Loan, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".RejectLoan")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.loanRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.loanRepo.Rollback(trx)
+			return
+		}
+
+		u.loanRepo.Commit(trx)
+	}()
+
+	validLoan, err := u.getIndividualLoanLockTx(ctx, loanID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedLoans, err := u.RejectLoansWithTx(ctx, []loan.Loan{validLoan}, employeeID, rejectReason, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedLoans[0], nil
 }
 
-func (u *loanUsecase) ApproveLoan(ctx context.Context, loanID uuid.UUID, req loan.ApproveLoanRequest) (*loan.Loan, error) {
+// RejectLoansWithTx rejects every loan or none of them. The loans must be locked within trx.
+func (u *loanUsecase) RejectLoansWithTx(ctx context.Context, loans []loan.Loan, employeeID uuid.UUID, rejectReason string, trx *gorm.DB) ([]loan.Loan, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".RejectLoansWithTx")
+	defer span.End()
+
+	changes := make([]loan.StateChange, 0, len(loans))
+	for _, validLoan := range loans {
+		change, err := validLoan.Transition(loan.EventReject, loan.TransitionInput{
+			Actor:  loan.EmployeeActor(employeeID),
+			Reason: rejectReason,
+		})
+		if err != nil {
+			return nil, httpError.NewBadRequestError(err.Error())
+		}
+
+		changes = append(changes, change)
+	}
+
+	updatedLoans := make([]loan.Loan, 0, len(loans))
+	for i, validLoan := range loans {
+		updatedLoan, err := u.loanRepo.TransitionWithTx(ctx, validLoan.ID, changes[i], map[string]any{
+			"reject_reason": rejectReason,
+			"rejected_by":   employeeID,
+		}, trx)
+		if err != nil {
+			return nil, err
+		}
+
+		updatedLoans = append(updatedLoans, updatedLoan)
+	}
+
+	return updatedLoans, nil
+}
+
+// ApproveLoan records the approval vote of the validator. The loan moves to approved once the votes satisfy the
+// approval policy for its amount.
+func (u *loanUsecase) ApproveLoan(ctx context.Context, loanID uuid.UUID, employeeID uuid.UUID, req loan.ApproveLoanRequest) (res *loan.Loan, err error) {
 	ctx, span := tracer.Start(ctx, tracerName+".ApproveLoan")
 	defer span.End()
 
-	validLoan, err := u.loanRepo.GetByID(ctx, loanID)
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	approved := false
+	trx := u.loanRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.loanRepo.Rollback(trx)
+			return
+		}
+
+		u.loanRepo.Commit(trx)
+
+		if !approved {
+			return
+		}
+
+		u.loanBus.Publish("loan.approved", loan.LoanApprovedEvent{
+			LoanID: loanID,
+		})
+	}()
+
+	validLoan, err := u.getIndividualLoanLockTx(ctx, loanID, trx)
 	if err != nil {
 		return nil, err
-	} else if validLoan.ID == uuid.Nil {
-		return nil, httpError.NewNotFoundError("loan not found")
-	} else if validLoan.State != loan.StateProposed {
-		return nil, httpError.NewBadRequestError("loan is not in proposed state")
 	}
 
-	validEmployee, err := u.employeeRepo.GetByID(ctx, req.ValidatorEmployeeID)
+	updatedLoans, approved, err := u.ApproveLoansWithTx(ctx, []loan.Loan{validLoan}, employeeID, req, trx)
 	if err != nil {
 		return nil, err
+	}
+
+	return &updatedLoans[0], nil
+}
+
+// ApproveLoansWithTx records the validator's vote on every loan and approves them together once the votes satisfy the
+// approval policy for their total amount. The loans must be locked within trx, and the caller publishes the approved
+// events after committing.
+func (u *loanUsecase) ApproveLoansWithTx(ctx context.Context, loans []loan.Loan, employeeID uuid.UUID, req loan.ApproveLoanRequest, trx *gorm.DB) ([]loan.Loan, bool, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ApproveLoansWithTx")
+	defer span.End()
+
+	changes := make([]loan.StateChange, 0, len(loans))
+	totalAmount := money.Money(0)
+	for _, validLoan := range loans {
+		change, err := validLoan.Transition(loan.EventApprove, loan.TransitionInput{
+			Actor: loan.EmployeeActor(employeeID),
+		})
+		if err != nil {
+			return nil, false, httpError.NewBadRequestError(err.Error())
+		}
+
+		changes = append(changes, change)
+		totalAmount += validLoan.PrincipalAmount
+	}
+
+	err := u.checkOnBehalfOf(ctx, employeeID, req.ValidatorEmployeeID, "validator")
+	if err != nil {
+		return nil, false, err
+	}
+
+	validEmployee, err := u.employeeRepo.GetByIDWithRoles(ctx, req.ValidatorEmployeeID)
+	if err != nil {
+		return nil, false, err
 	} else if validEmployee.ID == uuid.Nil {
-		return nil, httpError.NewNotFoundError("validator employee not found")
+		return nil, false, httpError.NewNotFoundError("validator employee not found")
 	}
 
-	updatedLoan, err := u.loanRepo.UpdateWithMap(ctx, loanID, map[string]any{
-		"state":                   loan.StateApproved,
-		"approval_date":           time.Now(),
-		"validator_employee_id":   req.ValidatorEmployeeID,
-		"visit_proof_picture_url": req.VisitProofPictureURL,
-	})
+	policy, err := u.approvalPolicyRepo.GetByAmount(ctx, totalAmount)
+	if err != nil {
+		return nil, false, err
+	}
+
+	progresses := make([]loan.ApprovalProgress, 0, len(loans))
+	for _, validLoan := range loans {
+		votes, err := u.approvalVoteRepo.GetByLoanIDWithTx(ctx, validLoan.ID, trx)
+		if err != nil {
+			return nil, false, err
+		}
+
+		progress := loan.NewApprovalProgress(policy, votes)
+		if progress.HasVoted(req.ValidatorEmployeeID) {
+			return nil, false, httpError.NewBadRequestError("validator employee has already approved this loan")
+		} else if progress.HasRecorded(employeeID) {
+			return nil, false, httpError.NewBadRequestError("employee has already recorded an approval of this loan")
+		} else if !progress.AcceptsRoles(validEmployee.RoleNames()) {
+			return nil, false, httpError.NewForbiddenError(fmt.Sprintf("validator employee must have one of the roles %s to approve this loan", strings.Join(progress.RequiredRoles, ", ")))
+		}
+
+		vote, err := u.approvalVoteRepo.CreateWithTx(ctx, loan.ApprovalVote{
+			LoanID:               validLoan.ID,
+			ValidatorEmployeeID:  req.ValidatorEmployeeID,
+			VisitProofPictureURL: req.VisitProofPictureURL,
+			RecordedBy:           employeeID,
+		}, trx)
+		if err != nil {
+			return nil, false, err
+		}
+
+		progresses = append(progresses, loan.NewApprovalProgress(policy, append(votes, vote)))
+	}
+
+	// Loans approved together always receive the same votes, so they complete together
+	if !progresses[0].Complete() {
+		for i := range loans {
+			loans[i].ApprovalProgress = &progresses[i]
+		}
+
+		return loans, false, nil
+	}
+
+	updatedLoans := make([]loan.Loan, 0, len(loans))
+	for i, validLoan := range loans {
+		// The score is refreshed with the repayments made since the loan was proposed
+		data, err := u.rescoreLoan(ctx, validLoan)
+		if err != nil {
+			return nil, false, err
+		}
+
+		data["approval_date"] = time.Now()
+		data["validator_employee_id"] = req.ValidatorEmployeeID
+		data["visit_proof_picture_url"] = req.VisitProofPictureURL
+		data["approved_by"] = employeeID
+
+		updatedLoan, err := u.loanRepo.TransitionWithTx(ctx, validLoan.ID, changes[i], data, trx)
+		if err != nil {
+			return nil, false, err
+		}
+
+		updatedLoan.ApprovalProgress = &progresses[i]
+		updatedLoans = append(updatedLoans, updatedLoan)
+	}
+
+	return updatedLoans, true, nil
+}
+
+// rescoreLoan returns the loan columns of a fresh risk assessment
+func (u *loanUsecase) rescoreLoan(ctx context.Context, l loan.Loan) (map[string]any, error) {
+	borrower, err := u.borrowerRepo.GetByID(ctx, l.BorrowerID)
+	if err != nil {
+		return nil, err
+	} else if borrower.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("borrower not found")
+	}
+
+	score, err := u.scoringUsecase.Score(ctx, borrower, l.PrincipalAmount)
 	if err != nil {
 		return nil, err
 	}
 
-	return &updatedLoan, nil
+	return score.Assessment(time.Now()).Columns(), nil
 }
 
-func (u *loanUsecase) DisburseLoan(ctx context.Context, loanID uuid.UUID, req loan.DisburseLoanRequest) (*loan.Loan, error) {
+func (u *loanUsecase) DisburseLoan(ctx context.Context, loanID uuid.UUID, employeeID uuid.UUID, req loan.DisburseLoanRequest) (res *loan.Loan, err error) {
 	ctx, span := tracer.Start(ctx, tracerName+".DisburseLoan")
 	defer span.End()
 
-	validLoan, err := u.loanRepo.GetByID(ctx, loanID)
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.loanRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.loanRepo.Rollback(trx)
+			return
+		}
+
+		u.loanRepo.Commit(trx)
+	}()
+
+	validLoan, err := u.getIndividualLoanLockTx(ctx, loanID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedLoans, err := u.DisburseLoansWithTx(ctx, []loan.Loan{validLoan}, employeeID, req, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedLoans[0], nil
+}
+
+// DisburseLoansWithTx disburses every loan or none of them, generating their installments and booking the payouts.
+// The loans must be locked within trx.
+func (u *loanUsecase) DisburseLoansWithTx(ctx context.Context, loans []loan.Loan, employeeID uuid.UUID, req loan.DisburseLoanRequest, trx *gorm.DB) ([]loan.Loan, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".DisburseLoansWithTx")
+	defer span.End()
+
+	changes := make([]loan.StateChange, 0, len(loans))
+	for _, validLoan := range loans {
+		change, err := validLoan.Transition(loan.EventDisburse, loan.TransitionInput{
+			Actor: loan.EmployeeActor(employeeID),
+		})
+		if err != nil {
+			return nil, httpError.NewBadRequestError(err.Error())
+		} else if validLoan.Tenor <= 0 {
+			// Loans proposed before tenors were recorded have no schedule to disburse against
+			return nil, httpError.NewBadRequestError("loan has no tenor, it must be set before the loan is disbursed")
+		}
+
+		changes = append(changes, change)
+	}
+
+	err := u.checkOnBehalfOf(ctx, employeeID, req.OfficerEmployeeID, "officer")
 	if err != nil {
 		return nil, err
-	} else if validLoan.ID == uuid.Nil {
-		return nil, httpError.NewNotFoundError("loan not found")
-	} else if validLoan.State != loan.StateInvested {
-		return nil, httpError.NewBadRequestError("loan is not in invested state")
 	}
 
 	validEmployee, err := u.employeeRepo.GetByID(ctx, req.OfficerEmployeeID)
@@ -133,17 +462,94 @@ func (u *loanUsecase) DisburseLoan(ctx context.Context, loanID uuid.UUID, req lo
 		return nil, httpError.NewNotFoundError("officer employee not found")
 	}
 
-	updatedLoan, err := u.loanRepo.UpdateWithMap(ctx, loanID, map[string]any{
-		"state":                loan.StateDisbursed,
-		"disbursement_date":    req.DisbursementDate,
-		"officer_employee_id":  req.OfficerEmployeeID,
-		"signed_agreement_url": req.SignedAgreementURL,
-	})
+	updatedLoans := make([]loan.Loan, 0, len(loans))
+	for i, validLoan := range loans {
+		updatedLoan, err := u.loanRepo.TransitionWithTx(ctx, validLoan.ID, changes[i], map[string]any{
+			"disbursement_date":    req.DisbursementDate,
+			"officer_employee_id":  req.OfficerEmployeeID,
+			"signed_agreement_url": req.SignedAgreementURL,
+			"disbursed_by":         employeeID,
+		}, trx)
+		if err != nil {
+			return nil, err
+		}
+
+		installments, err := generateInstallments(validLoan, req.DisbursementDate)
+		if err != nil {
+			return nil, httpError.NewInternalServerError(err.Error())
+		}
+
+		err = u.installmentRepo.CreateBulkWithTx(ctx, installments, trx)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = u.ledgerUsecase.PostWithTx(ctx, ledger.JournalEntry{
+			ReferenceType: ledger.ReferenceDisbursement,
+			ReferenceID:   validLoan.ID,
+			Description:   "Loan disbursement",
+			Postings: []ledger.Posting{
+				ledger.Debit(ledger.AccountBorrowerReceivable, &validLoan.ID, validLoan.PrincipalAmount),
+				ledger.Credit(ledger.AccountSettlementCash, nil, validLoan.PrincipalAmount),
+			},
+		}, trx)
+		if err != nil {
+			return nil, err
+		}
+
+		updatedLoans = append(updatedLoans, updatedLoan)
+	}
+
+	return updatedLoans, nil
+}
+
+// getIndividualLoanLockTx locks a loan that is not part of a group loan, which is only moved together with its group
+func (u *loanUsecase) getIndividualLoanLockTx(ctx context.Context, loanID uuid.UUID, trx *gorm.DB) (loan.Loan, error) {
+	validLoan, err := u.loanRepo.GetByIDLockTx(ctx, loanID, trx)
+	if err != nil {
+		return loan.Loan{}, err
+	} else if validLoan.ID == uuid.Nil {
+		return loan.Loan{}, httpError.NewNotFoundError("loan not found")
+	} else if validLoan.GroupLoanID != nil {
+		return loan.Loan{}, httpError.NewBadRequestError("loan belongs to a group loan and can only be moved with its group")
+	}
+
+	return validLoan, nil
+}
+
+// checkOnBehalfOf allows an employee to act only as themselves unless they may act on behalf of others
+func (u *loanUsecase) checkOnBehalfOf(ctx context.Context, employeeID uuid.UUID, assigneeID uuid.UUID, assignee string) error {
+	if employeeID == assigneeID {
+		return nil
+	}
+
+	actingEmployee, err := u.employeeRepo.GetByIDWithRoles(ctx, employeeID)
+	if err != nil {
+		return err
+	} else if actingEmployee.ID == uuid.Nil || !actingEmployee.HasPermission(employee.PermissionLoanActOnBehalf) {
+		return httpError.NewForbiddenError(fmt.Sprintf("%s permission is required to act on behalf of another %s employee", employee.PermissionLoanActOnBehalf, assignee))
+	}
+
+	return nil
+}
+
+func (u *loanUsecase) GetLoanHistory(ctx context.Context, loanID uuid.UUID) ([]loan.StateHistory, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetLoanHistory")
+	defer span.End()
+
+	validLoan, err := u.loanRepo.GetByID(ctx, loanID)
+	if err != nil {
+		return nil, err
+	} else if validLoan.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("loan not found")
+	}
+
+	histories, err := u.loanRepo.GetStateHistory(ctx, loanID)
 	if err != nil {
 		return nil, err
 	}
 
-	return &updatedLoan, nil
+	return histories, nil
 }
 
 func (u *loanUsecase) DetailLoan(ctx context.Context, loanID uuid.UUID) (*loan.Loan, error) {
@@ -157,6 +563,21 @@ func (u *loanUsecase) DetailLoan(ctx context.Context, loanID uuid.UUID) (*loan.L
 		return nil, httpError.NewNotFoundError("loan not found")
 	}
 
+	if validLoan.State == loan.StateProposed {
+		policy, err := u.approvalPolicyRepo.GetByAmount(ctx, validLoan.PrincipalAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		votes, err := u.approvalVoteRepo.GetByLoanID(ctx, loanID)
+		if err != nil {
+			return nil, err
+		}
+
+		progress := loan.NewApprovalProgress(policy, votes)
+		validLoan.ApprovalProgress = &progress
+	}
+
 	return &validLoan, nil
 }
 
@@ -188,6 +609,8 @@ func (u *loanUsecase) GetLoanAgreementDetail(ctx context.Context, loanID uuid.UU
 		return nil, httpError.NewNotFoundError("loan not found")
 	} else if loanData.State == loan.StateProposed || loanData.State == loan.StateApproved {
 		return nil, httpError.NewNotFoundError("loan not found")
+	} else if loanData.Tenor <= 0 {
+		return nil, httpError.NewBadRequestError("loan has no tenor, its agreement has no repayment schedule")
 	}
 
 	borrowerData, err := u.borrowerRepo.GetByID(ctx, loanData.BorrowerID)
@@ -197,10 +620,69 @@ func (u *loanUsecase) GetLoanAgreementDetail(ctx context.Context, loanID uuid.UU
 		return nil, httpError.NewNotFoundError("borrower not found")
 	}
 
+	terms := loanData.AmortizationTerms()
+	schedule, err := amortization.New(terms)
+	if err != nil {
+		return nil, httpError.NewInternalServerError(err.Error())
+	}
+
 	return &loan.LoanAgreementResponse{
-		LoanID:          loanData.ID,
-		PrincipalAmount: loanData.PrincipalAmount,
-		InterestRate:    loanData.Rate,
-		BorrowerName:    borrowerData.FullName,
+		LoanID:             loanData.ID,
+		PrincipalAmount:    loanData.PrincipalAmount,
+		InterestRate:       loanData.Rate,
+		Tenor:              loanData.Tenor,
+		RepaymentFrequency: loanData.RepaymentFrequency,
+		AmortizationMethod: terms.Method,
+		BorrowerName:       borrowerData.FullName,
+		RiskGrade:          loanData.RiskAssessment.RiskGrade,
+		CreditScore:        loanData.RiskAssessment.CreditScore,
+		TotalInterest:      schedule.TotalInterest(),
+		TotalRepayment:     schedule.TotalPayment(),
+		APR:                schedule.APR(),
+		EIR:                schedule.EIR(),
+		Schedule:           schedule.Installments,
 	}, nil
 }
+
+func (u *loanUsecase) GetLoanSchedule(ctx context.Context, loanID uuid.UUID) ([]installment.Installment, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetLoanSchedule")
+	defer span.End()
+
+	validLoan, err := u.loanRepo.GetByID(ctx, loanID)
+	if err != nil {
+		return nil, err
+	} else if validLoan.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("loan not found")
+	}
+
+	installments, err := u.installmentRepo.GetByLoanID(ctx, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	return installments, nil
+}
+
+// generateInstallments builds the repayment schedule of the loan with its amortization method, the n-th
+// installment falling due n periods after disbursement
+func generateInstallments(l loan.Loan, disbursementDate time.Time) ([]installment.Installment, error) {
+	schedule, err := amortization.New(l.AmortizationTerms())
+	if err != nil {
+		return nil, err
+	}
+
+	installments := make([]installment.Installment, 0, len(schedule.Installments))
+	for _, row := range schedule.Installments {
+		installments = append(installments, installment.Installment{
+			LoanID:             l.ID,
+			Sequence:           row.Period,
+			DueDate:            l.RepaymentFrequency.DueDate(disbursementDate, row.Period),
+			PrincipalAmount:    row.Principal,
+			InterestAmount:     row.Interest,
+			OutstandingBalance: row.Balance,
+			Status:             installment.StatusUnpaid,
+		})
+	}
+
+	return installments, nil
+}
@@ -3,34 +3,53 @@ package usecase_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/BagusAK95/amarta_test/internal/application/loan/usecase"
 	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
 	borrowerMock "github.com/BagusAK95/amarta_test/internal/domain/borrower/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/amortization"
 	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	eligibilityMock "github.com/BagusAK95/amarta_test/internal/domain/eligibility/mock"
 	"github.com/BagusAK95/amarta_test/internal/domain/employee"
 	employeeMock "github.com/BagusAK95/amarta_test/internal/domain/employee/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	installmentMock "github.com/BagusAK95/amarta_test/internal/domain/installment/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	ledgerMock "github.com/BagusAK95/amarta_test/internal/domain/ledger/mock"
 	"github.com/BagusAK95/amarta_test/internal/domain/loan"
 	loanMock "github.com/BagusAK95/amarta_test/internal/domain/loan/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/pricing"
+	pricingMock "github.com/BagusAK95/amarta_test/internal/domain/pricing/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	scoringMock "github.com/BagusAK95/amarta_test/internal/domain/scoring/mock"
+	busMock "github.com/BagusAK95/amarta_test/internal/infrastructure/bus/mock"
 	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 func TestCreateLoan(t *testing.T) {
 	ctx := context.Background()
 	borrowerID := uuid.New()
+	employeeID := uuid.New()
 	req := loan.CreateLoanRequest{
-		BorrowerID:      borrowerID,
-		PrincipalAmount: 1000,
-		Rate:            0.1,
-		ROI:             100,
+		BorrowerID:         borrowerID,
+		PrincipalAmount:    1000,
+		Tenor:              10,
+		RepaymentFrequency: loan.FrequencyWeekly,
 	}
+	priceInput := pricing.Input{PrincipalAmount: 1000, Tenor: 10, RepaymentFrequency: loan.FrequencyWeekly, AmortizationMethod: amortization.MethodFlat}
 	borrowerData := borrower.Borrower{
 		BaseModel: model.BaseModel{ID: borrowerID},
 		FullName:  "test borrower",
+		Status:    borrower.StatusActive,
+		KYCStatus: borrower.KYCStatusVerified,
+		BranchID:  uuid.New(),
 	}
 	loanData := loan.Loan{
 		BaseModel:       model.BaseModel{ID: uuid.New()},
@@ -40,34 +59,64 @@ func TestCreateLoan(t *testing.T) {
 		ROI:             100,
 		State:           loan.StateProposed,
 	}
+	eligibleChecks := loan.EligibilityChecks{
+		{Rule: "borrower_status", Passed: true, Reason: "borrower is active and KYC is verified"},
+	}
+	scoreResult := scoring.Result{Score: 72, Grade: scoring.GradeB, ScorecardVersion: 1}
+	quote := pricing.Quote{Terms: pricing.Terms{Rate: 20, ROI: 16}, RiskGrade: scoring.GradeB}
 
 	t.Run("success", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
-		loanRepo.On("Create", mock.Anything, mock.AnythingOfType("loan.Loan")).Return(loanData, nil)
-
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.CreateLoan(ctx, req)
+		eligibilityUsecase.On("Evaluate", mock.Anything, borrowerData, req.PrincipalAmount).Return(eligibleChecks, nil)
+		scoringUsecase.On("Score", mock.Anything, borrowerData, req.PrincipalAmount).Return(scoreResult, nil)
+		pricingUsecase.On("Price", mock.Anything, scoring.GradeB, priceInput).Return(quote, nil)
+		loanRepo.On("Create", mock.Anything, mock.MatchedBy(func(l loan.Loan) bool {
+			return *l.ProposedBy == employeeID && l.State == loan.StateProposed && l.BranchID == borrowerData.BranchID &&
+				l.Rate == 20 && l.ROI == 16 && l.AmortizationMethod == amortization.MethodFlat &&
+				assert.ObjectsAreEqual(eligibleChecks, l.EligibilityChecks) &&
+				*l.RiskAssessment.CreditScore == 72 && *l.RiskAssessment.RiskGrade == "B" && *l.RiskAssessment.ScorecardVersion == 1
+		})).Return(loanData, nil)
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.CreateLoan(ctx, employeeID, req)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		assert.Equal(t, loanData.ID, res.ID)
 		borrowerRepo.AssertExpectations(t)
 		loanRepo.AssertExpectations(t)
+		scoringUsecase.AssertExpectations(t)
 	})
 
 	t.Run("borrower not found", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrower.Borrower{}, nil)
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.CreateLoan(ctx, req)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.CreateLoan(ctx, employeeID, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
@@ -75,16 +124,114 @@ func TestCreateLoan(t *testing.T) {
 		borrowerRepo.AssertExpectations(t)
 	})
 
+	t.Run("borrower not eligible", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
+		eligibilityUsecase.On("Evaluate", mock.Anything, borrowerData, req.PrincipalAmount).Return(loan.EligibilityChecks{
+			{Rule: "borrower_status", Passed: true, Reason: "borrower is active and KYC is verified"},
+			{Rule: "max_active_loans", Passed: false, Reason: "borrower already has 2 active loans, the maximum is 2"},
+		}, nil)
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.CreateLoan(ctx, employeeID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("borrower is not eligible for the loan", "max_active_loans: borrower already has 2 active loans, the maximum is 2"), err)
+		loanRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("no active scorecard", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
+		eligibilityUsecase.On("Evaluate", mock.Anything, borrowerData, req.PrincipalAmount).Return(eligibleChecks, nil)
+		scoringUsecase.On("Score", mock.Anything, borrowerData, req.PrincipalAmount).Return(scoring.Result{}, httpError.NewInternalServerError("no active credit scorecard"))
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.CreateLoan(ctx, employeeID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewInternalServerError("no active credit scorecard"), err)
+		loanRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("pricing not allowed", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		rate, roi := float32(20), float32(19)
+		pricedReq := req
+		pricedReq.Rate, pricedReq.ROI = &rate, &roi
+		pricedInput := priceInput
+		pricedInput.Rate, pricedInput.ROI = &rate, &roi
+		pricingErr := httpError.NewBadRequestError("loan pricing is not allowed", "spread 1 is below the minimum of 2 points")
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
+		eligibilityUsecase.On("Evaluate", mock.Anything, borrowerData, req.PrincipalAmount).Return(eligibleChecks, nil)
+		scoringUsecase.On("Score", mock.Anything, borrowerData, req.PrincipalAmount).Return(scoreResult, nil)
+		pricingUsecase.On("Price", mock.Anything, scoring.GradeB, pricedInput).Return(pricing.Quote{}, pricingErr)
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.CreateLoan(ctx, employeeID, pricedReq)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, pricingErr, err)
+		loanRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
 	t.Run("create loan error", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
+		eligibilityUsecase.On("Evaluate", mock.Anything, borrowerData, req.PrincipalAmount).Return(eligibleChecks, nil)
+		scoringUsecase.On("Score", mock.Anything, borrowerData, req.PrincipalAmount).Return(scoreResult, nil)
+		pricingUsecase.On("Price", mock.Anything, scoring.GradeB, priceInput).Return(quote, nil)
 		loanRepo.On("Create", mock.Anything, mock.AnythingOfType("loan.Loan")).Return(loan.Loan{}, assert.AnError)
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.CreateLoan(ctx, req)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.CreateLoan(ctx, employeeID, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
@@ -93,9 +240,113 @@ func TestCreateLoan(t *testing.T) {
 	})
 }
 
+func TestQuoteLoan(t *testing.T) {
+	ctx := context.Background()
+	borrowerID := uuid.New()
+	rate := float32(21)
+	req := loan.QuoteLoanRequest{
+		BorrowerID:         borrowerID,
+		PrincipalAmount:    12_000_000,
+		Rate:               &rate,
+		Tenor:              12,
+		RepaymentFrequency: loan.FrequencyMonthly,
+		AmortizationMethod: amortization.MethodAnnuity,
+	}
+	borrowerData := borrower.Borrower{
+		BaseModel: model.BaseModel{ID: borrowerID},
+		Segment:   borrower.SegmentSmall,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
+		scoringUsecase.On("Score", mock.Anything, borrowerData, req.PrincipalAmount).Return(scoring.Result{Score: 55, Grade: scoring.GradeC, ScorecardVersion: 1}, nil)
+		pricingUsecase.On("Price", mock.Anything, scoring.GradeC, pricing.Input{
+			PrincipalAmount:    12_000_000,
+			Tenor:              12,
+			RepaymentFrequency: loan.FrequencyMonthly,
+			AmortizationMethod: amortization.MethodAnnuity,
+			Rate:               &rate,
+		}).Return(pricing.Quote{
+			Terms:           pricing.Terms{Rate: 21, ROI: 20},
+			RiskGrade:       scoring.GradeC,
+			Suggested:       pricing.Terms{Rate: 25, ROI: 20},
+			Spread:          1,
+			TotalInterest:   1_411_188,
+			InvestorReturn:  1_343_988,
+			PlatformRevenue: 67_200,
+			APR:             21,
+			EIR:             23.14,
+		}, nil)
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.QuoteLoan(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &loan.LoanQuoteResponse{
+			BorrowerID:         borrowerID,
+			PrincipalAmount:    12_000_000,
+			Tenor:              12,
+			RepaymentFrequency: loan.FrequencyMonthly,
+			AmortizationMethod: amortization.MethodAnnuity,
+			CreditScore:        55,
+			RiskGrade:          "C",
+			ScorecardVersion:   1,
+			Rate:               21,
+			ROI:                20,
+			SuggestedRate:      25,
+			SuggestedROI:       20,
+			Spread:             1,
+			TotalInterest:      1_411_188,
+			InvestorReturn:     1_343_988,
+			PlatformRevenue:    67_200,
+			APR:                21,
+			EIR:                23.14,
+		}, res)
+		eligibilityUsecase.AssertNotCalled(t, "Evaluate", mock.Anything, mock.Anything, mock.Anything)
+		loanRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("borrower not found", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrower.Borrower{}, nil)
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.QuoteLoan(ctx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("borrower not found"), err)
+		scoringUsecase.AssertNotCalled(t, "Score", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func TestRejectLoan(t *testing.T) {
 	ctx := context.Background()
 	loanID := uuid.New()
+	employeeID := uuid.New()
 	reason := "some reason"
 	loanData := loan.Loan{
 		BaseModel: model.BaseModel{ID: loanID},
@@ -104,14 +355,29 @@ func TestRejectLoan(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
-
-		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
-		loanRepo.On("UpdateWithMap", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
-
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.RejectLoan(ctx, loanID, reason)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		loanRepo.On("TransitionWithTx", mock.Anything, loanID, loan.StateChange{
+			From:   loan.StateProposed,
+			To:     loan.StateRejected,
+			Actor:  loan.EmployeeActor(employeeID),
+			Reason: reason,
+		}, map[string]any{"reject_reason": reason, "rejected_by": employeeID}, mock.Anything).Return(loanData, nil)
+		loanRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.RejectLoan(ctx, loanID, employeeID, reason)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
@@ -120,13 +386,23 @@ func TestRejectLoan(t *testing.T) {
 
 	t.Run("loan not found", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
-		loanRepo.On("GetByID", mock.Anything, loanID).Return(loan.Loan{}, nil)
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loan.Loan{}, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.RejectLoan(ctx, loanID, reason)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.RejectLoan(ctx, loanID, employeeID, reason)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
@@ -136,20 +412,148 @@ func TestRejectLoan(t *testing.T) {
 
 	t.Run("loan not in proposed state", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		loanData.State = loan.StateApproved
-		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.RejectLoan(ctx, loanID, reason)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.RejectLoan(ctx, loanID, employeeID, reason)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
 		assert.Equal(t, httpError.NewBadRequestError("loan is not in proposed state"), err)
 		loanRepo.AssertExpectations(t)
 	})
+
+	t.Run("loan belongs to a group loan", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		groupLoanID := uuid.New()
+		groupLoanData := loanData
+		groupLoanData.State = loan.StateProposed
+		groupLoanData.GroupLoanID = &groupLoanID
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(groupLoanData, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.RejectLoan(ctx, loanID, employeeID, reason)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("loan belongs to a group loan and can only be moved with its group"), err)
+		loanRepo.AssertNotCalled(t, "TransitionWithTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestApproveLoansWithTx(t *testing.T) {
+	ctx := context.Background()
+	employeeID := uuid.New()
+	req := loan.ApproveLoanRequest{
+		ValidatorEmployeeID:  employeeID,
+		VisitProofPictureURL: "https://example.com/visit.jpg",
+	}
+	loans := []loan.Loan{
+		{BaseModel: model.BaseModel{ID: uuid.New()}, PrincipalAmount: 30_000_000, State: loan.StateProposed},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, PrincipalAmount: 25_000_000, State: loan.StateProposed},
+	}
+	approverData := employee.Employee{
+		BaseModel: model.BaseModel{ID: employeeID},
+		Roles:     []employee.Role{{Name: "approver"}},
+	}
+	largeLoanPolicy := loan.ApprovalPolicy{
+		MinAmount:         50_000_000,
+		RequiredApprovals: 2,
+		RequiredRoles:     []string{"approver", "supervisor"},
+	}
+
+	t.Run("policy applies to the total amount", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, employeeID).Return(approverData, nil)
+		approvalPolicyRepo.On("GetByAmount", mock.Anything, money.Money(55_000_000)).Return(largeLoanPolicy, nil)
+		for _, l := range loans {
+			approvalVoteRepo.On("GetByLoanIDWithTx", mock.Anything, l.ID, mock.Anything).Return([]loan.ApprovalVote{}, nil)
+			approvalVoteRepo.On("CreateWithTx", mock.Anything, loan.ApprovalVote{
+				LoanID:               l.ID,
+				ValidatorEmployeeID:  employeeID,
+				VisitProofPictureURL: req.VisitProofPictureURL,
+				RecordedBy:           employeeID,
+			}, mock.Anything).Return(loan.ApprovalVote{LoanID: l.ID, ValidatorEmployeeID: employeeID}, nil)
+		}
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, approved, err := uc.ApproveLoansWithTx(ctx, loans, employeeID, req, &gorm.DB{})
+
+		assert.NoError(t, err)
+		assert.False(t, approved)
+		assert.Len(t, res, 2)
+		for _, l := range res {
+			assert.Equal(t, 1, l.ApprovalProgress.Approvals)
+			assert.Equal(t, 2, l.ApprovalProgress.RequiredApprovals)
+		}
+		approvalPolicyRepo.AssertExpectations(t)
+		approvalVoteRepo.AssertExpectations(t)
+		loanRepo.AssertNotCalled(t, "TransitionWithTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("one loan not in proposed state", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		mixedLoans := []loan.Loan{loans[0], loans[1]}
+		mixedLoans[1].State = loan.StateRejected
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, approved, err := uc.ApproveLoansWithTx(ctx, mixedLoans, employeeID, req, &gorm.DB{})
+
+		assert.Error(t, err)
+		assert.False(t, approved)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("loan is not in proposed state"), err)
+		approvalVoteRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
 }
 
 func TestApproveLoan(t *testing.T) {
@@ -157,43 +561,93 @@ func TestApproveLoan(t *testing.T) {
 	loanID := uuid.New()
 	employeeID := uuid.New()
 	req := loan.ApproveLoanRequest{
-		ValidatorEmployeeID: employeeID,
+		ValidatorEmployeeID:  employeeID,
+		VisitProofPictureURL: "https://example.com/visit.jpg",
 	}
 	loanData := loan.Loan{
-		BaseModel: model.BaseModel{ID: loanID},
-		State:     loan.StateProposed,
+		BaseModel:  model.BaseModel{ID: loanID},
+		BorrowerID: uuid.New(),
+		State:      loan.StateProposed,
+	}
+	borrowerData := borrower.Borrower{
+		BaseModel: model.BaseModel{ID: loanData.BorrowerID},
+		Segment:   borrower.SegmentMicro,
 	}
+	scoreResult := scoring.Result{Score: 58, Grade: scoring.GradeC, ScorecardVersion: 2}
 	employeeData := employee.Employee{
 		BaseModel: model.BaseModel{ID: employeeID},
 	}
+	approverData := employee.Employee{
+		BaseModel: model.BaseModel{ID: employeeID},
+		Roles:     []employee.Role{{Name: "approver"}},
+	}
+	largeLoanPolicy := loan.ApprovalPolicy{
+		MinAmount:         50_000_000,
+		RequiredApprovals: 2,
+		RequiredRoles:     []string{"approver", "supervisor"},
+	}
 
 	t.Run("success", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
-
-		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
-		employeeRepo.On("GetByID", mock.Anything, employeeID).Return(employeeData, nil)
-		loanRepo.On("UpdateWithMap", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
-
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.ApproveLoan(ctx, loanID, req)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, employeeID).Return(employeeData, nil)
+		approvalPolicyRepo.On("GetByAmount", mock.Anything, loanData.PrincipalAmount).Return(loan.ApprovalPolicy{}, nil)
+		approvalVoteRepo.On("GetByLoanIDWithTx", mock.Anything, loanID, mock.Anything).Return([]loan.ApprovalVote{}, nil)
+		approvalVoteRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.Anything).Return(loan.ApprovalVote{LoanID: loanID, ValidatorEmployeeID: employeeID}, nil)
+		borrowerRepo.On("GetByID", mock.Anything, loanData.BorrowerID).Return(borrowerData, nil)
+		scoringUsecase.On("Score", mock.Anything, borrowerData, loanData.PrincipalAmount).Return(scoreResult, nil)
+		loanRepo.On("TransitionWithTx", mock.Anything, loanID, loan.StateChange{
+			From:  loan.StateProposed,
+			To:    loan.StateApproved,
+			Actor: loan.EmployeeActor(employeeID),
+		}, mock.MatchedBy(func(data map[string]any) bool {
+			return *data["credit_score"].(*int) == 58 && *data["risk_grade"].(*string) == "C" && *data["scorecard_version"].(*int) == 2
+		}), mock.Anything).Return(loanData, nil)
+		loanRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+		loanBus.On("Publish", "loan.approved", loan.LoanApprovedEvent{LoanID: loanID})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.ApproveLoan(ctx, loanID, employeeID, req)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		loanRepo.AssertExpectations(t)
 		employeeRepo.AssertExpectations(t)
+		scoringUsecase.AssertExpectations(t)
+		loanBus.AssertExpectations(t)
 	})
 
 	t.Run("loan not found", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
-		loanRepo.On("GetByID", mock.Anything, loanID).Return(loan.Loan{}, nil)
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loan.Loan{}, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.ApproveLoan(ctx, loanID, req)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.ApproveLoan(ctx, loanID, employeeID, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
@@ -203,14 +657,24 @@ func TestApproveLoan(t *testing.T) {
 
 	t.Run("loan not in proposed state", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		loanData.State = loan.StateApproved
-		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.ApproveLoan(ctx, loanID, req)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.ApproveLoan(ctx, loanID, employeeID, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
@@ -220,15 +684,25 @@ func TestApproveLoan(t *testing.T) {
 
 	t.Run("validator employee not found", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		loanData.State = loan.StateProposed
-		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
-		employeeRepo.On("GetByID", mock.Anything, employeeID).Return(employee.Employee{}, nil)
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, employeeID).Return(employee.Employee{}, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.ApproveLoan(ctx, loanID, req)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.ApproveLoan(ctx, loanID, employeeID, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
@@ -236,6 +710,267 @@ func TestApproveLoan(t *testing.T) {
 		loanRepo.AssertExpectations(t)
 		employeeRepo.AssertExpectations(t)
 	})
+
+	t.Run("employee with act on behalf permission approves for validator", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		supervisorID := uuid.New()
+		loanData.State = loan.StateProposed
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, supervisorID).Return(employee.Employee{
+			BaseModel: model.BaseModel{ID: supervisorID},
+			Roles:     []employee.Role{{Name: "supervisor", Permissions: []string{string(employee.PermissionLoanActOnBehalf)}}},
+		}, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, employeeID).Return(employeeData, nil)
+		approvalPolicyRepo.On("GetByAmount", mock.Anything, loanData.PrincipalAmount).Return(loan.ApprovalPolicy{}, nil)
+		approvalVoteRepo.On("GetByLoanIDWithTx", mock.Anything, loanID, mock.Anything).Return([]loan.ApprovalVote{}, nil)
+		approvalVoteRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.Anything).Return(loan.ApprovalVote{LoanID: loanID, ValidatorEmployeeID: employeeID}, nil)
+		borrowerRepo.On("GetByID", mock.Anything, loanData.BorrowerID).Return(borrowerData, nil)
+		scoringUsecase.On("Score", mock.Anything, borrowerData, loanData.PrincipalAmount).Return(scoreResult, nil)
+		loanRepo.On("TransitionWithTx", mock.Anything, loanID, loan.StateChange{
+			From:  loan.StateProposed,
+			To:    loan.StateApproved,
+			Actor: loan.EmployeeActor(supervisorID),
+		}, mock.MatchedBy(func(data map[string]any) bool {
+			return data["validator_employee_id"] == employeeID && data["approved_by"] == supervisorID
+		}), mock.Anything).Return(loanData, nil)
+		loanRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+		loanBus.On("Publish", "loan.approved", loan.LoanApprovedEvent{LoanID: loanID})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.ApproveLoan(ctx, loanID, supervisorID, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		loanRepo.AssertExpectations(t)
+		employeeRepo.AssertExpectations(t)
+	})
+
+	t.Run("caller is not the validator", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		callerID := uuid.New()
+		loanData.State = loan.StateProposed
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, callerID).Return(employee.Employee{
+			BaseModel: model.BaseModel{ID: callerID},
+			Roles:     []employee.Role{{Name: "approver", Permissions: []string{string(employee.PermissionLoanApprove)}}},
+		}, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.ApproveLoan(ctx, loanID, callerID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewForbiddenError("loan.act_on_behalf permission is required to act on behalf of another validator employee"), err)
+		loanRepo.AssertExpectations(t)
+		loanRepo.AssertNotCalled(t, "TransitionWithTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		loanBus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("vote recorded until policy is satisfied", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		loanData.State = loan.StateProposed
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, employeeID).Return(approverData, nil)
+		approvalPolicyRepo.On("GetByAmount", mock.Anything, loanData.PrincipalAmount).Return(largeLoanPolicy, nil)
+		approvalVoteRepo.On("GetByLoanIDWithTx", mock.Anything, loanID, mock.Anything).Return([]loan.ApprovalVote{}, nil)
+		approvalVoteRepo.On("CreateWithTx", mock.Anything, loan.ApprovalVote{
+			LoanID:               loanID,
+			ValidatorEmployeeID:  employeeID,
+			VisitProofPictureURL: req.VisitProofPictureURL,
+			RecordedBy:           employeeID,
+		}, mock.Anything).Return(loan.ApprovalVote{LoanID: loanID, ValidatorEmployeeID: employeeID}, nil)
+		loanRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.ApproveLoan(ctx, loanID, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, loan.StateProposed, res.State)
+		assert.Equal(t, 1, res.ApprovalProgress.Approvals)
+		assert.Equal(t, 2, res.ApprovalProgress.RequiredApprovals)
+		approvalVoteRepo.AssertExpectations(t)
+		loanRepo.AssertNotCalled(t, "TransitionWithTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		loanBus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("last required vote approves the loan", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		loanData.State = loan.StateProposed
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, employeeID).Return(approverData, nil)
+		approvalPolicyRepo.On("GetByAmount", mock.Anything, loanData.PrincipalAmount).Return(largeLoanPolicy, nil)
+		approvalVoteRepo.On("GetByLoanIDWithTx", mock.Anything, loanID, mock.Anything).Return([]loan.ApprovalVote{
+			{LoanID: loanID, ValidatorEmployeeID: uuid.New()},
+		}, nil)
+		approvalVoteRepo.On("CreateWithTx", mock.Anything, mock.Anything, mock.Anything).Return(loan.ApprovalVote{LoanID: loanID, ValidatorEmployeeID: employeeID}, nil)
+		borrowerRepo.On("GetByID", mock.Anything, loanData.BorrowerID).Return(borrowerData, nil)
+		scoringUsecase.On("Score", mock.Anything, borrowerData, loanData.PrincipalAmount).Return(scoreResult, nil)
+		loanRepo.On("TransitionWithTx", mock.Anything, loanID, loan.StateChange{
+			From:  loan.StateProposed,
+			To:    loan.StateApproved,
+			Actor: loan.EmployeeActor(employeeID),
+		}, mock.Anything, mock.Anything).Return(loan.Loan{BaseModel: model.BaseModel{ID: loanID}, State: loan.StateApproved}, nil)
+		loanRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+		loanBus.On("Publish", "loan.approved", loan.LoanApprovedEvent{LoanID: loanID})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.ApproveLoan(ctx, loanID, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, loan.StateApproved, res.State)
+		assert.Equal(t, 2, res.ApprovalProgress.Approvals)
+		loanRepo.AssertExpectations(t)
+		loanBus.AssertExpectations(t)
+	})
+
+	t.Run("validator already approved", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		loanData.State = loan.StateProposed
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, employeeID).Return(approverData, nil)
+		approvalPolicyRepo.On("GetByAmount", mock.Anything, loanData.PrincipalAmount).Return(largeLoanPolicy, nil)
+		approvalVoteRepo.On("GetByLoanIDWithTx", mock.Anything, loanID, mock.Anything).Return([]loan.ApprovalVote{
+			{LoanID: loanID, ValidatorEmployeeID: employeeID},
+		}, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.ApproveLoan(ctx, loanID, employeeID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("validator employee has already approved this loan"), err)
+		approvalVoteRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("employee already recorded a vote on behalf of another validator", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		supervisorID := uuid.New()
+		loanData.State = loan.StateProposed
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, supervisorID).Return(employee.Employee{
+			BaseModel: model.BaseModel{ID: supervisorID},
+			Roles:     []employee.Role{{Name: "supervisor", Permissions: []string{string(employee.PermissionLoanActOnBehalf)}}},
+		}, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, employeeID).Return(approverData, nil)
+		approvalPolicyRepo.On("GetByAmount", mock.Anything, loanData.PrincipalAmount).Return(largeLoanPolicy, nil)
+		approvalVoteRepo.On("GetByLoanIDWithTx", mock.Anything, loanID, mock.Anything).Return([]loan.ApprovalVote{
+			{LoanID: loanID, ValidatorEmployeeID: uuid.New(), RecordedBy: supervisorID},
+		}, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.ApproveLoan(ctx, loanID, supervisorID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("employee has already recorded an approval of this loan"), err)
+		approvalVoteRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything, mock.Anything)
+		loanRepo.AssertNotCalled(t, "TransitionWithTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("validator lacks the required role", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		loanData.State = loan.StateProposed
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, employeeID).Return(employeeData, nil)
+		approvalPolicyRepo.On("GetByAmount", mock.Anything, loanData.PrincipalAmount).Return(largeLoanPolicy, nil)
+		approvalVoteRepo.On("GetByLoanIDWithTx", mock.Anything, loanID, mock.Anything).Return([]loan.ApprovalVote{}, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.ApproveLoan(ctx, loanID, employeeID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewForbiddenError("validator employee must have one of the roles approver, supervisor to approve this loan"), err)
+		approvalVoteRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
 }
 
 func TestDisburseLoan(t *testing.T) {
@@ -244,10 +979,15 @@ func TestDisburseLoan(t *testing.T) {
 	employeeID := uuid.New()
 	req := loan.DisburseLoanRequest{
 		OfficerEmployeeID: employeeID,
+		DisbursementDate:  time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
 	}
 	loanData := loan.Loan{
-		BaseModel: model.BaseModel{ID: loanID},
-		State:     loan.StateInvested,
+		BaseModel:          model.BaseModel{ID: loanID},
+		State:              loan.StateInvested,
+		PrincipalAmount:    1000000,
+		Rate:               26,
+		Tenor:              10,
+		RepaymentFrequency: loan.FrequencyWeekly,
 	}
 	employeeData := employee.Employee{
 		BaseModel: model.BaseModel{ID: employeeID},
@@ -255,31 +995,79 @@ func TestDisburseLoan(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
-
-		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		var installments []installment.Installment
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
 		employeeRepo.On("GetByID", mock.Anything, employeeID).Return(employeeData, nil)
-		loanRepo.On("UpdateWithMap", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
-
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.DisburseLoan(ctx, loanID, req)
+		loanRepo.On("TransitionWithTx", mock.Anything, loanID, loan.StateChange{
+			From:  loan.StateInvested,
+			To:    loan.StateDisbursed,
+			Actor: loan.EmployeeActor(employeeID),
+		}, mock.Anything, mock.Anything).Return(loanData, nil)
+		installmentRepo.On("CreateBulkWithTx", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { installments = args.Get(1).([]installment.Installment) }).
+			Return(nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return entry.ReferenceType == ledger.ReferenceDisbursement && assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountBorrowerReceivable, &loanID, loanData.PrincipalAmount),
+				ledger.Credit(ledger.AccountSettlementCash, nil, loanData.PrincipalAmount),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		loanRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.DisburseLoan(ctx, loanID, employeeID, req)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
+		assert.Len(t, installments, 10)
+
+		var totalPrincipal, totalInterest money.Money
+		for i, inst := range installments {
+			assert.Equal(t, i+1, inst.Sequence)
+			assert.Equal(t, req.DisbursementDate.AddDate(0, 0, 7*(i+1)), inst.DueDate)
+			totalPrincipal += inst.PrincipalAmount
+			totalInterest += inst.InterestAmount
+		}
+		assert.Equal(t, money.Money(1000000), totalPrincipal)
+		assert.Equal(t, money.Money(50000), totalInterest)
+		assert.Equal(t, money.Money(0), installments[9].OutstandingBalance)
 		loanRepo.AssertExpectations(t)
 		employeeRepo.AssertExpectations(t)
+		installmentRepo.AssertExpectations(t)
+		ledgerUsecase.AssertExpectations(t)
 	})
 
 	t.Run("loan not found", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
-		loanRepo.On("GetByID", mock.Anything, loanID).Return(loan.Loan{}, nil)
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loan.Loan{}, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.DisburseLoan(ctx, loanID, req)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.DisburseLoan(ctx, loanID, employeeID, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
@@ -289,14 +1077,24 @@ func TestDisburseLoan(t *testing.T) {
 
 	t.Run("loan not in invested state", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		loanData.State = loan.StateProposed
-		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.DisburseLoan(ctx, loanID, req)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.DisburseLoan(ctx, loanID, employeeID, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
@@ -306,15 +1104,25 @@ func TestDisburseLoan(t *testing.T) {
 
 	t.Run("officer employee not found", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		loanData.State = loan.StateInvested
-		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
 		employeeRepo.On("GetByID", mock.Anything, employeeID).Return(employee.Employee{}, nil)
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
-		res, err := uc.DisburseLoan(ctx, loanID, req)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.DisburseLoan(ctx, loanID, employeeID, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
@@ -322,6 +1130,66 @@ func TestDisburseLoan(t *testing.T) {
 		loanRepo.AssertExpectations(t)
 		employeeRepo.AssertExpectations(t)
 	})
+
+	t.Run("loan without tenor", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		legacyLoan := loanData
+		legacyLoan.State = loan.StateInvested
+		legacyLoan.Tenor = 0
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(legacyLoan, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.DisburseLoan(ctx, loanID, employeeID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("loan has no tenor, it must be set before the loan is disbursed"), err)
+		loanRepo.AssertExpectations(t)
+		installmentRepo.AssertNotCalled(t, "CreateBulkWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("caller is not the officer", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		callerID := uuid.New()
+		loanData.State = loan.StateInvested
+		loanRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		employeeRepo.On("GetByIDWithRoles", mock.Anything, callerID).Return(employee.Employee{}, nil)
+		loanRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.DisburseLoan(ctx, loanID, callerID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewForbiddenError("loan.act_on_behalf permission is required to act on behalf of another officer employee"), err)
+		loanRepo.AssertExpectations(t)
+		installmentRepo.AssertNotCalled(t, "CreateBulkWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
 }
 
 func TestListLoan(t *testing.T) {
@@ -338,12 +1206,20 @@ func TestListLoan(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		loanRepo.On("Pagination", mock.Anything, mock.Anything, page, limit).Return(pagination, nil)
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
 		res, err := uc.ListLoan(ctx, &state, page, limit)
 
 		assert.NoError(t, err)
@@ -361,18 +1237,63 @@ func TestDetailLoan(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
 		res, err := uc.DetailLoan(ctx, loanID)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		loanRepo.AssertExpectations(t)
 	})
+
+	t.Run("proposed loan exposes approval progress", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		proposedLoan := loan.Loan{BaseModel: model.BaseModel{ID: loanID}, PrincipalAmount: 100_000_000, State: loan.StateProposed}
+		votes := []loan.ApprovalVote{{LoanID: loanID, ValidatorEmployeeID: uuid.New()}}
+		loanRepo.On("GetByID", mock.Anything, loanID).Return(proposedLoan, nil)
+		approvalPolicyRepo.On("GetByAmount", mock.Anything, proposedLoan.PrincipalAmount).Return(loan.ApprovalPolicy{
+			MinAmount:         50_000_000,
+			RequiredApprovals: 2,
+			RequiredRoles:     []string{"approver"},
+		}, nil)
+		approvalVoteRepo.On("GetByLoanID", mock.Anything, loanID).Return(votes, nil)
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.DetailLoan(ctx, loanID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &loan.ApprovalProgress{
+			RequiredApprovals: 2,
+			RequiredRoles:     []string{"approver"},
+			Approvals:         1,
+			Votes:             votes,
+		}, res.ApprovalProgress)
+		approvalPolicyRepo.AssertExpectations(t)
+		approvalVoteRepo.AssertExpectations(t)
+	})
 }
 
 func TestGetLoanAgreementDetail(t *testing.T) {
@@ -380,9 +1301,14 @@ func TestGetLoanAgreementDetail(t *testing.T) {
 	loanID := uuid.New()
 	borrowerID := uuid.New()
 	loanData := loan.Loan{
-		BaseModel:  model.BaseModel{ID: loanID},
-		BorrowerID: borrowerID,
-		State:      loan.StateInvested,
+		BaseModel:          model.BaseModel{ID: loanID},
+		BorrowerID:         borrowerID,
+		PrincipalAmount:    1_000_000,
+		Rate:               12,
+		Tenor:              3,
+		RepaymentFrequency: loan.FrequencyMonthly,
+		AmortizationMethod: amortization.MethodDecliningBalance,
+		State:              loan.StateInvested,
 	}
 	borrowerData := borrower.Borrower{
 		BaseModel: model.BaseModel{ID: borrowerID},
@@ -390,29 +1316,54 @@ func TestGetLoanAgreementDetail(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
 		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrowerData, nil)
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
 		res, err := uc.GetLoanAgreementDetail(ctx, loanID)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
+		assert.Equal(t, amortization.MethodDecliningBalance, res.AmortizationMethod)
+		assert.Equal(t, 20_000, int(res.TotalInterest))
+		assert.Equal(t, 1_020_000, int(res.TotalRepayment))
+		assert.InDelta(t, 12, res.APR, 0.01)
+		assert.Equal(t, []amortization.Installment{
+			{Period: 1, Principal: 333_333, Interest: 10_000, Payment: 343_333, Balance: 666_667},
+			{Period: 2, Principal: 333_333, Interest: 6_667, Payment: 340_000, Balance: 333_334},
+			{Period: 3, Principal: 333_334, Interest: 3_333, Payment: 336_667, Balance: 0},
+		}, res.Schedule)
 		loanRepo.AssertExpectations(t)
 		borrowerRepo.AssertExpectations(t)
 	})
 
 	t.Run("loan not found", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		loanRepo.On("GetByID", mock.Anything, loanID).Return(loan.Loan{}, nil)
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
 		res, err := uc.GetLoanAgreementDetail(ctx, loanID)
 
 		assert.Error(t, err)
@@ -421,15 +1372,50 @@ func TestGetLoanAgreementDetail(t *testing.T) {
 		loanRepo.AssertExpectations(t)
 	})
 
+	t.Run("loan without tenor", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		legacyLoan := loanData
+		legacyLoan.Tenor = 0
+		loanRepo.On("GetByID", mock.Anything, loanID).Return(legacyLoan, nil)
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.GetLoanAgreementDetail(ctx, loanID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("loan has no tenor, its agreement has no repayment schedule"), err)
+		loanRepo.AssertExpectations(t)
+		borrowerRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+
 	t.Run("borrower not found", func(t *testing.T) {
 		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
 		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
 		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
 
 		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
 		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrower.Borrower{}, nil)
 
-		uc := usecase.NewLoanUsecase(loanRepo, borrowerRepo, employeeRepo)
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
 		res, err := uc.GetLoanAgreementDetail(ctx, loanID)
 
 		assert.Error(t, err)
@@ -439,3 +1425,121 @@ func TestGetLoanAgreementDetail(t *testing.T) {
 		borrowerRepo.AssertExpectations(t)
 	})
 }
+
+func TestGetLoanSchedule(t *testing.T) {
+	ctx := context.Background()
+	loanID := uuid.New()
+	loanData := loan.Loan{
+		BaseModel: model.BaseModel{ID: loanID},
+		State:     loan.StateDisbursed,
+	}
+	installments := []installment.Installment{
+		{BaseModel: model.BaseModel{ID: uuid.New()}, LoanID: loanID, Sequence: 1},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
+		installmentRepo.On("GetByLoanID", mock.Anything, loanID).Return(installments, nil)
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.GetLoanSchedule(ctx, loanID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, installments, res)
+		loanRepo.AssertExpectations(t)
+		installmentRepo.AssertExpectations(t)
+	})
+
+	t.Run("loan not found", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		loanRepo.On("GetByID", mock.Anything, loanID).Return(loan.Loan{}, nil)
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.GetLoanSchedule(ctx, loanID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("loan not found"), err)
+		loanRepo.AssertExpectations(t)
+	})
+}
+
+func TestGetLoanHistory(t *testing.T) {
+	ctx := context.Background()
+	loanID := uuid.New()
+	employeeID := uuid.New()
+	histories := []loan.StateHistory{
+		{LoanID: loanID, FromState: loan.StateProposed, ToState: loan.StateApproved, ActorType: loan.ActorEmployee, ActorID: &employeeID},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		loanRepo.On("GetByID", mock.Anything, loanID).Return(loan.Loan{BaseModel: model.BaseModel{ID: loanID}}, nil)
+		loanRepo.On("GetStateHistory", mock.Anything, loanID).Return(histories, nil)
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.GetLoanHistory(ctx, loanID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, histories, res)
+		loanRepo.AssertExpectations(t)
+	})
+
+	t.Run("loan not found", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		approvalPolicyRepo := new(loanMock.MockIApprovalPolicyRepository)
+		approvalVoteRepo := new(loanMock.MockIApprovalVoteRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+		employeeRepo := new(employeeMock.MockIEmployeeRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		eligibilityUsecase := new(eligibilityMock.MockIEligibilityUsecase)
+		scoringUsecase := new(scoringMock.MockIScoringUsecase)
+		pricingUsecase := new(pricingMock.MockIPricingUsecase)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		loanBus := new(busMock.MockBus[loan.LoanApprovedEvent])
+
+		loanRepo.On("GetByID", mock.Anything, loanID).Return(loan.Loan{}, nil)
+
+		uc := usecase.NewLoanUsecase(loanRepo, approvalPolicyRepo, approvalVoteRepo, borrowerRepo, employeeRepo, installmentRepo, eligibilityUsecase, scoringUsecase, pricingUsecase, ledgerUsecase, loanBus)
+		res, err := uc.GetLoanHistory(ctx, loanID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("loan not found"), err)
+		loanRepo.AssertNotCalled(t, "GetStateHistory", mock.Anything, mock.Anything)
+	})
+}
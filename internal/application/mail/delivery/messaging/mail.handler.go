@@ -3,6 +3,7 @@ package messaging
 import (
 	"context"
 
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
 	"github.com/BagusAK95/amarta_test/internal/domain/mail"
 )
 
@@ -17,7 +18,7 @@ func NewMailHandler(usecase mail.IMailUsecase) *mailHandler {
 }
 
 func (h *mailHandler) Send(msg mail.MailSendRequest) {
-	ctx := context.Background()
+	ctx := branch.WithScope(context.Background(), branch.AllBranches())
 
 	h.usecase.Send(ctx, msg)
 }
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/marketplace"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/BagusAK95/amarta_test/internal/utils/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type marketplaceHandler struct {
+	usecase   marketplace.IMarketplaceUsecase
+	validator *validator.CustomValidator
+}
+
+func NewMarketplaceHandler(usecase marketplace.IMarketplaceUsecase) *marketplaceHandler {
+	return &marketplaceHandler{
+		usecase:   usecase,
+		validator: validator.NewValidator(),
+	}
+}
+
+func (h *marketplaceHandler) ListLoan(c *gin.Context) {
+	var query marketplace.ListLoanRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(query); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid query parameter", errs...))
+		return
+	}
+
+	var page int = 1
+	if pageStr := c.Query("page"); pageStr != "" {
+		page, _ = strconv.Atoi(pageStr)
+	}
+
+	var limit int = 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, _ = strconv.Atoi(limitStr)
+	}
+
+	res, err := h.usecase.ListLoan(c.Request.Context(), query, page, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *marketplaceHandler) DetailLoan(c *gin.Context) {
+	loanID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	res, err := h.usecase.DetailLoan(c.Request.Context(), loanID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/investment"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/marketplace"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracerName = "MarketplaceUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type marketplaceUsecase struct {
+	loanRepo       loan.ILoanRepository
+	investmentRepo investment.IInvestmentRepository
+	borrowerRepo   borrower.IBorrowerRepository
+}
+
+func NewMarketplaceUsecase(loanRepo loan.ILoanRepository, investmentRepo investment.IInvestmentRepository, borrowerRepo borrower.IBorrowerRepository) marketplace.IMarketplaceUsecase {
+	return &marketplaceUsecase{
+		loanRepo:       loanRepo,
+		investmentRepo: investmentRepo,
+		borrowerRepo:   borrowerRepo,
+	}
+}
+
+func (u *marketplaceUsecase) ListLoan(ctx context.Context, req marketplace.ListLoanRequest, page int, limit int) (marketplace.LoanPagination, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ListLoan")
+	defer span.End()
+
+	loans, err := u.loanRepo.PaginationOpen(ctx, loan.ListOpenLoanFilter{
+		MinROI:    req.MinROI,
+		MaxROI:    req.MaxROI,
+		MinAmount: req.MinAmount,
+		MaxAmount: req.MaxAmount,
+	}, page, limit)
+	if err != nil {
+		return marketplace.LoanPagination{}, err
+	}
+
+	loanIDs := make([]uuid.UUID, 0, len(loans.Data))
+	borrowerIDs := make([]uuid.UUID, 0, len(loans.Data))
+	for _, l := range loans.Data {
+		loanIDs = append(loanIDs, l.ID)
+		borrowerIDs = append(borrowerIDs, l.BorrowerID)
+	}
+
+	funded := map[uuid.UUID]money.Money{}
+	borrowers := map[uuid.UUID]borrower.Borrower{}
+	if len(loans.Data) > 0 {
+		funded, err = u.investmentRepo.GetTotalInvestmentByLoanIDs(ctx, loanIDs)
+		if err != nil {
+			return marketplace.LoanPagination{}, err
+		}
+
+		borrowerList, err := u.borrowerRepo.GetByIDs(ctx, borrowerIDs)
+		if err != nil {
+			return marketplace.LoanPagination{}, err
+		}
+
+		for _, b := range borrowerList {
+			borrowers[b.ID] = b
+		}
+	}
+
+	res := marketplace.LoanPagination{
+		Data:    make([]marketplace.LoanResponse, 0, len(loans.Data)),
+		HasNext: loans.HasNext,
+		HasPrev: loans.HasPrev,
+	}
+	for _, l := range loans.Data {
+		res.Data = append(res.Data, toLoanResponse(l, borrowers[l.BorrowerID], funded[l.ID]))
+	}
+
+	return res, nil
+}
+
+func (u *marketplaceUsecase) DetailLoan(ctx context.Context, loanID uuid.UUID) (*marketplace.LoanResponse, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".DetailLoan")
+	defer span.End()
+
+	validLoan, err := u.loanRepo.GetByID(ctx, loanID)
+	if err != nil {
+		return nil, err
+	} else if validLoan.ID == uuid.Nil || validLoan.State != loan.StateApproved {
+		return nil, httpError.NewNotFoundError("loan not found")
+	}
+
+	validBorrower, err := u.borrowerRepo.GetByID(ctx, validLoan.BorrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	funded, err := u.investmentRepo.GetTotalInvestmentByLoanID(ctx, validLoan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toLoanResponse(validLoan, validBorrower, funded)
+
+	return &res, nil
+}
+
+func toLoanResponse(l loan.Loan, b borrower.Borrower, funded money.Money) marketplace.LoanResponse {
+	return marketplace.LoanResponse{
+		LoanID:             l.ID,
+		PrincipalAmount:    l.PrincipalAmount,
+		FundedAmount:       funded,
+		RemainingAmount:    l.PrincipalAmount - funded,
+		Rate:               l.Rate,
+		ROI:                l.ROI,
+		Tenor:              l.Tenor,
+		RepaymentFrequency: l.RepaymentFrequency,
+		ApprovalDate:       l.ApprovalDetails.ApprovalDate,
+		RiskGrade:          l.RiskAssessment.RiskGrade,
+		CreditScore:        l.RiskAssessment.CreditScore,
+		Borrower: marketplace.BorrowerProfile{
+			Initials:    initials(b.FullName),
+			Status:      b.Status,
+			MemberSince: b.CreatedAt,
+		},
+	}
+}
+
+// initials reduces a full name to the first letter of each word, e.g. "Budi Santoso" becomes "B.S.".
+func initials(fullName string) string {
+	var sb strings.Builder
+	for _, word := range strings.Fields(fullName) {
+		r, _ := utf8.DecodeRuneInString(word)
+		sb.WriteRune(unicode.ToUpper(r))
+		sb.WriteRune('.')
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,154 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/application/marketplace/usecase"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	borrowerMock "github.com/BagusAK95/amarta_test/internal/domain/borrower/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	investmentMock "github.com/BagusAK95/amarta_test/internal/domain/investment/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	loanMock "github.com/BagusAK95/amarta_test/internal/domain/loan/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/marketplace"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListLoan(t *testing.T) {
+	ctx := context.Background()
+	approvalDate := time.Now()
+	borrowerID := uuid.New()
+	loanID := uuid.New()
+	minROI := float32(8)
+	maxAmount := money.Money(5000000)
+	req := marketplace.ListLoanRequest{MinROI: &minROI, MaxAmount: &maxAmount}
+	loanData := loan.Loan{
+		BaseModel:          model.BaseModel{ID: loanID},
+		BorrowerID:         borrowerID,
+		PrincipalAmount:    3000000,
+		Rate:               12,
+		ROI:                9,
+		Tenor:              12,
+		RepaymentFrequency: loan.FrequencyMonthly,
+		State:              loan.StateApproved,
+		ApprovalDetails:    loan.ApprovalDetails{ApprovalDate: &approvalDate},
+	}
+	borrowerData := borrower.Borrower{
+		BaseModel:    model.BaseModel{ID: borrowerID},
+		FullName:     "budi santoso",
+		IDCardNumber: "3174012345678901",
+		Address:      "Jl. Merdeka No. 1",
+		PhoneNumber:  "081234567890",
+		Email:        "budi@example.com",
+		Status:       "active",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+
+		unfundedLoan := loanData
+		unfundedLoan.ID = uuid.New()
+		loanRepo.On("PaginationOpen", mock.Anything, loan.ListOpenLoanFilter{MinROI: &minROI, MaxAmount: &maxAmount}, 1, 10).Return(repository.Pagination[loan.Loan]{
+			Data:    []loan.Loan{loanData, unfundedLoan},
+			HasNext: true,
+		}, nil)
+		borrowerRepo.On("GetByIDs", mock.Anything, []uuid.UUID{borrowerID, borrowerID}).Return([]borrower.Borrower{borrowerData}, nil)
+		investmentRepo.On("GetTotalInvestmentByLoanIDs", mock.Anything, []uuid.UUID{loanID, unfundedLoan.ID}).Return(map[uuid.UUID]money.Money{
+			loanID: 1000000,
+		}, nil).Once()
+
+		uc := usecase.NewMarketplaceUsecase(loanRepo, investmentRepo, borrowerRepo)
+		res, err := uc.ListLoan(ctx, req, 1, 10)
+
+		assert.NoError(t, err)
+		assert.True(t, res.HasNext)
+		assert.Len(t, res.Data, 2)
+		assert.Equal(t, money.Money(1000000), res.Data[0].FundedAmount)
+		assert.Equal(t, money.Money(2000000), res.Data[0].RemainingAmount)
+		assert.Equal(t, "B.S.", res.Data[0].Borrower.Initials)
+		assert.Equal(t, money.Money(0), res.Data[1].FundedAmount)
+		assert.Equal(t, money.Money(3000000), res.Data[1].RemainingAmount)
+
+		body, err := json.Marshal(res)
+		assert.NoError(t, err)
+		for _, pii := range []string{borrowerData.FullName, borrowerData.IDCardNumber, borrowerData.Address, borrowerData.PhoneNumber, borrowerData.Email, borrowerID.String()} {
+			assert.NotContains(t, string(body), pii)
+		}
+
+		loanRepo.AssertExpectations(t)
+		borrowerRepo.AssertExpectations(t)
+		investmentRepo.AssertExpectations(t)
+	})
+
+	t.Run("empty page", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+
+		loanRepo.On("PaginationOpen", mock.Anything, mock.Anything, 2, 10).Return(repository.Pagination[loan.Loan]{HasPrev: true}, nil)
+
+		uc := usecase.NewMarketplaceUsecase(loanRepo, investmentRepo, borrowerRepo)
+		res, err := uc.ListLoan(ctx, marketplace.ListLoanRequest{}, 2, 10)
+
+		assert.NoError(t, err)
+		assert.True(t, res.HasPrev)
+		assert.Empty(t, res.Data)
+		borrowerRepo.AssertNotCalled(t, "GetByIDs", mock.Anything, mock.Anything)
+		investmentRepo.AssertNotCalled(t, "GetTotalInvestmentByLoanIDs", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDetailLoan(t *testing.T) {
+	ctx := context.Background()
+	borrowerID := uuid.New()
+	loanID := uuid.New()
+	loanData := loan.Loan{
+		BaseModel:       model.BaseModel{ID: loanID},
+		BorrowerID:      borrowerID,
+		PrincipalAmount: 3000000,
+		State:           loan.StateApproved,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+
+		loanRepo.On("GetByID", mock.Anything, loanID).Return(loanData, nil)
+		borrowerRepo.On("GetByID", mock.Anything, borrowerID).Return(borrower.Borrower{FullName: "Siti Aminah Putri"}, nil)
+		investmentRepo.On("GetTotalInvestmentByLoanID", mock.Anything, loanID).Return(money.Money(0), nil)
+
+		uc := usecase.NewMarketplaceUsecase(loanRepo, investmentRepo, borrowerRepo)
+		res, err := uc.DetailLoan(ctx, loanID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.Money(3000000), res.RemainingAmount)
+		assert.Equal(t, "S.A.P.", res.Borrower.Initials)
+	})
+
+	t.Run("loan not open for funding", func(t *testing.T) {
+		loanRepo := new(loanMock.MockILoanRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		borrowerRepo := new(borrowerMock.MockIBorrowerRepository)
+
+		investedLoan := loanData
+		investedLoan.State = loan.StateInvested
+		loanRepo.On("GetByID", mock.Anything, loanID).Return(investedLoan, nil)
+
+		uc := usecase.NewMarketplaceUsecase(loanRepo, investmentRepo, borrowerRepo)
+		res, err := uc.DetailLoan(ctx, loanID)
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("loan not found"), err)
+	})
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/pricing"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	sq "github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var tracerName = "TierRepository"
+var tracer = otel.Tracer(tracerName)
+
+type tierRepo struct {
+	repository.BaseRepo[pricing.Tier]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewTierRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) pricing.ITierRepository {
+	baseRepo := repository.NewBaseRepo[pricing.Tier](dbMaster, dbSlave)
+
+	return &tierRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+// GetByGradeAndTenor returns the tier pricing the grade at the tenor, or the zero value when there is none
+func (r *tierRepo) GetByGradeAndTenor(ctx context.Context, grade scoring.Grade, tenorMonths int) (tier pricing.Tier, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetByGradeAndTenor")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(tier.TableName()).
+		Where(sq.Eq{
+			"risk_grade": grade,
+			"deleted_at": nil,
+		}).
+		Where(sq.LtOrEq{"min_tenor_months": tenorMonths}).
+		Where(sq.GtOrEq{"max_tenor_months": tenorMonths}).
+		Limit(1)
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&tier).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/pricing"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracerName = "PricingUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type pricingUsecase struct {
+	policy   pricing.Policy
+	tierRepo pricing.ITierRepository
+}
+
+func NewPricingUsecase(policy pricing.Policy, tierRepo pricing.ITierRepository) pricing.IPricingUsecase {
+	return &pricingUsecase{
+		policy:   policy,
+		tierRepo: tierRepo,
+	}
+}
+
+// Price quotes a loan of the given risk grade from the pricing table, refusing terms the policy does not allow
+func (u *pricingUsecase) Price(ctx context.Context, grade scoring.Grade, input pricing.Input) (pricing.Quote, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".Price")
+	defer span.End()
+
+	tenorMonths := pricing.TenorMonths(input.Tenor, input.RepaymentFrequency)
+	tier, err := u.tierRepo.GetByGradeAndTenor(ctx, grade, tenorMonths)
+	if err != nil {
+		return pricing.Quote{}, err
+	} else if tier.ID == uuid.Nil {
+		return pricing.Quote{}, httpError.NewBadRequestError(fmt.Sprintf("no pricing for risk grade %s and a tenor of %d months", grade, tenorMonths))
+	}
+
+	terms, failures := u.policy.Resolve(tier, input)
+	if len(failures) > 0 {
+		return pricing.Quote{}, httpError.NewBadRequestError("loan pricing is not allowed", failures...)
+	}
+
+	quote, err := pricing.NewQuote(tier, terms, input)
+	if err != nil {
+		return pricing.Quote{}, httpError.NewBadRequestError("loan pricing is not allowed", err.Error())
+	}
+
+	return quote, nil
+}
@@ -0,0 +1,86 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BagusAK95/amarta_test/internal/application/pricing/usecase"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/pricing"
+	pricingMock "github.com/BagusAK95/amarta_test/internal/domain/pricing/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPrice(t *testing.T) {
+	ctx := context.Background()
+	policy := pricing.Policy{MinSpread: 2, MaxDeviation: 2}
+	tier := pricing.Tier{
+		BaseModel:      model.BaseModel{ID: uuid.New()},
+		RiskGrade:      scoring.GradeC,
+		MinTenorMonths: 1,
+		MaxTenorMonths: 6,
+		Rate:           24,
+		ROI:            19,
+	}
+	input := pricing.Input{PrincipalAmount: 5_200_000, Tenor: 26, RepaymentFrequency: loan.FrequencyWeekly}
+
+	t.Run("priced from the tier", func(t *testing.T) {
+		tierRepo := new(pricingMock.MockITierRepository)
+
+		tierRepo.On("GetByGradeAndTenor", mock.Anything, scoring.GradeC, 6).Return(tier, nil)
+
+		uc := usecase.NewPricingUsecase(policy, tierRepo)
+		quote, err := uc.Price(ctx, scoring.GradeC, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, pricing.Terms{Rate: 24, ROI: 19}, quote.Terms)
+		assert.Equal(t, float32(5), quote.Spread)
+		assert.Equal(t, 624_000, int(quote.TotalInterest))
+		assert.Equal(t, 494_000, int(quote.InvestorReturn))
+		assert.Equal(t, 130_000, int(quote.PlatformRevenue))
+		tierRepo.AssertExpectations(t)
+	})
+
+	t.Run("terms not allowed", func(t *testing.T) {
+		tierRepo := new(pricingMock.MockITierRepository)
+
+		rate := float32(30)
+		tierRepo.On("GetByGradeAndTenor", mock.Anything, scoring.GradeC, 6).Return(tier, nil)
+
+		uc := usecase.NewPricingUsecase(policy, tierRepo)
+		quote, err := uc.Price(ctx, scoring.GradeC, pricing.Input{PrincipalAmount: 5_200_000, Tenor: 26, RepaymentFrequency: loan.FrequencyWeekly, Rate: &rate})
+
+		assert.Error(t, err)
+		assert.Equal(t, pricing.Quote{}, quote)
+		assert.Equal(t, httpError.NewBadRequestError("loan pricing is not allowed", "rate 30 is more than 2 points from 24 for grade C"), err)
+	})
+
+	t.Run("unknown amortization method", func(t *testing.T) {
+		tierRepo := new(pricingMock.MockITierRepository)
+
+		tierRepo.On("GetByGradeAndTenor", mock.Anything, scoring.GradeC, 6).Return(tier, nil)
+
+		uc := usecase.NewPricingUsecase(policy, tierRepo)
+		_, err := uc.Price(ctx, scoring.GradeC, pricing.Input{PrincipalAmount: 5_200_000, Tenor: 26, RepaymentFrequency: loan.FrequencyWeekly, AmortizationMethod: "balloon"})
+
+		assert.Error(t, err)
+		assert.Equal(t, httpError.NewBadRequestError("loan pricing is not allowed", `amortization: unknown method "balloon"`), err)
+	})
+
+	t.Run("no tier for the tenor", func(t *testing.T) {
+		tierRepo := new(pricingMock.MockITierRepository)
+
+		tierRepo.On("GetByGradeAndTenor", mock.Anything, scoring.GradeE, 48).Return(pricing.Tier{}, nil)
+
+		uc := usecase.NewPricingUsecase(policy, tierRepo)
+		_, err := uc.Price(ctx, scoring.GradeE, pricing.Input{PrincipalAmount: 5_000_000, Tenor: 48, RepaymentFrequency: loan.FrequencyMonthly})
+
+		assert.Error(t, err)
+		assert.Equal(t, httpError.NewBadRequestError("no pricing for risk grade E and a tenor of 48 months"), err)
+	})
+}
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/repayment"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/BagusAK95/amarta_test/internal/utils/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type repaymentHandler struct {
+	usecase   repayment.IRepaymentUsecase
+	validator *validator.CustomValidator
+}
+
+func NewRepaymentHandler(usecase repayment.IRepaymentUsecase) *repaymentHandler {
+	return &repaymentHandler{
+		usecase:   usecase,
+		validator: validator.NewValidator(),
+	}
+}
+
+func (h *repaymentHandler) CreateRepayment(c *gin.Context) {
+	loanID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body repayment.CreateRepaymentRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.CreateRepayment(c.Request.Context(), loanID, employeeID.(uuid.UUID), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/repayment"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var distributionTracerName = "DistributionRepository"
+var distributionTracer = otel.Tracer(distributionTracerName)
+
+type distributionRepo struct {
+	repository.BaseRepo[repayment.Distribution]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewDistributionRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) repayment.IDistributionRepository {
+	baseRepo := repository.NewBaseRepo[repayment.Distribution](dbMaster, dbSlave)
+
+	return &distributionRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *distributionRepo) GetTotalsByInvestmentIDs(ctx context.Context, investmentIDs []uuid.UUID) (totals []repayment.InvestmentTotal, err error) {
+	ctx, span := distributionTracer.Start(ctx, distributionTracerName+".GetTotalsByInvestmentIDs")
+	defer span.End()
+
+	var model repayment.Distribution
+
+	builder := sq.
+		Select(
+			"investment_id",
+			"COALESCE(SUM(principal_amount), 0) AS principal_amount",
+			"COALESCE(SUM(return_amount), 0) AS return_amount",
+		).
+		From(model.TableName()).
+		Where(sq.Eq{
+			"investment_id": investmentIDs,
+			"deleted_at":    nil,
+		}).
+		GroupBy("investment_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&totals).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/repayment"
+	"gorm.io/gorm"
+)
+
+type repaymentRepo struct {
+	repository.BaseRepo[repayment.Repayment]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewRepaymentRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) repayment.IRepaymentRepository {
+	baseRepo := repository.NewBaseRepo[repayment.Repayment](dbMaster, dbSlave)
+
+	return &repaymentRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
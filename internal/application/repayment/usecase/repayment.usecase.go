@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	"github.com/BagusAK95/amarta_test/internal/domain/investment"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/repayment"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var tracerName = "RepaymentUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type repaymentUsecase struct {
+	repaymentRepo    repayment.IRepaymentRepository
+	distributionRepo repayment.IDistributionRepository
+	loanRepo         loan.ILoanRepository
+	installmentRepo  installment.IInstallmentRepository
+	investmentRepo   investment.IInvestmentRepository
+	ledgerUsecase    ledger.ILedgerUsecase
+}
+
+func NewRepaymentUsecase(repaymentRepo repayment.IRepaymentRepository, distributionRepo repayment.IDistributionRepository, loanRepo loan.ILoanRepository, installmentRepo installment.IInstallmentRepository, investmentRepo investment.IInvestmentRepository, ledgerUsecase ledger.ILedgerUsecase) repayment.IRepaymentUsecase {
+	return &repaymentUsecase{
+		repaymentRepo:    repaymentRepo,
+		distributionRepo: distributionRepo,
+		loanRepo:         loanRepo,
+		installmentRepo:  installmentRepo,
+		investmentRepo:   investmentRepo,
+		ledgerUsecase:    ledgerUsecase,
+	}
+}
+
+func (u *repaymentUsecase) CreateRepayment(ctx context.Context, loanID uuid.UUID, employeeID uuid.UUID, req repayment.CreateRepaymentRequest) (res *repayment.Repayment, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".CreateRepayment")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.repaymentRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.repaymentRepo.Rollback(trx)
+			return
+		}
+
+		u.repaymentRepo.Commit(trx)
+	}()
+
+	validLoan, err := u.loanRepo.GetByIDLockTx(ctx, loanID, trx)
+	if err != nil {
+		return nil, err
+	} else if validLoan.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("loan not found")
+	} else if validLoan.State != loan.StateDisbursed {
+		return nil, httpError.NewBadRequestError("loan is not in disbursed state")
+	}
+
+	unpaidInstallments, err := u.installmentRepo.GetUnpaidByLoanIDLockTx(ctx, loanID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalDue := money.Money(0)
+	for _, inst := range unpaidInstallments {
+		totalDue += inst.TotalDue()
+	}
+
+	if req.Amount > totalDue {
+		return nil, httpError.NewBadRequestError("repayment amount exceeds outstanding balance")
+	}
+
+	newRepayment := repayment.Repayment{
+		LoanID:      loanID,
+		Amount:      req.Amount,
+		PaymentDate: req.PaymentDate,
+	}
+
+	err = u.allocateRepayment(ctx, &newRepayment, unpaidInstallments, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	newRepayment, err = u.repaymentRepo.CreateWithTx(ctx, newRepayment, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.distributeRepayment(ctx, validLoan, newRepayment, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	if totalDue-req.Amount == 0 {
+		change, err := validLoan.Transition(loan.EventPayOff, loan.TransitionInput{
+			Actor:       loan.EmployeeActor(employeeID),
+			Outstanding: totalDue - req.Amount,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = u.loanRepo.TransitionWithTx(ctx, loanID, change, nil, trx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &newRepayment, nil
+}
+
+// allocateRepayment applies the repayment to the oldest installments first, settling fees, then interest, then principal.
+func (u *repaymentUsecase) allocateRepayment(ctx context.Context, rep *repayment.Repayment, unpaidInstallments []installment.Installment, trx *gorm.DB) (err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".AllocateRepayment")
+	defer span.End()
+
+	remaining := rep.Amount
+	for _, inst := range unpaidInstallments {
+		if remaining <= 0 {
+			break
+		}
+
+		fee := money.Min(remaining, inst.FeeDue())
+		remaining -= fee
+
+		interest := money.Min(remaining, inst.InterestDue())
+		remaining -= interest
+
+		principal := money.Min(remaining, inst.PrincipalDue())
+		remaining -= principal
+
+		rep.FeeAmount += fee
+		rep.InterestAmount += interest
+		rep.PrincipalAmount += principal
+
+		payload := map[string]any{
+			"paid_fee":       inst.PaidFee + fee,
+			"paid_interest":  inst.PaidInterest + interest,
+			"paid_principal": inst.PaidPrincipal + principal,
+			"status":         installment.StatusPartial,
+		}
+
+		if inst.TotalDue()-fee-interest-principal == 0 {
+			payload["status"] = installment.StatusPaid
+			payload["paid_at"] = rep.PaymentDate
+		}
+
+		_, err = u.installmentRepo.UpdateWithMapTx(ctx, inst.ID, payload, trx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// distributeRepayment credits every investor of the loan with their pro-rata share of the repaid principal and of the
+// investor return, which is the part of the repaid interest covered by the loan ROI and never more than the repaid
+// interest. Fees and the remaining interest spread are booked as platform revenue in the same journal entry.
+func (u *repaymentUsecase) distributeRepayment(ctx context.Context, validLoan loan.Loan, rep repayment.Repayment, trx *gorm.DB) (err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".DistributeRepayment")
+	defer span.End()
+
+	investments, err := u.investmentRepo.GetByLoanIDLockTx(ctx, validLoan.ID, trx)
+	if err != nil {
+		return err
+	}
+
+	returnAmount := money.Money(0)
+	if validLoan.Rate > 0 && len(investments) > 0 {
+		// Loans created before pricing tiers may have an ROI above the rate; their investors get the whole interest
+		returnAmount = money.Min(rep.InterestAmount, money.Floor(float64(rep.InterestAmount)*float64(validLoan.ROI)/float64(validLoan.Rate)))
+	}
+
+	principalShares := prorate(rep.PrincipalAmount, investments)
+	returnShares := prorate(returnAmount, investments)
+
+	postings := []ledger.Posting{
+		ledger.Debit(ledger.AccountSettlementCash, nil, rep.Amount),
+		ledger.Credit(ledger.AccountBorrowerReceivable, &validLoan.ID, rep.PrincipalAmount),
+		ledger.Credit(ledger.AccountPlatformFee, nil, rep.FeeAmount+rep.InterestAmount-returnAmount),
+	}
+
+	distributedPrincipal := money.Money(0)
+	distributions := make([]repayment.Distribution, 0, len(investments))
+	for i, inv := range investments {
+		distributedPrincipal += principalShares[i]
+		postings = append(postings, ledger.Credit(ledger.AccountInvestorCash, &investments[i].InvestorID, principalShares[i]+returnShares[i]))
+		distributions = append(distributions, repayment.Distribution{
+			RepaymentID:     rep.ID,
+			LoanID:          validLoan.ID,
+			InvestmentID:    inv.ID,
+			InvestorID:      inv.InvestorID,
+			PrincipalAmount: principalShares[i],
+			ReturnAmount:    returnShares[i],
+		})
+	}
+
+	postings = append(postings, ledger.Debit(ledger.AccountLoanFunding, &validLoan.ID, distributedPrincipal))
+
+	_, err = u.ledgerUsecase.PostWithTx(ctx, ledger.JournalEntry{
+		ReferenceType: ledger.ReferenceRepayment,
+		ReferenceID:   rep.ID,
+		Description:   "Repayment of loan " + validLoan.ID.String(),
+		Postings:      postings,
+	}, trx)
+	if err != nil {
+		return err
+	}
+
+	if len(distributions) == 0 {
+		return nil
+	}
+
+	err = u.distributionRepo.CreateBulkWithTx(ctx, distributions, trx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// prorate splits amount across investments in proportion to their amounts, rounding each share down to the rupiah.
+// The rounding remainder goes to the largest investment, or the first one by ID on a tie, so the shares always sum to amount.
+func prorate(amount money.Money, investments []investment.Investment) []money.Money {
+	shares := make([]money.Money, len(investments))
+
+	totalInvested := money.Money(0)
+	for _, inv := range investments {
+		totalInvested += inv.Amount
+	}
+
+	if amount <= 0 || totalInvested <= 0 {
+		return shares
+	}
+
+	allocated := money.Money(0)
+	largest := 0
+	for i, inv := range investments {
+		shares[i] = amount.MulDiv(inv.Amount, totalInvested)
+		allocated += shares[i]
+
+		if inv.Amount > investments[largest].Amount ||
+			(inv.Amount == investments[largest].Amount && inv.ID.String() < investments[largest].ID.String()) {
+			largest = i
+		}
+	}
+
+	shares[largest] += amount - allocated
+
+	return shares
+}
@@ -0,0 +1,368 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/application/repayment/usecase"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	installmentMock "github.com/BagusAK95/amarta_test/internal/domain/installment/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/investment"
+	investmentMock "github.com/BagusAK95/amarta_test/internal/domain/investment/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	ledgerMock "github.com/BagusAK95/amarta_test/internal/domain/ledger/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	loanMock "github.com/BagusAK95/amarta_test/internal/domain/loan/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/repayment"
+	repaymentMock "github.com/BagusAK95/amarta_test/internal/domain/repayment/mock"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateRepayment(t *testing.T) {
+	ctx := context.Background()
+	loanID := uuid.New()
+	employeeID := uuid.New()
+	firstInstallmentID := uuid.New()
+	secondInstallmentID := uuid.New()
+	paymentDate := time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC)
+	loanData := loan.Loan{
+		BaseModel: model.BaseModel{ID: loanID},
+		State:     loan.StateDisbursed,
+		Rate:      20,
+		ROI:       10,
+	}
+	unpaidInstallments := []installment.Installment{
+		{
+			BaseModel:       model.BaseModel{ID: firstInstallmentID},
+			LoanID:          loanID,
+			Sequence:        1,
+			PrincipalAmount: 1000,
+			InterestAmount:  100,
+			FeeAmount:       50,
+			PaidFee:         50,
+			PaidInterest:    40,
+			Status:          installment.StatusPartial,
+		},
+		{
+			BaseModel:       model.BaseModel{ID: secondInstallmentID},
+			LoanID:          loanID,
+			Sequence:        2,
+			PrincipalAmount: 1000,
+			InterestAmount:  100,
+			FeeAmount:       20,
+			Status:          installment.StatusUnpaid,
+		},
+	}
+
+	t.Run("success allocates to oldest installment first", func(t *testing.T) {
+		repaymentRepo := new(repaymentMock.MockIRepaymentRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		req := repayment.CreateRepaymentRequest{Amount: 1200, PaymentDate: paymentDate}
+
+		repaymentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		installmentRepo.On("GetUnpaidByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return(unpaidInstallments, nil)
+		installmentRepo.On("UpdateWithMapTx", mock.Anything, firstInstallmentID, map[string]any{
+			"paid_fee":       money.Money(50),
+			"paid_interest":  money.Money(100),
+			"paid_principal": money.Money(1000),
+			"status":         installment.StatusPaid,
+			"paid_at":        paymentDate,
+		}, mock.Anything).Return(installment.Installment{}, nil)
+		installmentRepo.On("UpdateWithMapTx", mock.Anything, secondInstallmentID, map[string]any{
+			"paid_fee":       money.Money(20),
+			"paid_interest":  money.Money(100),
+			"paid_principal": money.Money(20),
+			"status":         installment.StatusPartial,
+		}, mock.Anything).Return(installment.Installment{}, nil)
+		repaymentRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("repayment.Repayment"), mock.Anything).
+			Return(func(_ context.Context, rep repayment.Repayment, _ *gorm.DB) (repayment.Repayment, error) {
+				return rep, nil
+			})
+		investmentRepo.On("GetByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return([]investment.Investment{}, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.AnythingOfType("ledger.JournalEntry"), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		repaymentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewRepaymentUsecase(repaymentRepo, distributionRepo, loanRepo, installmentRepo, investmentRepo, ledgerUsecase)
+		res, err := uc.CreateRepayment(ctx, loanID, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		assert.Equal(t, money.Money(20), res.FeeAmount)
+		assert.Equal(t, money.Money(160), res.InterestAmount)
+		assert.Equal(t, money.Money(1020), res.PrincipalAmount)
+		repaymentRepo.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+		installmentRepo.AssertExpectations(t)
+	})
+
+	t.Run("success pays off loan", func(t *testing.T) {
+		repaymentRepo := new(repaymentMock.MockIRepaymentRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		req := repayment.CreateRepaymentRequest{Amount: 2180, PaymentDate: paymentDate}
+
+		repaymentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		installmentRepo.On("GetUnpaidByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return(unpaidInstallments, nil)
+		installmentRepo.On("UpdateWithMapTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(installment.Installment{}, nil)
+		repaymentRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("repayment.Repayment"), mock.Anything).Return(repayment.Repayment{}, nil)
+		investmentRepo.On("GetByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return([]investment.Investment{}, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.AnythingOfType("ledger.JournalEntry"), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		loanRepo.On("TransitionWithTx", mock.Anything, loanID, mock.MatchedBy(func(change loan.StateChange) bool {
+			return change.From == loan.StateDisbursed && change.To == loan.StatePaidOff && assert.ObjectsAreEqual(loan.EmployeeActor(employeeID), change.Actor)
+		}), mock.Anything, mock.Anything).Return(loan.Loan{}, nil)
+		repaymentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewRepaymentUsecase(repaymentRepo, distributionRepo, loanRepo, installmentRepo, investmentRepo, ledgerUsecase)
+		res, err := uc.CreateRepayment(ctx, loanID, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		installmentRepo.AssertNumberOfCalls(t, "UpdateWithMapTx", 2)
+		repaymentRepo.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+		installmentRepo.AssertExpectations(t)
+	})
+
+	t.Run("success distributes principal and return to investors", func(t *testing.T) {
+		repaymentRepo := new(repaymentMock.MockIRepaymentRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		repaymentID := uuid.New()
+		investors := []uuid.UUID{
+			uuid.MustParse("00000000-0000-0000-0000-00000000000a"),
+			uuid.MustParse("00000000-0000-0000-0000-00000000000b"),
+			uuid.MustParse("00000000-0000-0000-0000-00000000000c"),
+		}
+		investments := []investment.Investment{
+			{BaseModel: model.BaseModel{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}, LoanID: loanID, InvestorID: investors[0], Amount: 1000},
+			{BaseModel: model.BaseModel{ID: uuid.MustParse("00000000-0000-0000-0000-000000000002")}, LoanID: loanID, InvestorID: investors[1], Amount: 1000},
+			{BaseModel: model.BaseModel{ID: uuid.MustParse("00000000-0000-0000-0000-000000000003")}, LoanID: loanID, InvestorID: investors[2], Amount: 1000},
+		}
+
+		req := repayment.CreateRepaymentRequest{Amount: 1200, PaymentDate: paymentDate}
+
+		repaymentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		installmentRepo.On("GetUnpaidByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return(unpaidInstallments, nil)
+		installmentRepo.On("UpdateWithMapTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(installment.Installment{}, nil)
+		repaymentRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("repayment.Repayment"), mock.Anything).
+			Return(func(_ context.Context, rep repayment.Repayment, _ *gorm.DB) (repayment.Repayment, error) {
+				rep.ID = repaymentID
+				return rep, nil
+			})
+		investmentRepo.On("GetByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return(investments, nil)
+		// principal 1020 splits evenly, return 80 (160 interest * 10/20) leaves a remainder of 2 for the first investment
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return entry.ReferenceType == ledger.ReferenceRepayment && entry.ReferenceID == repaymentID && assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountSettlementCash, nil, 1200),
+				ledger.Credit(ledger.AccountBorrowerReceivable, &loanID, 1020),
+				ledger.Credit(ledger.AccountPlatformFee, nil, 20+160-80),
+				ledger.Credit(ledger.AccountInvestorCash, &investors[0], 340+28),
+				ledger.Credit(ledger.AccountInvestorCash, &investors[1], 340+26),
+				ledger.Credit(ledger.AccountInvestorCash, &investors[2], 340+26),
+				ledger.Debit(ledger.AccountLoanFunding, &loanID, 1020),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		distributionRepo.On("CreateBulkWithTx", mock.Anything, []repayment.Distribution{
+			{RepaymentID: repaymentID, LoanID: loanID, InvestmentID: investments[0].ID, InvestorID: investors[0], PrincipalAmount: 340, ReturnAmount: 28},
+			{RepaymentID: repaymentID, LoanID: loanID, InvestmentID: investments[1].ID, InvestorID: investors[1], PrincipalAmount: 340, ReturnAmount: 26},
+			{RepaymentID: repaymentID, LoanID: loanID, InvestmentID: investments[2].ID, InvestorID: investors[2], PrincipalAmount: 340, ReturnAmount: 26},
+		}, mock.Anything).Return(nil)
+		repaymentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewRepaymentUsecase(repaymentRepo, distributionRepo, loanRepo, installmentRepo, investmentRepo, ledgerUsecase)
+		res, err := uc.CreateRepayment(ctx, loanID, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		repaymentRepo.AssertExpectations(t)
+		investmentRepo.AssertExpectations(t)
+		ledgerUsecase.AssertExpectations(t)
+		distributionRepo.AssertExpectations(t)
+	})
+
+	t.Run("legacy loan with roi above rate pays investors the whole interest", func(t *testing.T) {
+		repaymentRepo := new(repaymentMock.MockIRepaymentRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		legacyLoan := loanData
+		legacyLoan.ROI = 30
+		investorID := uuid.New()
+		investments := []investment.Investment{
+			{BaseModel: model.BaseModel{ID: uuid.New()}, LoanID: loanID, InvestorID: investorID, Amount: 1000},
+		}
+
+		req := repayment.CreateRepaymentRequest{Amount: 1200, PaymentDate: paymentDate}
+
+		repaymentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(legacyLoan, nil)
+		installmentRepo.On("GetUnpaidByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return(unpaidInstallments, nil)
+		installmentRepo.On("UpdateWithMapTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(installment.Installment{}, nil)
+		repaymentRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("repayment.Repayment"), mock.Anything).
+			Return(func(_ context.Context, rep repayment.Repayment, _ *gorm.DB) (repayment.Repayment, error) {
+				return rep, nil
+			})
+		investmentRepo.On("GetByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return(investments, nil)
+		// 160 interest * 30/20 would be 240, more than the borrower paid, so the return is capped at 160
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountSettlementCash, nil, 1200),
+				ledger.Credit(ledger.AccountBorrowerReceivable, &loanID, 1020),
+				ledger.Credit(ledger.AccountPlatformFee, nil, 20),
+				ledger.Credit(ledger.AccountInvestorCash, &investorID, 1020+160),
+				ledger.Debit(ledger.AccountLoanFunding, &loanID, 1020),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		distributionRepo.On("CreateBulkWithTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		repaymentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewRepaymentUsecase(repaymentRepo, distributionRepo, loanRepo, installmentRepo, investmentRepo, ledgerUsecase)
+		res, err := uc.CreateRepayment(ctx, loanID, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		ledgerUsecase.AssertExpectations(t)
+		distributionRepo.AssertExpectations(t)
+	})
+
+	t.Run("rounding remainder goes to the largest investment", func(t *testing.T) {
+		repaymentRepo := new(repaymentMock.MockIRepaymentRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		smallInvestorID := uuid.New()
+		largeInvestorID := uuid.New()
+		investments := []investment.Investment{
+			{BaseModel: model.BaseModel{ID: uuid.New()}, LoanID: loanID, InvestorID: smallInvestorID, Amount: 1000},
+			{BaseModel: model.BaseModel{ID: uuid.New()}, LoanID: loanID, InvestorID: largeInvestorID, Amount: 2000},
+		}
+
+		// 60 interest settles the first installment's remaining interest, leaving 1000 principal to split 1:2
+		req := repayment.CreateRepaymentRequest{Amount: 1060, PaymentDate: paymentDate}
+
+		repaymentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		installmentRepo.On("GetUnpaidByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return(unpaidInstallments, nil)
+		installmentRepo.On("UpdateWithMapTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(installment.Installment{}, nil)
+		repaymentRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("repayment.Repayment"), mock.Anything).
+			Return(func(_ context.Context, rep repayment.Repayment, _ *gorm.DB) (repayment.Repayment, error) {
+				return rep, nil
+			})
+		investmentRepo.On("GetByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return(investments, nil)
+		// 1000 principal -> 333 / 666 + 1 remainder, 30 return -> 10 / 20
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return assert.ObjectsAreEqual(ledger.Credit(ledger.AccountInvestorCash, &smallInvestorID, 343), entry.Postings[3]) &&
+				assert.ObjectsAreEqual(ledger.Credit(ledger.AccountInvestorCash, &largeInvestorID, 687), entry.Postings[4])
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		distributionRepo.On("CreateBulkWithTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		repaymentRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewRepaymentUsecase(repaymentRepo, distributionRepo, loanRepo, installmentRepo, investmentRepo, ledgerUsecase)
+		res, err := uc.CreateRepayment(ctx, loanID, employeeID, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		assert.Equal(t, money.Money(1000), res.PrincipalAmount)
+		ledgerUsecase.AssertExpectations(t)
+		distributionRepo.AssertExpectations(t)
+	})
+
+	t.Run("loan not found", func(t *testing.T) {
+		repaymentRepo := new(repaymentMock.MockIRepaymentRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		repaymentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loan.Loan{}, nil)
+		repaymentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewRepaymentUsecase(repaymentRepo, distributionRepo, loanRepo, installmentRepo, investmentRepo, ledgerUsecase)
+		res, err := uc.CreateRepayment(ctx, loanID, employeeID, repayment.CreateRepaymentRequest{Amount: 100})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("loan not found"), err)
+		loanRepo.AssertExpectations(t)
+		repaymentRepo.AssertExpectations(t)
+	})
+
+	t.Run("loan not in disbursed state", func(t *testing.T) {
+		repaymentRepo := new(repaymentMock.MockIRepaymentRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		investedLoan := loanData
+		investedLoan.State = loan.StateInvested
+		repaymentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(investedLoan, nil)
+		repaymentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewRepaymentUsecase(repaymentRepo, distributionRepo, loanRepo, installmentRepo, investmentRepo, ledgerUsecase)
+		res, err := uc.CreateRepayment(ctx, loanID, employeeID, repayment.CreateRepaymentRequest{Amount: 100})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("loan is not in disbursed state"), err)
+		loanRepo.AssertExpectations(t)
+		repaymentRepo.AssertExpectations(t)
+	})
+
+	t.Run("amount exceeds outstanding balance", func(t *testing.T) {
+		repaymentRepo := new(repaymentMock.MockIRepaymentRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+		distributionRepo := new(repaymentMock.MockIDistributionRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		repaymentRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		installmentRepo.On("GetUnpaidByLoanIDLockTx", mock.Anything, loanID, mock.Anything).Return(unpaidInstallments, nil)
+		repaymentRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewRepaymentUsecase(repaymentRepo, distributionRepo, loanRepo, installmentRepo, investmentRepo, ledgerUsecase)
+		res, err := uc.CreateRepayment(ctx, loanID, employeeID, repayment.CreateRepaymentRequest{Amount: 5000, PaymentDate: paymentDate})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("repayment amount exceeds outstanding balance"), err)
+		loanRepo.AssertExpectations(t)
+		installmentRepo.AssertExpectations(t)
+		repaymentRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	sq "github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var tracerName = "ScorecardRepository"
+var tracer = otel.Tracer(tracerName)
+
+type scorecardRepo struct {
+	repository.BaseRepo[scoring.Scorecard]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewScorecardRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) scoring.IScorecardRepository {
+	baseRepo := repository.NewBaseRepo[scoring.Scorecard](dbMaster, dbSlave)
+
+	return &scorecardRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+// GetActive returns the scorecard in use, or the zero value when none is active
+func (r *scorecardRepo) GetActive(ctx context.Context) (scorecard scoring.Scorecard, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".GetActive")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(scorecard.TableName()).
+		Where(sq.Eq{
+			"active":     true,
+			"deleted_at": nil,
+		}).
+		OrderBy("version DESC").
+		Limit(1)
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&scorecard).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
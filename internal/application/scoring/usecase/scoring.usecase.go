@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracerName = "ScoringUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type scoringUsecase struct {
+	scorecardRepo   scoring.IScorecardRepository
+	loanRepo        loan.ILoanRepository
+	installmentRepo installment.IInstallmentRepository
+}
+
+func NewScoringUsecase(scorecardRepo scoring.IScorecardRepository, loanRepo loan.ILoanRepository, installmentRepo installment.IInstallmentRepository) scoring.IScoringUsecase {
+	return &scoringUsecase{
+		scorecardRepo:   scorecardRepo,
+		loanRepo:        loanRepo,
+		installmentRepo: installmentRepo,
+	}
+}
+
+// Score rates a loan of principalAmount to the borrower with the active scorecard
+func (u *scoringUsecase) Score(ctx context.Context, b borrower.Borrower, principalAmount money.Money) (scoring.Result, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".Score")
+	defer span.End()
+
+	scorecard, err := u.scorecardRepo.GetActive(ctx)
+	if err != nil {
+		return scoring.Result{}, err
+	} else if scorecard.ID == uuid.Nil {
+		return scoring.Result{}, httpError.NewInternalServerError("no active credit scorecard")
+	}
+
+	input := scoring.Input{
+		Borrower:        b,
+		PrincipalAmount: principalAmount,
+		Now:             time.Now(),
+	}
+
+	// The caller found the borrower within its scope, and the whole history counts whichever branch lent it
+	input.Loans, err = u.loanRepo.GetByBorrowerID(branch.WithScope(ctx, branch.AllBranches()), b.ID)
+	if err != nil {
+		return scoring.Result{}, err
+	}
+
+	if len(input.Loans) > 0 {
+		loanIDs := make([]uuid.UUID, 0, len(input.Loans))
+		for _, l := range input.Loans {
+			loanIDs = append(loanIDs, l.ID)
+		}
+
+		input.Installments, err = u.installmentRepo.GetByLoanIDs(ctx, loanIDs)
+		if err != nil {
+			return scoring.Result{}, err
+		}
+	}
+
+	return scorecard.Score(input), nil
+}
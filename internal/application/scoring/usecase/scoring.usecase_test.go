@@ -0,0 +1,96 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/application/scoring/usecase"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	installmentMock "github.com/BagusAK95/amarta_test/internal/domain/installment/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	loanMock "github.com/BagusAK95/amarta_test/internal/domain/loan/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	scoringMock "github.com/BagusAK95/amarta_test/internal/domain/scoring/mock"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestScore(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Now().AddDate(-2, 0, 0)
+	borrowerData := borrower.Borrower{
+		BaseModel: model.BaseModel{ID: uuid.New(), CreatedAt: &createdAt},
+		Segment:   borrower.SegmentMicro,
+	}
+	scorecard := scoring.Scorecard{
+		BaseModel:         model.BaseModel{ID: uuid.New()},
+		Version:           2,
+		Weights:           scoring.Table{scoring.FactorRepaymentHistory: 1, scoring.FactorLoanHistory: 1},
+		GradeCutoffs:      scoring.GradeCutoffs{scoring.GradeA: 80, scoring.GradeB: 65, scoring.GradeC: 50, scoring.GradeD: 35},
+		LoanSizeReference: 50_000_000,
+		Active:            true,
+	}
+
+	t.Run("returning borrower", func(t *testing.T) {
+		scorecardRepo := new(scoringMock.MockIScorecardRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+
+		paidOff := loan.Loan{BaseModel: model.BaseModel{ID: uuid.New()}, BorrowerID: borrowerData.ID, State: loan.StatePaidOff}
+		paidAt := time.Now().AddDate(0, -1, -1)
+		scorecardRepo.On("GetActive", mock.Anything).Return(scorecard, nil)
+		loanRepo.On("GetByBorrowerID", mock.Anything, borrowerData.ID).Return([]loan.Loan{paidOff}, nil)
+		installmentRepo.On("GetByLoanIDs", mock.Anything, []uuid.UUID{paidOff.ID}).Return([]installment.Installment{
+			{LoanID: paidOff.ID, DueDate: time.Now().AddDate(0, -1, 0), PaidAt: &paidAt},
+		}, nil)
+
+		uc := usecase.NewScoringUsecase(scorecardRepo, loanRepo, installmentRepo)
+		result, err := uc.Score(ctx, borrowerData, 5_000_000)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 60, result.Score)
+		assert.Equal(t, scoring.GradeC, result.Grade)
+		assert.Equal(t, 2, result.ScorecardVersion)
+		scorecardRepo.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+		installmentRepo.AssertExpectations(t)
+	})
+
+	t.Run("first-time borrower", func(t *testing.T) {
+		scorecardRepo := new(scoringMock.MockIScorecardRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+
+		scorecardRepo.On("GetActive", mock.Anything).Return(scorecard, nil)
+		loanRepo.On("GetByBorrowerID", mock.Anything, borrowerData.ID).Return([]loan.Loan{}, nil)
+
+		uc := usecase.NewScoringUsecase(scorecardRepo, loanRepo, installmentRepo)
+		result, err := uc.Score(ctx, borrowerData, 5_000_000)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 25, result.Score)
+		assert.Equal(t, scoring.GradeE, result.Grade)
+		installmentRepo.AssertNotCalled(t, "GetByLoanIDs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("no active scorecard", func(t *testing.T) {
+		scorecardRepo := new(scoringMock.MockIScorecardRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		installmentRepo := new(installmentMock.MockIInstallmentRepository)
+
+		scorecardRepo.On("GetActive", mock.Anything).Return(scoring.Scorecard{}, nil)
+
+		uc := usecase.NewScoringUsecase(scorecardRepo, loanRepo, installmentRepo)
+		result, err := uc.Score(ctx, borrowerData, 5_000_000)
+
+		assert.Error(t, err)
+		assert.Equal(t, scoring.Result{}, result)
+		assert.Equal(t, httpError.NewInternalServerError("no active credit scorecard"), err)
+		loanRepo.AssertNotCalled(t, "GetByBorrowerID", mock.Anything, mock.Anything)
+	})
+}
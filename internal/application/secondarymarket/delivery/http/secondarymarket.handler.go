@@ -0,0 +1,125 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/secondarymarket"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/BagusAK95/amarta_test/internal/utils/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type secondaryMarketHandler struct {
+	usecase   secondarymarket.ISecondaryMarketUsecase
+	validator *validator.CustomValidator
+}
+
+func NewSecondaryMarketHandler(usecase secondarymarket.ISecondaryMarketUsecase) *secondaryMarketHandler {
+	return &secondaryMarketHandler{
+		usecase:   usecase,
+		validator: validator.NewValidator(),
+	}
+}
+
+func (h *secondaryMarketHandler) CreateListing(c *gin.Context) {
+	var body secondarymarket.CreateListingRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.CreateListing(c.Request.Context(), investorID.(uuid.UUID), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *secondaryMarketHandler) CancelListing(c *gin.Context) {
+	listingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.CancelListing(c.Request.Context(), investorID.(uuid.UUID), listingID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *secondaryMarketHandler) ListListing(c *gin.Context) {
+	var page int = 1
+	if pageStr := c.Query("page"); pageStr != "" {
+		page, _ = strconv.Atoi(pageStr)
+	}
+
+	var limit int = 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, _ = strconv.Atoi(limitStr)
+	}
+
+	res, err := h.usecase.ListListing(c.Request.Context(), page, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *secondaryMarketHandler) BuyListing(c *gin.Context) {
+	listingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.BuyListing(c.Request.Context(), investorID.(uuid.UUID), listingID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *secondaryMarketHandler) ListTransfer(c *gin.Context) {
+	var page int = 1
+	if pageStr := c.Query("page"); pageStr != "" {
+		page, _ = strconv.Atoi(pageStr)
+	}
+
+	var limit int = 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, _ = strconv.Atoi(limitStr)
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.ListTransfer(c.Request.Context(), investorID.(uuid.UUID), page, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
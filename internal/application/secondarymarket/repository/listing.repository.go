@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/secondarymarket"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var listingTracerName = "ListingRepository"
+var listingTracer = otel.Tracer(listingTracerName)
+
+type listingRepo struct {
+	repository.BaseRepo[secondarymarket.Listing]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewListingRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) secondarymarket.IListingRepository {
+	baseRepo := repository.NewBaseRepo[secondarymarket.Listing](dbMaster, dbSlave)
+
+	return &listingRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *listingRepo) GetOpenByInvestmentIDLockTx(ctx context.Context, investmentID uuid.UUID, trx *gorm.DB) (res secondarymarket.Listing, err error) {
+	ctx, span := listingTracer.Start(ctx, listingTracerName+".GetOpenByInvestmentIDLockTx")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(res.TableName()).
+		Where(sq.Eq{
+			"investment_id": investmentID,
+			"status":        secondarymarket.ListingStatusOpen,
+			"deleted_at":    nil,
+		}).
+		Suffix("FOR UPDATE")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Raw(qry, args...).Scan(&res).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
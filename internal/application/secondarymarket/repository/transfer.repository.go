@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/secondarymarket"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var transferTracerName = "TransferRepository"
+var transferTracer = otel.Tracer(transferTracerName)
+
+type transferRepo struct {
+	repository.BaseRepo[secondarymarket.Transfer]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewTransferRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) secondarymarket.ITransferRepository {
+	baseRepo := repository.NewBaseRepo[secondarymarket.Transfer](dbMaster, dbSlave)
+
+	return &transferRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+// PaginationByInvestorID lists the transfers the investor took part in as seller or buyer, newest first.
+func (r *transferRepo) PaginationByInvestorID(ctx context.Context, investorID uuid.UUID, page int, limit int) (res repository.Pagination[secondarymarket.Transfer], err error) {
+	ctx, span := transferTracer.Start(ctx, transferTracerName+".PaginationByInvestorID")
+	defer span.End()
+
+	var model secondarymarket.Transfer
+	var transfers []secondarymarket.Transfer
+
+	builder := sq.
+		Select("*").
+		From(model.TableName()).
+		Where(sq.Or{
+			sq.Eq{"seller_investor_id": investorID},
+			sq.Eq{"buyer_investor_id": investorID},
+		}).
+		Where(sq.Eq{"deleted_at": nil}).
+		OrderBy("id DESC").
+		Limit(uint64(limit + 1)).
+		Offset(uint64((page - 1) * limit))
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = r.readConn.WithContext(ctx).Raw(qry, args...).Scan(&transfers).Error
+	if err != nil {
+		return
+	}
+
+	if len(transfers) > limit {
+		res.HasNext = true
+		transfers = transfers[:limit]
+	}
+
+	if page > 1 {
+		res.HasPrev = true
+	}
+
+	res.Data = transfers
+	return
+}
@@ -0,0 +1,326 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/investment"
+	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/secondarymarket"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var tracerName = "SecondaryMarketUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type secondaryMarketUsecase struct {
+	listingRepo    secondarymarket.IListingRepository
+	transferRepo   secondarymarket.ITransferRepository
+	investmentRepo investment.IInvestmentRepository
+	investorRepo   investor.IInvestorRepository
+	loanRepo       loan.ILoanRepository
+	ledgerUsecase  ledger.ILedgerUsecase
+}
+
+func NewSecondaryMarketUsecase(listingRepo secondarymarket.IListingRepository, transferRepo secondarymarket.ITransferRepository, investmentRepo investment.IInvestmentRepository, investorRepo investor.IInvestorRepository, loanRepo loan.ILoanRepository, ledgerUsecase ledger.ILedgerUsecase) secondarymarket.ISecondaryMarketUsecase {
+	return &secondaryMarketUsecase{
+		listingRepo:    listingRepo,
+		transferRepo:   transferRepo,
+		investmentRepo: investmentRepo,
+		investorRepo:   investorRepo,
+		loanRepo:       loanRepo,
+		ledgerUsecase:  ledgerUsecase,
+	}
+}
+
+func (u *secondaryMarketUsecase) CreateListing(ctx context.Context, investorID uuid.UUID, req secondarymarket.CreateListingRequest) (res *secondarymarket.Listing, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".CreateListing")
+	defer span.End()
+
+	inv, err := u.investmentRepo.GetByID(ctx, req.InvestmentID)
+	if err != nil {
+		return nil, err
+	} else if inv.ID == uuid.Nil || inv.InvestorID != investorID {
+		return nil, httpError.NewNotFoundError("investment not found")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.listingRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.listingRepo.Rollback(trx)
+			return
+		}
+
+		u.listingRepo.Commit(trx)
+	}()
+
+	validLoan, err := u.loanRepo.GetByIDLockTx(ctx, inv.LoanID, trx)
+	if err != nil {
+		return nil, err
+	} else if validLoan.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("loan not found")
+	} else if validLoan.State != loan.StateDisbursed {
+		return nil, httpError.NewBadRequestError("loan is not in disbursed state")
+	}
+
+	inv, err = u.investmentRepo.GetByIDLockTx(ctx, req.InvestmentID, trx)
+	if err != nil {
+		return nil, err
+	} else if inv.ID == uuid.Nil || inv.InvestorID != investorID {
+		return nil, httpError.NewNotFoundError("investment not found")
+	}
+
+	openListing, err := u.listingRepo.GetOpenByInvestmentIDLockTx(ctx, req.InvestmentID, trx)
+	if err != nil {
+		return nil, err
+	} else if openListing.ID != uuid.Nil {
+		return nil, httpError.NewBadRequestError("investment is already listed")
+	}
+
+	newListing, err := u.listingRepo.CreateWithTx(ctx, secondarymarket.Listing{
+		InvestmentID:     inv.ID,
+		LoanID:           inv.LoanID,
+		SellerInvestorID: investorID,
+		Price:            req.Price,
+		Status:           secondarymarket.ListingStatusOpen,
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newListing, nil
+}
+
+func (u *secondaryMarketUsecase) CancelListing(ctx context.Context, investorID uuid.UUID, listingID uuid.UUID) (res *secondarymarket.Listing, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".CancelListing")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.listingRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.listingRepo.Rollback(trx)
+			return
+		}
+
+		u.listingRepo.Commit(trx)
+	}()
+
+	validListing, err := u.listingRepo.GetByIDLockTx(ctx, listingID, trx)
+	if err != nil {
+		return nil, err
+	} else if validListing.ID == uuid.Nil || validListing.SellerInvestorID != investorID {
+		return nil, httpError.NewNotFoundError("listing not found")
+	} else if validListing.Status != secondarymarket.ListingStatusOpen {
+		return nil, httpError.NewBadRequestError("listing is not open")
+	}
+
+	updatedListing, err := u.listingRepo.UpdateWithMapTx(ctx, listingID, map[string]any{
+		"status": secondarymarket.ListingStatusCancelled,
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedListing, nil
+}
+
+func (u *secondaryMarketUsecase) ListListing(ctx context.Context, page int, limit int) (repository.Pagination[secondarymarket.Listing], error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ListListing")
+	defer span.End()
+
+	listings, err := u.listingRepo.Pagination(ctx, map[string]any{
+		"status": secondarymarket.ListingStatusOpen,
+	}, page, limit)
+	if err != nil {
+		return repository.Pagination[secondarymarket.Listing]{}, err
+	}
+
+	return listings, nil
+}
+
+// BuyListing moves the listed investment to the buyer and the price to the seller in one transaction. Rows are locked
+// loan first, as AddInvestment and repayments do, then both investors, then the investment and the listing itself.
+// Repayments read the owner from the investment row, so every distribution after the transfer goes to the buyer.
+func (u *secondaryMarketUsecase) BuyListing(ctx context.Context, investorID uuid.UUID, listingID uuid.UUID) (res *secondarymarket.Transfer, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".BuyListing")
+	defer span.End()
+
+	validListing, err := u.listingRepo.GetByID(ctx, listingID)
+	if err != nil {
+		return nil, err
+	} else if validListing.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("listing not found")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.listingRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.listingRepo.Rollback(trx)
+			return
+		}
+
+		u.listingRepo.Commit(trx)
+	}()
+
+	validLoan, err := u.loanRepo.GetByIDLockTx(ctx, validListing.LoanID, trx)
+	if err != nil {
+		return nil, err
+	} else if validLoan.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("loan not found")
+	} else if validLoan.State != loan.StateDisbursed {
+		return nil, httpError.NewBadRequestError("loan is not in disbursed state")
+	}
+
+	if validListing.SellerInvestorID == investorID {
+		return nil, httpError.NewBadRequestError("cannot buy your own listing")
+	}
+
+	buyer, err := u.lockInvestors(ctx, investorID, validListing.SellerInvestorID, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := u.investmentRepo.GetByIDLockTx(ctx, validListing.InvestmentID, trx)
+	if err != nil {
+		return nil, err
+	} else if inv.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("investment not found")
+	}
+
+	validListing, err = u.listingRepo.GetByIDLockTx(ctx, listingID, trx)
+	if err != nil {
+		return nil, err
+	} else if validListing.Status != secondarymarket.ListingStatusOpen || inv.InvestorID != validListing.SellerInvestorID {
+		return nil, httpError.NewBadRequestError("listing is not open")
+	} else if buyer.Balance < validListing.Price {
+		return nil, httpError.NewBadRequestError("insufficient balance")
+	}
+
+	err = u.checkConcentration(ctx, validLoan, buyer, inv.Amount, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.investmentRepo.UpdateWithMapTx(ctx, inv.ID, map[string]any{
+		"investor_id": investorID,
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.listingRepo.UpdateWithMapTx(ctx, listingID, map[string]any{
+		"status":            secondarymarket.ListingStatusSold,
+		"buyer_investor_id": investorID,
+		"sold_at":           time.Now(),
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	newTransfer, err := u.transferRepo.CreateWithTx(ctx, secondarymarket.Transfer{
+		ListingID:        listingID,
+		InvestmentID:     inv.ID,
+		LoanID:           inv.LoanID,
+		SellerInvestorID: validListing.SellerInvestorID,
+		BuyerInvestorID:  investorID,
+		Price:            validListing.Price,
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.ledgerUsecase.PostWithTx(ctx, ledger.JournalEntry{
+		ReferenceType: ledger.ReferenceTransfer,
+		ReferenceID:   newTransfer.ID,
+		Description:   "Transfer of investment " + inv.ID.String(),
+		Postings: []ledger.Posting{
+			ledger.Debit(ledger.AccountInvestorCash, &investorID, validListing.Price),
+			ledger.Credit(ledger.AccountInvestorCash, &validListing.SellerInvestorID, validListing.Price),
+		},
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newTransfer, nil
+}
+
+func (u *secondaryMarketUsecase) ListTransfer(ctx context.Context, investorID uuid.UUID, page int, limit int) (repository.Pagination[secondarymarket.Transfer], error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ListTransfer")
+	defer span.End()
+
+	transfers, err := u.transferRepo.PaginationByInvestorID(ctx, investorID, page, limit)
+	if err != nil {
+		return repository.Pagination[secondarymarket.Transfer]{}, err
+	}
+
+	return transfers, nil
+}
+
+// lockInvestors locks the buyer and the seller in ID order so that two investors trading with each other at the same
+// time cannot deadlock, and returns the buyer.
+// checkConcentration applies the primary market diversification limits to the buyer, as if they invested the
+// purchased amount in the loan
+func (u *secondaryMarketUsecase) checkConcentration(ctx context.Context, validLoan loan.Loan, buyer investor.Investor, amount money.Money, trx *gorm.DB) error {
+	ctx, span := tracer.Start(ctx, tracerName+".CheckConcentration")
+	defer span.End()
+
+	limits := investment.ConcentrationLimits{
+		MaxAmountPerLoan: config.INVESTMENT_MAX_AMOUNT_PER_LOAN,
+		MaxLoanShare:     config.INVESTMENT_MAX_LOAN_SHARE,
+		MaxBorrowerShare: config.INVESTMENT_MAX_BORROWER_SHARE,
+	}
+	if !limits.Enabled() {
+		return nil
+	}
+
+	exposure, err := u.investmentRepo.GetExposureTx(ctx, buyer.ID, validLoan.ID, validLoan.BorrowerID, trx)
+	if err != nil {
+		return err
+	}
+
+	if violations := limits.Violations(exposure, buyer.Balance, validLoan.PrincipalAmount, amount); len(violations) > 0 {
+		return httpError.NewBadRequestError("investment exceeds concentration limits", violations...)
+	}
+
+	return nil
+}
+
+func (u *secondaryMarketUsecase) lockInvestors(ctx context.Context, buyerID uuid.UUID, sellerID uuid.UUID, trx *gorm.DB) (buyer investor.Investor, err error) {
+	investorIDs := []uuid.UUID{buyerID, sellerID}
+	if sellerID.String() < buyerID.String() {
+		investorIDs = []uuid.UUID{sellerID, buyerID}
+	}
+
+	for _, id := range investorIDs {
+		validInvestor, err := u.investorRepo.GetByIDLockTx(ctx, id, trx)
+		if err != nil {
+			return buyer, err
+		} else if validInvestor.ID == uuid.Nil {
+			return buyer, httpError.NewNotFoundError("investor not found")
+		}
+
+		if id == buyerID {
+			buyer = validInvestor
+		}
+	}
+
+	return buyer, nil
+}
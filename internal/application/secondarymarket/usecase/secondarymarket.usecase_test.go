@@ -0,0 +1,380 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BagusAK95/amarta_test/internal/application/secondarymarket/usecase"
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/investment"
+	investmentMock "github.com/BagusAK95/amarta_test/internal/domain/investment/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	investorMock "github.com/BagusAK95/amarta_test/internal/domain/investor/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	ledgerMock "github.com/BagusAK95/amarta_test/internal/domain/ledger/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	loanMock "github.com/BagusAK95/amarta_test/internal/domain/loan/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/secondarymarket"
+	secondarymarketMock "github.com/BagusAK95/amarta_test/internal/domain/secondarymarket/mock"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateListing(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	loanID := uuid.New()
+	investmentID := uuid.New()
+	req := secondarymarket.CreateListingRequest{
+		InvestmentID: investmentID,
+		Price:        900,
+	}
+	loanData := loan.Loan{
+		BaseModel: model.BaseModel{ID: loanID},
+		State:     loan.StateDisbursed,
+	}
+	investmentData := investment.Investment{
+		BaseModel:  model.BaseModel{ID: investmentID},
+		LoanID:     loanID,
+		InvestorID: investorID,
+		Amount:     1000,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		listingRepo := new(secondarymarketMock.MockIListingRepository)
+		transferRepo := new(secondarymarketMock.MockITransferRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
+		listingRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		investmentRepo.On("GetByIDLockTx", mock.Anything, investmentID, mock.Anything).Return(investmentData, nil)
+		listingRepo.On("GetOpenByInvestmentIDLockTx", mock.Anything, investmentID, mock.Anything).Return(secondarymarket.Listing{}, nil)
+		listingRepo.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(l secondarymarket.Listing) bool {
+			return l.InvestmentID == investmentID && l.SellerInvestorID == investorID && l.Price == req.Price && l.Status == secondarymarket.ListingStatusOpen
+		}), mock.Anything).Return(secondarymarket.Listing{BaseModel: model.BaseModel{ID: uuid.New()}}, nil)
+		listingRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewSecondaryMarketUsecase(listingRepo, transferRepo, investmentRepo, investorRepo, loanRepo, ledgerUsecase)
+		res, err := uc.CreateListing(ctx, investorID, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		listingRepo.AssertExpectations(t)
+		investmentRepo.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+	})
+
+	t.Run("investment of another investor", func(t *testing.T) {
+		listingRepo := new(secondarymarketMock.MockIListingRepository)
+		transferRepo := new(secondarymarketMock.MockITransferRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
+
+		uc := usecase.NewSecondaryMarketUsecase(listingRepo, transferRepo, investmentRepo, investorRepo, loanRepo, ledgerUsecase)
+		res, err := uc.CreateListing(ctx, uuid.New(), req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("investment not found"), err)
+		investmentRepo.AssertExpectations(t)
+	})
+
+	t.Run("loan not disbursed", func(t *testing.T) {
+		listingRepo := new(secondarymarketMock.MockIListingRepository)
+		transferRepo := new(secondarymarketMock.MockITransferRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		approvedLoan := loanData
+		approvedLoan.State = loan.StateApproved
+		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
+		listingRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(approvedLoan, nil)
+		listingRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewSecondaryMarketUsecase(listingRepo, transferRepo, investmentRepo, investorRepo, loanRepo, ledgerUsecase)
+		res, err := uc.CreateListing(ctx, investorID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("loan is not in disbursed state"), err)
+		listingRepo.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+	})
+
+	t.Run("already listed", func(t *testing.T) {
+		listingRepo := new(secondarymarketMock.MockIListingRepository)
+		transferRepo := new(secondarymarketMock.MockITransferRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		investmentRepo.On("GetByID", mock.Anything, investmentID).Return(investmentData, nil)
+		listingRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		investmentRepo.On("GetByIDLockTx", mock.Anything, investmentID, mock.Anything).Return(investmentData, nil)
+		listingRepo.On("GetOpenByInvestmentIDLockTx", mock.Anything, investmentID, mock.Anything).Return(secondarymarket.Listing{BaseModel: model.BaseModel{ID: uuid.New()}}, nil)
+		listingRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewSecondaryMarketUsecase(listingRepo, transferRepo, investmentRepo, investorRepo, loanRepo, ledgerUsecase)
+		res, err := uc.CreateListing(ctx, investorID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("investment is already listed"), err)
+		listingRepo.AssertExpectations(t)
+	})
+}
+
+func TestCancelListing(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	listingID := uuid.New()
+	listingData := secondarymarket.Listing{
+		BaseModel:        model.BaseModel{ID: listingID},
+		SellerInvestorID: investorID,
+		Price:            900,
+		Status:           secondarymarket.ListingStatusOpen,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		listingRepo := new(secondarymarketMock.MockIListingRepository)
+		transferRepo := new(secondarymarketMock.MockITransferRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		cancelledListing := listingData
+		cancelledListing.Status = secondarymarket.ListingStatusCancelled
+		listingRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		listingRepo.On("GetByIDLockTx", mock.Anything, listingID, mock.Anything).Return(listingData, nil)
+		listingRepo.On("UpdateWithMapTx", mock.Anything, listingID, map[string]any{
+			"status": secondarymarket.ListingStatusCancelled,
+		}, mock.Anything).Return(cancelledListing, nil)
+		listingRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewSecondaryMarketUsecase(listingRepo, transferRepo, investmentRepo, investorRepo, loanRepo, ledgerUsecase)
+		res, err := uc.CancelListing(ctx, investorID, listingID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, secondarymarket.ListingStatusCancelled, res.Status)
+		listingRepo.AssertExpectations(t)
+	})
+
+	t.Run("listing not open", func(t *testing.T) {
+		listingRepo := new(secondarymarketMock.MockIListingRepository)
+		transferRepo := new(secondarymarketMock.MockITransferRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		soldListing := listingData
+		soldListing.Status = secondarymarket.ListingStatusSold
+		listingRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		listingRepo.On("GetByIDLockTx", mock.Anything, listingID, mock.Anything).Return(soldListing, nil)
+		listingRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewSecondaryMarketUsecase(listingRepo, transferRepo, investmentRepo, investorRepo, loanRepo, ledgerUsecase)
+		res, err := uc.CancelListing(ctx, investorID, listingID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("listing is not open"), err)
+		listingRepo.AssertExpectations(t)
+	})
+}
+
+func TestBuyListing(t *testing.T) {
+	ctx := context.Background()
+	sellerID := uuid.New()
+	buyerID := uuid.New()
+	loanID := uuid.New()
+	investmentID := uuid.New()
+	listingID := uuid.New()
+	transferID := uuid.New()
+	listingData := secondarymarket.Listing{
+		BaseModel:        model.BaseModel{ID: listingID},
+		InvestmentID:     investmentID,
+		LoanID:           loanID,
+		SellerInvestorID: sellerID,
+		Price:            900,
+		Status:           secondarymarket.ListingStatusOpen,
+	}
+	loanData := loan.Loan{
+		BaseModel: model.BaseModel{ID: loanID},
+		State:     loan.StateDisbursed,
+	}
+	investmentData := investment.Investment{
+		BaseModel:  model.BaseModel{ID: investmentID},
+		LoanID:     loanID,
+		InvestorID: sellerID,
+		Amount:     1000,
+	}
+	sellerData := investor.Investor{
+		BaseModel: model.BaseModel{ID: sellerID},
+	}
+	buyerData := investor.Investor{
+		BaseModel: model.BaseModel{ID: buyerID},
+		Balance:   5000,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		listingRepo := new(secondarymarketMock.MockIListingRepository)
+		transferRepo := new(secondarymarketMock.MockITransferRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		listingRepo.On("GetByID", mock.Anything, listingID).Return(listingData, nil)
+		listingRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		investorRepo.On("GetByIDLockTx", mock.Anything, sellerID, mock.Anything).Return(sellerData, nil)
+		investorRepo.On("GetByIDLockTx", mock.Anything, buyerID, mock.Anything).Return(buyerData, nil)
+		investmentRepo.On("GetByIDLockTx", mock.Anything, investmentID, mock.Anything).Return(investmentData, nil)
+		listingRepo.On("GetByIDLockTx", mock.Anything, listingID, mock.Anything).Return(listingData, nil)
+		investmentRepo.On("UpdateWithMapTx", mock.Anything, investmentID, map[string]any{
+			"investor_id": buyerID,
+		}, mock.Anything).Return(investmentData, nil)
+		listingRepo.On("UpdateWithMapTx", mock.Anything, listingID, mock.MatchedBy(func(data map[string]any) bool {
+			return data["status"] == secondarymarket.ListingStatusSold && data["buyer_investor_id"] == buyerID
+		}), mock.Anything).Return(listingData, nil)
+		transferRepo.On("CreateWithTx", mock.Anything, secondarymarket.Transfer{
+			ListingID:        listingID,
+			InvestmentID:     investmentID,
+			LoanID:           loanID,
+			SellerInvestorID: sellerID,
+			BuyerInvestorID:  buyerID,
+			Price:            listingData.Price,
+		}, mock.Anything).Return(secondarymarket.Transfer{BaseModel: model.BaseModel{ID: transferID}}, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return entry.ReferenceType == ledger.ReferenceTransfer && entry.ReferenceID == transferID && assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountInvestorCash, &buyerID, listingData.Price),
+				ledger.Credit(ledger.AccountInvestorCash, &sellerID, listingData.Price),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		listingRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewSecondaryMarketUsecase(listingRepo, transferRepo, investmentRepo, investorRepo, loanRepo, ledgerUsecase)
+		res, err := uc.BuyListing(ctx, buyerID, listingID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, transferID, res.ID)
+		listingRepo.AssertExpectations(t)
+		transferRepo.AssertExpectations(t)
+		investmentRepo.AssertExpectations(t)
+		investorRepo.AssertExpectations(t)
+		ledgerUsecase.AssertExpectations(t)
+	})
+
+	t.Run("own listing", func(t *testing.T) {
+		listingRepo := new(secondarymarketMock.MockIListingRepository)
+		transferRepo := new(secondarymarketMock.MockITransferRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		listingRepo.On("GetByID", mock.Anything, listingID).Return(listingData, nil)
+		listingRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		listingRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewSecondaryMarketUsecase(listingRepo, transferRepo, investmentRepo, investorRepo, loanRepo, ledgerUsecase)
+		res, err := uc.BuyListing(ctx, sellerID, listingID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("cannot buy your own listing"), err)
+		listingRepo.AssertExpectations(t)
+	})
+
+	t.Run("insufficient balance", func(t *testing.T) {
+		listingRepo := new(secondarymarketMock.MockIListingRepository)
+		transferRepo := new(secondarymarketMock.MockITransferRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		poorBuyer := buyerData
+		poorBuyer.Balance = 100
+		listingRepo.On("GetByID", mock.Anything, listingID).Return(listingData, nil)
+		listingRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		investorRepo.On("GetByIDLockTx", mock.Anything, sellerID, mock.Anything).Return(sellerData, nil)
+		investorRepo.On("GetByIDLockTx", mock.Anything, buyerID, mock.Anything).Return(poorBuyer, nil)
+		investmentRepo.On("GetByIDLockTx", mock.Anything, investmentID, mock.Anything).Return(investmentData, nil)
+		listingRepo.On("GetByIDLockTx", mock.Anything, listingID, mock.Anything).Return(listingData, nil)
+		listingRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewSecondaryMarketUsecase(listingRepo, transferRepo, investmentRepo, investorRepo, loanRepo, ledgerUsecase)
+		res, err := uc.BuyListing(ctx, buyerID, listingID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("insufficient balance"), err)
+		investmentRepo.AssertNotCalled(t, "UpdateWithMapTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		ledgerUsecase.AssertNotCalled(t, "PostWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("purchase exceeds concentration limits", func(t *testing.T) {
+		listingRepo := new(secondarymarketMock.MockIListingRepository)
+		transferRepo := new(secondarymarketMock.MockITransferRepository)
+		investmentRepo := new(investmentMock.MockIInvestmentRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		loanRepo := new(loanMock.MockILoanRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		config.INVESTMENT_MAX_AMOUNT_PER_LOAN = 1200
+		config.INVESTMENT_MAX_BORROWER_SHARE = 20
+		t.Cleanup(func() {
+			config.INVESTMENT_MAX_AMOUNT_PER_LOAN = 0
+			config.INVESTMENT_MAX_BORROWER_SHARE = 0
+		})
+
+		listingRepo.On("GetByID", mock.Anything, listingID).Return(listingData, nil)
+		listingRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		loanRepo.On("GetByIDLockTx", mock.Anything, loanID, mock.Anything).Return(loanData, nil)
+		investorRepo.On("GetByIDLockTx", mock.Anything, sellerID, mock.Anything).Return(sellerData, nil)
+		investorRepo.On("GetByIDLockTx", mock.Anything, buyerID, mock.Anything).Return(buyerData, nil)
+		investmentRepo.On("GetByIDLockTx", mock.Anything, investmentID, mock.Anything).Return(investmentData, nil)
+		listingRepo.On("GetByIDLockTx", mock.Anything, listingID, mock.Anything).Return(listingData, nil)
+		investmentRepo.On("GetExposureTx", mock.Anything, buyerID, loanID, loanData.BorrowerID, mock.Anything).Return(investment.Exposure{
+			Loan:     500,
+			Borrower: 500,
+			Total:    2000,
+		}, nil)
+		listingRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewSecondaryMarketUsecase(listingRepo, transferRepo, investmentRepo, investorRepo, loanRepo, ledgerUsecase)
+		res, err := uc.BuyListing(ctx, buyerID, listingID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("investment exceeds concentration limits",
+			"investment in this loan would be 1500, above the maximum of 1200 per investor",
+			"investment in this borrower would be 1500, above 20% of your portfolio (1400)",
+		), err)
+		investmentRepo.AssertExpectations(t)
+		investmentRepo.AssertNotCalled(t, "UpdateWithMapTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		ledgerUsecase.AssertNotCalled(t, "PostWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
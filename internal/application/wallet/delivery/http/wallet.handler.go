@@ -0,0 +1,150 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/wallet"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/BagusAK95/amarta_test/internal/utils/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const callbackSignatureHeader = "x-callback-signature"
+
+type walletHandler struct {
+	usecase   wallet.IWalletUsecase
+	validator *validator.CustomValidator
+}
+
+func NewWalletHandler(usecase wallet.IWalletUsecase) *walletHandler {
+	return &walletHandler{
+		usecase:   usecase,
+		validator: validator.NewValidator(),
+	}
+}
+
+func (h *walletHandler) CreateTopup(c *gin.Context) {
+	var body wallet.CreateTopupRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.CreateTopup(c.Request.Context(), investorID.(uuid.UUID), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *walletHandler) TopupCallback(c *gin.Context) {
+	payload, err := c.GetRawData()
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	err = h.usecase.HandleTopupCallback(c.Request.Context(), payload, c.GetHeader(callbackSignatureHeader))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "ok"})
+}
+
+func (h *walletHandler) CreateWithdrawal(c *gin.Context) {
+	var body wallet.CreateWithdrawalRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	investorID, _ := c.Get("investorID")
+
+	res, err := h.usecase.CreateWithdrawal(c.Request.Context(), investorID.(uuid.UUID), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *walletHandler) ApproveWithdrawal(c *gin.Context) {
+	withdrawalID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.ApproveWithdrawal(c.Request.Context(), withdrawalID, employeeID.(uuid.UUID))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *walletHandler) RejectWithdrawal(c *gin.Context) {
+	withdrawalID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	var body wallet.RejectWithdrawalRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	if errs := h.validator.Validate(body); len(errs) > 0 {
+		_ = c.Error(httpError.NewBadRequestError("invalid request body", errs...))
+		return
+	}
+
+	employeeID, _ := c.Get("employeeID")
+
+	res, err := h.usecase.RejectWithdrawal(c.Request.Context(), withdrawalID, employeeID.(uuid.UUID), body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *walletHandler) PayoutCallback(c *gin.Context) {
+	payload, err := c.GetRawData()
+	if err != nil {
+		_ = c.Error(httpError.NewBadRequestError(err.Error()))
+		return
+	}
+
+	err = h.usecase.HandlePayoutCallback(c.Request.Context(), payload, c.GetHeader(callbackSignatureHeader))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "ok"})
+}
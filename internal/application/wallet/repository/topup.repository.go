@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/wallet"
+	sq "github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var topupTracerName = "TopupRepository"
+var topupTracer = otel.Tracer(topupTracerName)
+
+type topupRepo struct {
+	repository.BaseRepo[wallet.Topup]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewTopupRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) wallet.ITopupRepository {
+	baseRepo := repository.NewBaseRepo[wallet.Topup](dbMaster, dbSlave)
+
+	return &topupRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *topupRepo) GetByExternalIDLockTx(ctx context.Context, externalID string, trx *gorm.DB) (res wallet.Topup, err error) {
+	ctx, span := topupTracer.Start(ctx, topupTracerName+".GetByExternalIDLockTx")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(res.TableName()).
+		Where(sq.Eq{
+			"external_id": externalID,
+			"deleted_at":  nil,
+		}).
+		Suffix("FOR UPDATE")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Raw(qry, args...).Scan(&res).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/wallet"
+	sq "github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var withdrawalTracerName = "WithdrawalRepository"
+var withdrawalTracer = otel.Tracer(withdrawalTracerName)
+
+type withdrawalRepo struct {
+	repository.BaseRepo[wallet.Withdrawal]
+	writeConn *gorm.DB
+	readConn  *gorm.DB
+}
+
+func NewWithdrawalRepo(dbMaster *gorm.DB, dbSlave *gorm.DB) wallet.IWithdrawalRepository {
+	baseRepo := repository.NewBaseRepo[wallet.Withdrawal](dbMaster, dbSlave)
+
+	return &withdrawalRepo{
+		BaseRepo:  *baseRepo,
+		writeConn: dbMaster,
+		readConn:  dbSlave,
+	}
+}
+
+func (r *withdrawalRepo) GetByPayoutReferenceLockTx(ctx context.Context, payoutReference string, trx *gorm.DB) (res wallet.Withdrawal, err error) {
+	ctx, span := withdrawalTracer.Start(ctx, withdrawalTracerName+".GetByPayoutReferenceLockTx")
+	defer span.End()
+
+	builder := sq.
+		Select("*").
+		From(res.TableName()).
+		Where(sq.Eq{
+			"payout_reference": payoutReference,
+			"deleted_at":       nil,
+		}).
+		Suffix("FOR UPDATE")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return
+	}
+
+	err = trx.WithContext(ctx).Raw(qry, args...).Scan(&res).Error
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,449 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	"github.com/BagusAK95/amarta_test/internal/domain/wallet"
+	"github.com/BagusAK95/amarta_test/internal/infrastructure/payment"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+var tracerName = "WalletUsecase"
+var tracer = otel.Tracer(tracerName)
+
+type walletUsecase struct {
+	topupRepo      wallet.ITopupRepository
+	withdrawalRepo wallet.IWithdrawalRepository
+	investorRepo   investor.IInvestorRepository
+	ledgerUsecase  ledger.ILedgerUsecase
+	gateway        payment.IGateway
+}
+
+func NewWalletUsecase(topupRepo wallet.ITopupRepository, withdrawalRepo wallet.IWithdrawalRepository, investorRepo investor.IInvestorRepository, ledgerUsecase ledger.ILedgerUsecase, gateway payment.IGateway) wallet.IWalletUsecase {
+	return &walletUsecase{
+		topupRepo:      topupRepo,
+		withdrawalRepo: withdrawalRepo,
+		investorRepo:   investorRepo,
+		ledgerUsecase:  ledgerUsecase,
+		gateway:        gateway,
+	}
+}
+
+func (u *walletUsecase) CreateTopup(ctx context.Context, investorID uuid.UUID, req wallet.CreateTopupRequest) (*wallet.Topup, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".CreateTopup")
+	defer span.End()
+
+	validInvestor, err := u.investorRepo.GetByID(ctx, investorID)
+	if err != nil {
+		return nil, err
+	} else if validInvestor.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("investor not found")
+	}
+
+	topupID, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	va, err := u.gateway.CreateVirtualAccount(ctx, payment.VirtualAccountRequest{
+		ReferenceID:  topupID,
+		Bank:         req.Bank,
+		CustomerName: validInvestor.FullName,
+		Amount:       req.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	newTopup, err := u.topupRepo.Create(ctx, wallet.Topup{
+		BaseModel:            model.BaseModel{ID: topupID},
+		InvestorID:           investorID,
+		Amount:               req.Amount,
+		Bank:                 va.Bank,
+		VirtualAccountNumber: va.AccountNumber,
+		ExternalID:           va.ExternalID,
+		Status:               wallet.TopupStatusPending,
+		ExpiresAt:            va.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &newTopup, nil
+}
+
+// HandleTopupCallback settles a top-up from a signed gateway webhook. Callbacks for top-ups that are no longer
+// pending are acknowledged without changes, since gateways retry deliveries.
+func (u *walletUsecase) HandleTopupCallback(ctx context.Context, payload []byte, signature string) (err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".HandleTopupCallback")
+	defer span.End()
+
+	if !u.gateway.VerifySignature(payload, signature) {
+		return httpError.NewForbiddenError("invalid callback signature")
+	}
+
+	var req wallet.TopupCallbackRequest
+	if err = json.Unmarshal(payload, &req); err != nil {
+		return httpError.NewBadRequestError("invalid callback payload")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.topupRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.topupRepo.Rollback(trx)
+			return
+		}
+
+		u.topupRepo.Commit(trx)
+	}()
+
+	validTopup, err := u.topupRepo.GetByExternalIDLockTx(ctx, req.ExternalID, trx)
+	if err != nil {
+		return err
+	} else if validTopup.ID == uuid.Nil {
+		return httpError.NewNotFoundError("topup not found")
+	} else if validTopup.Status != wallet.TopupStatusPending {
+		return nil
+	}
+
+	switch req.Status {
+	case wallet.TopupStatusExpired:
+		_, err = u.topupRepo.UpdateWithMapTx(ctx, validTopup.ID, map[string]any{
+			"status": wallet.TopupStatusExpired,
+		}, trx)
+		return err
+	case wallet.TopupStatusPaid:
+		if req.Amount != validTopup.Amount {
+			return httpError.NewBadRequestError("callback amount does not match topup amount")
+		}
+	default:
+		return httpError.NewBadRequestError("unknown topup status")
+	}
+
+	_, err = u.topupRepo.UpdateWithMapTx(ctx, validTopup.ID, map[string]any{
+		"status":  wallet.TopupStatusPaid,
+		"paid_at": req.PaidAt,
+	}, trx)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.ledgerUsecase.PostWithTx(ctx, ledger.JournalEntry{
+		ReferenceType: ledger.ReferenceTopup,
+		ReferenceID:   validTopup.ID,
+		Description:   "Top-up via " + validTopup.Bank + " virtual account",
+		Postings: []ledger.Posting{
+			ledger.Debit(ledger.AccountSettlementCash, nil, validTopup.Amount),
+			ledger.Credit(ledger.AccountInvestorCash, &validTopup.InvestorID, validTopup.Amount),
+		},
+	}, trx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *walletUsecase) CreateWithdrawal(ctx context.Context, investorID uuid.UUID, req wallet.CreateWithdrawalRequest) (res *wallet.Withdrawal, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".CreateWithdrawal")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.withdrawalRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.withdrawalRepo.Rollback(trx)
+			return
+		}
+
+		u.withdrawalRepo.Commit(trx)
+	}()
+
+	validInvestor, err := u.investorRepo.GetByIDLockTx(ctx, investorID, trx)
+	if err != nil {
+		return nil, err
+	} else if validInvestor.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("investor not found")
+	} else if validInvestor.Balance < req.Amount {
+		return nil, httpError.NewBadRequestError("insufficient balance")
+	}
+
+	newWithdrawal, err := u.withdrawalRepo.CreateWithTx(ctx, wallet.Withdrawal{
+		InvestorID:    investorID,
+		Amount:        req.Amount,
+		BankCode:      req.BankCode,
+		AccountNumber: req.AccountNumber,
+		AccountName:   req.AccountName,
+		Status:        wallet.WithdrawalStatusPending,
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.ledgerUsecase.PostWithTx(ctx, ledger.JournalEntry{
+		ReferenceType: ledger.ReferenceWithdrawal,
+		ReferenceID:   newWithdrawal.ID,
+		Description:   "Withdrawal requested",
+		Postings: []ledger.Posting{
+			ledger.Debit(ledger.AccountInvestorCash, &investorID, req.Amount),
+			ledger.Credit(ledger.AccountWithdrawalPending, &newWithdrawal.ID, req.Amount),
+		},
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newWithdrawal, nil
+}
+
+// ApproveWithdrawal moves a pending withdrawal to processing and then requests its payout. The status is committed
+// before the gateway is called, so a payout is never sent for a withdrawal that could be approved again, and the
+// withdrawal ID is the idempotency key of the payout. A processing withdrawal without a payout reference is approved
+// again to retry the request, which the gateway answers with the payout it already made. Only a payout the gateway
+// refused is failed, returning the amount to the investor.
+func (u *walletUsecase) ApproveWithdrawal(ctx context.Context, withdrawalID uuid.UUID, employeeID uuid.UUID) (*wallet.Withdrawal, error) {
+	ctx, span := tracer.Start(ctx, tracerName+".ApproveWithdrawal")
+	defer span.End()
+
+	validWithdrawal, err := u.startPayout(ctx, withdrawalID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	payout, err := u.gateway.CreatePayout(ctx, payment.PayoutRequest{
+		ReferenceID:    validWithdrawal.ID,
+		IdempotencyKey: validWithdrawal.ID.String(),
+		BankCode:       validWithdrawal.BankCode,
+		AccountNumber:  validWithdrawal.AccountNumber,
+		AccountName:    validWithdrawal.AccountName,
+		Amount:         validWithdrawal.Amount,
+	})
+	var refused *payment.PayoutRefusedError
+	if errors.As(err, &refused) {
+		if failErr := u.failPayout(ctx, withdrawalID, refused.Reason); failErr != nil {
+			return nil, failErr
+		}
+
+		return nil, httpError.NewInternalServerError("payout request failed, the amount was returned to the investor", refused.Reason)
+	} else if err != nil {
+		// The transfer may have gone out, so the withdrawal stays processing until an approval retries the payout
+		return nil, httpError.NewInternalServerError("payout request failed, approve the withdrawal again to retry", err.Error())
+	}
+
+	updatedWithdrawal, err := u.withdrawalRepo.UpdateWithMap(ctx, withdrawalID, map[string]any{
+		"payout_reference": payout.Reference,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedWithdrawal, nil
+}
+
+// startPayout commits the approval of a pending withdrawal, or returns a processing one whose payout was not recorded
+func (u *walletUsecase) startPayout(ctx context.Context, withdrawalID uuid.UUID, employeeID uuid.UUID) (res wallet.Withdrawal, err error) {
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.withdrawalRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.withdrawalRepo.Rollback(trx)
+			return
+		}
+
+		u.withdrawalRepo.Commit(trx)
+	}()
+
+	validWithdrawal, err := u.withdrawalRepo.GetByIDLockTx(ctx, withdrawalID, trx)
+	if err != nil {
+		return wallet.Withdrawal{}, err
+	} else if validWithdrawal.ID == uuid.Nil {
+		return wallet.Withdrawal{}, httpError.NewNotFoundError("withdrawal not found")
+	} else if validWithdrawal.Status == wallet.WithdrawalStatusProcessing && validWithdrawal.PayoutReference == nil {
+		return validWithdrawal, nil
+	} else if !validWithdrawal.Status.CanTransitionTo(wallet.WithdrawalStatusProcessing) {
+		return wallet.Withdrawal{}, httpError.NewBadRequestError("withdrawal is not in pending status")
+	}
+
+	return u.withdrawalRepo.UpdateWithMapTx(ctx, withdrawalID, map[string]any{
+		"status":               wallet.WithdrawalStatusProcessing,
+		"reviewer_employee_id": employeeID,
+		"reviewed_at":          time.Now(),
+	}, trx)
+}
+
+// failPayout records a payout the gateway refused as failed, returning the held amount to the investor
+func (u *walletUsecase) failPayout(ctx context.Context, withdrawalID uuid.UUID, reason string) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.withdrawalRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.withdrawalRepo.Rollback(trx)
+			return
+		}
+
+		u.withdrawalRepo.Commit(trx)
+	}()
+
+	validWithdrawal, err := u.withdrawalRepo.GetByIDLockTx(ctx, withdrawalID, trx)
+	if err != nil {
+		return err
+	} else if validWithdrawal.Status != wallet.WithdrawalStatusProcessing {
+		return httpError.NewBadRequestError("withdrawal is not in processing status")
+	}
+
+	return u.settleWithdrawalWithTx(ctx, validWithdrawal, wallet.WithdrawalStatusFailed, reason, trx)
+}
+
+func (u *walletUsecase) RejectWithdrawal(ctx context.Context, withdrawalID uuid.UUID, employeeID uuid.UUID, req wallet.RejectWithdrawalRequest) (res *wallet.Withdrawal, err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".RejectWithdrawal")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.withdrawalRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.withdrawalRepo.Rollback(trx)
+			return
+		}
+
+		u.withdrawalRepo.Commit(trx)
+	}()
+
+	validWithdrawal, err := u.withdrawalRepo.GetByIDLockTx(ctx, withdrawalID, trx)
+	if err != nil {
+		return nil, err
+	} else if validWithdrawal.ID == uuid.Nil {
+		return nil, httpError.NewNotFoundError("withdrawal not found")
+	} else if !validWithdrawal.Status.CanTransitionTo(wallet.WithdrawalStatusRejected) {
+		return nil, httpError.NewBadRequestError("withdrawal is not in pending status")
+	}
+
+	updatedWithdrawal, err := u.withdrawalRepo.UpdateWithMapTx(ctx, withdrawalID, map[string]any{
+		"status":               wallet.WithdrawalStatusRejected,
+		"reviewer_employee_id": employeeID,
+		"reviewed_at":          time.Now(),
+		"review_note":          req.Note,
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.ledgerUsecase.PostWithTx(ctx, ledger.JournalEntry{
+		ReferenceType: ledger.ReferenceWithdrawal,
+		ReferenceID:   validWithdrawal.ID,
+		Description:   "Withdrawal rejected",
+		Postings: []ledger.Posting{
+			ledger.Debit(ledger.AccountWithdrawalPending, &validWithdrawal.ID, validWithdrawal.Amount),
+			ledger.Credit(ledger.AccountInvestorCash, &validWithdrawal.InvestorID, validWithdrawal.Amount),
+		},
+	}, trx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedWithdrawal, nil
+}
+
+// HandlePayoutCallback settles a processing withdrawal from a signed gateway webhook. A completed payout releases the
+// held cash to the bank, a failed one returns it to the investor. Repeated deliveries of the same outcome are ignored.
+func (u *walletUsecase) HandlePayoutCallback(ctx context.Context, payload []byte, signature string) (err error) {
+	ctx, span := tracer.Start(ctx, tracerName+".HandlePayoutCallback")
+	defer span.End()
+
+	if !u.gateway.VerifySignature(payload, signature) {
+		return httpError.NewForbiddenError("invalid callback signature")
+	}
+
+	var req wallet.PayoutCallbackRequest
+	if err = json.Unmarshal(payload, &req); err != nil {
+		return httpError.NewBadRequestError("invalid callback payload")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.CONTEXT_TIMEOUT)
+	defer cancel()
+
+	trx := u.withdrawalRepo.BeginTransaction(ctx)
+	defer func() {
+		if err != nil {
+			u.withdrawalRepo.Rollback(trx)
+			return
+		}
+
+		u.withdrawalRepo.Commit(trx)
+	}()
+
+	validWithdrawal, err := u.withdrawalRepo.GetByPayoutReferenceLockTx(ctx, req.Reference, trx)
+	if err != nil {
+		return err
+	} else if validWithdrawal.ID == uuid.Nil {
+		return httpError.NewNotFoundError("withdrawal not found")
+	} else if validWithdrawal.Status == req.Status {
+		return nil
+	} else if !validWithdrawal.Status.CanTransitionTo(req.Status) {
+		return httpError.NewBadRequestError("invalid payout status transition")
+	}
+
+	return u.settleWithdrawalWithTx(ctx, validWithdrawal, req.Status, req.FailureReason, trx)
+}
+
+// settleWithdrawalWithTx moves a processing withdrawal to completed, releasing the held cash to the bank, or to
+// failed, returning it to the investor. The withdrawal must be locked within trx.
+func (u *walletUsecase) settleWithdrawalWithTx(ctx context.Context, validWithdrawal wallet.Withdrawal, status wallet.WithdrawalStatus, failureReason string, trx *gorm.DB) error {
+	updates := map[string]any{
+		"status": status,
+	}
+
+	entry := ledger.JournalEntry{
+		ReferenceType: ledger.ReferenceWithdrawal,
+		ReferenceID:   validWithdrawal.ID,
+	}
+
+	if status == wallet.WithdrawalStatusCompleted {
+		updates["completed_at"] = time.Now()
+		entry.Description = "Withdrawal paid out"
+		entry.Postings = []ledger.Posting{
+			ledger.Debit(ledger.AccountWithdrawalPending, &validWithdrawal.ID, validWithdrawal.Amount),
+			ledger.Credit(ledger.AccountSettlementCash, nil, validWithdrawal.Amount),
+		}
+	} else {
+		updates["failure_reason"] = failureReason
+		entry.Description = "Withdrawal payout failed"
+		entry.Postings = []ledger.Posting{
+			ledger.Debit(ledger.AccountWithdrawalPending, &validWithdrawal.ID, validWithdrawal.Amount),
+			ledger.Credit(ledger.AccountInvestorCash, &validWithdrawal.InvestorID, validWithdrawal.Amount),
+		}
+	}
+
+	_, err := u.withdrawalRepo.UpdateWithMapTx(ctx, validWithdrawal.ID, updates, trx)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.ledgerUsecase.PostWithTx(ctx, entry, trx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,535 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/application/wallet/usecase"
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/investor"
+	investorMock "github.com/BagusAK95/amarta_test/internal/domain/investor/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
+	ledgerMock "github.com/BagusAK95/amarta_test/internal/domain/ledger/mock"
+	"github.com/BagusAK95/amarta_test/internal/domain/wallet"
+	walletMock "github.com/BagusAK95/amarta_test/internal/domain/wallet/mock"
+	"github.com/BagusAK95/amarta_test/internal/infrastructure/payment"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newGateway() *payment.FakeGateway {
+	return payment.NewFakeGateway(config.PaymentConfig{CallbackSecret: "test-secret", VirtualAccountExpiry: 24})
+}
+
+// failingGateway is a gateway whose payouts always fail with err
+type failingGateway struct {
+	*payment.FakeGateway
+	err error
+}
+
+func (g failingGateway) CreatePayout(ctx context.Context, req payment.PayoutRequest) (payment.Payout, error) {
+	return payment.Payout{}, g.err
+}
+
+func signedPayload(t *testing.T, gateway *payment.FakeGateway, body any) ([]byte, string) {
+	payload, err := json.Marshal(body)
+	assert.NoError(t, err)
+
+	return payload, gateway.Sign(payload)
+}
+
+func TestCreateTopup(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	req := wallet.CreateTopupRequest{Amount: 500000, Bank: "bca"}
+
+	t.Run("success", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		investorRepo.On("GetByID", mock.Anything, investorID).Return(investor.Investor{BaseModel: model.BaseModel{ID: investorID}, FullName: "Michael Chen"}, nil)
+		topupRepo.On("Create", mock.Anything, mock.AnythingOfType("wallet.Topup")).
+			Return(func(_ context.Context, topup wallet.Topup) (wallet.Topup, error) {
+				return topup, nil
+			})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, newGateway())
+		res, err := uc.CreateTopup(ctx, investorID, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		assert.Equal(t, wallet.TopupStatusPending, res.Status)
+		assert.Equal(t, "va-"+res.ID.String(), res.ExternalID)
+		assert.True(t, strings.HasPrefix(res.VirtualAccountNumber, "8808"))
+		assert.True(t, res.ExpiresAt.After(time.Now()))
+		topupRepo.AssertExpectations(t)
+		investorRepo.AssertExpectations(t)
+	})
+
+	t.Run("investor not found", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		investorRepo.On("GetByID", mock.Anything, investorID).Return(investor.Investor{}, nil)
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, newGateway())
+		res, err := uc.CreateTopup(ctx, investorID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewNotFoundError("investor not found"), err)
+		investorRepo.AssertExpectations(t)
+	})
+}
+
+func TestHandleTopupCallback(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	topupData := wallet.Topup{
+		BaseModel:  model.BaseModel{ID: uuid.New()},
+		InvestorID: investorID,
+		Amount:     500000,
+		Bank:       "bca",
+		ExternalID: "va-123",
+		Status:     wallet.TopupStatusPending,
+	}
+	paidAt := time.Date(2025, 9, 24, 10, 0, 0, 0, time.UTC)
+
+	t.Run("success credits investor", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		gateway := newGateway()
+
+		payload, signature := signedPayload(t, gateway, wallet.TopupCallbackRequest{
+			ExternalID: "va-123",
+			Amount:     500000,
+			Status:     wallet.TopupStatusPaid,
+			PaidAt:     paidAt,
+		})
+
+		topupRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		topupRepo.On("GetByExternalIDLockTx", mock.Anything, "va-123", mock.Anything).Return(topupData, nil)
+		topupRepo.On("UpdateWithMapTx", mock.Anything, topupData.ID, map[string]any{
+			"status":  wallet.TopupStatusPaid,
+			"paid_at": paidAt,
+		}, mock.Anything).Return(wallet.Topup{}, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return entry.ReferenceType == ledger.ReferenceTopup && assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountSettlementCash, nil, 500000),
+				ledger.Credit(ledger.AccountInvestorCash, &investorID, 500000),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		topupRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, gateway)
+		err := uc.HandleTopupCallback(ctx, payload, signature)
+
+		assert.NoError(t, err)
+		topupRepo.AssertExpectations(t)
+		ledgerUsecase.AssertExpectations(t)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		gateway := newGateway()
+
+		payload, _ := signedPayload(t, gateway, wallet.TopupCallbackRequest{ExternalID: "va-123", Amount: 500000, Status: wallet.TopupStatusPaid})
+		forged := payment.NewFakeGateway(config.PaymentConfig{CallbackSecret: "other-secret"}).Sign(payload)
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, gateway)
+		err := uc.HandleTopupCallback(ctx, payload, forged)
+
+		assert.Equal(t, httpError.NewForbiddenError("invalid callback signature"), err)
+		topupRepo.AssertNotCalled(t, "BeginTransaction", mock.Anything)
+	})
+
+	t.Run("amount mismatch", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		gateway := newGateway()
+
+		payload, signature := signedPayload(t, gateway, wallet.TopupCallbackRequest{ExternalID: "va-123", Amount: 400000, Status: wallet.TopupStatusPaid})
+
+		topupRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		topupRepo.On("GetByExternalIDLockTx", mock.Anything, "va-123", mock.Anything).Return(topupData, nil)
+		topupRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, gateway)
+		err := uc.HandleTopupCallback(ctx, payload, signature)
+
+		assert.Equal(t, httpError.NewBadRequestError("callback amount does not match topup amount"), err)
+		topupRepo.AssertExpectations(t)
+	})
+
+	t.Run("already paid is ignored", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		gateway := newGateway()
+
+		paidTopup := topupData
+		paidTopup.Status = wallet.TopupStatusPaid
+		payload, signature := signedPayload(t, gateway, wallet.TopupCallbackRequest{ExternalID: "va-123", Amount: 500000, Status: wallet.TopupStatusPaid})
+
+		topupRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		topupRepo.On("GetByExternalIDLockTx", mock.Anything, "va-123", mock.Anything).Return(paidTopup, nil)
+		topupRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, gateway)
+		err := uc.HandleTopupCallback(ctx, payload, signature)
+
+		assert.NoError(t, err)
+		topupRepo.AssertExpectations(t)
+		ledgerUsecase.AssertNotCalled(t, "PostWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCreateWithdrawal(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	withdrawalID := uuid.New()
+	req := wallet.CreateWithdrawalRequest{
+		Amount:        200000,
+		BankCode:      "bca",
+		AccountNumber: "1234567890",
+		AccountName:   "Michael Chen",
+	}
+
+	t.Run("success holds funds", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		withdrawalRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		investorRepo.On("GetByIDLockTx", mock.Anything, investorID, mock.Anything).Return(investor.Investor{BaseModel: model.BaseModel{ID: investorID}, Balance: 1000000}, nil)
+		withdrawalRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("wallet.Withdrawal"), mock.Anything).
+			Return(func(_ context.Context, w wallet.Withdrawal, _ *gorm.DB) (wallet.Withdrawal, error) {
+				w.ID = withdrawalID
+				return w, nil
+			})
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return entry.ReferenceID == withdrawalID && assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountInvestorCash, &investorID, 200000),
+				ledger.Credit(ledger.AccountWithdrawalPending, &withdrawalID, 200000),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		withdrawalRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, newGateway())
+		res, err := uc.CreateWithdrawal(ctx, investorID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, wallet.WithdrawalStatusPending, res.Status)
+		withdrawalRepo.AssertExpectations(t)
+		investorRepo.AssertExpectations(t)
+		ledgerUsecase.AssertExpectations(t)
+	})
+
+	t.Run("insufficient balance", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		withdrawalRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		investorRepo.On("GetByIDLockTx", mock.Anything, investorID, mock.Anything).Return(investor.Investor{BaseModel: model.BaseModel{ID: investorID}, Balance: 100000}, nil)
+		withdrawalRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, newGateway())
+		res, err := uc.CreateWithdrawal(ctx, investorID, req)
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("insufficient balance"), err)
+		withdrawalRepo.AssertExpectations(t)
+	})
+}
+
+func TestReviewWithdrawal(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	employeeID := uuid.New()
+	withdrawalData := wallet.Withdrawal{
+		BaseModel:  model.BaseModel{ID: uuid.New()},
+		InvestorID: investorID,
+		Amount:     200000,
+		Status:     wallet.WithdrawalStatusPending,
+	}
+
+	t.Run("approve requests payout", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		processing := withdrawalData
+		processing.Status = wallet.WithdrawalStatusProcessing
+		withdrawalRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		withdrawalRepo.On("GetByIDLockTx", mock.Anything, withdrawalData.ID, mock.Anything).Return(withdrawalData, nil)
+		withdrawalRepo.On("UpdateWithMapTx", mock.Anything, withdrawalData.ID, mock.MatchedBy(func(payload map[string]any) bool {
+			return payload["status"] == wallet.WithdrawalStatusProcessing &&
+				payload["reviewer_employee_id"] == employeeID &&
+				payload["payout_reference"] == nil
+		}), mock.Anything).Return(processing, nil)
+		withdrawalRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+		withdrawalRepo.On("UpdateWithMap", mock.Anything, withdrawalData.ID, map[string]any{
+			"payout_reference": "po-" + withdrawalData.ID.String(),
+		}).Return(processing, nil)
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, newGateway())
+		res, err := uc.ApproveWithdrawal(ctx, withdrawalData.ID, employeeID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, wallet.WithdrawalStatusProcessing, res.Status)
+		withdrawalRepo.AssertExpectations(t)
+		withdrawalRepo.AssertNumberOfCalls(t, "Commit", 1)
+	})
+
+	t.Run("approve retries a payout that was not recorded", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		processing := withdrawalData
+		processing.Status = wallet.WithdrawalStatusProcessing
+		withdrawalRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		withdrawalRepo.On("GetByIDLockTx", mock.Anything, withdrawalData.ID, mock.Anything).Return(processing, nil)
+		withdrawalRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+		withdrawalRepo.On("UpdateWithMap", mock.Anything, withdrawalData.ID, map[string]any{
+			"payout_reference": "po-" + withdrawalData.ID.String(),
+		}).Return(processing, nil)
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, newGateway())
+		res, err := uc.ApproveWithdrawal(ctx, withdrawalData.ID, employeeID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, wallet.WithdrawalStatusProcessing, res.Status)
+		withdrawalRepo.AssertExpectations(t)
+		withdrawalRepo.AssertNotCalled(t, "UpdateWithMapTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("approve with refused payout returns funds", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		processing := withdrawalData
+		processing.Status = wallet.WithdrawalStatusProcessing
+		withdrawalRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		withdrawalRepo.On("GetByIDLockTx", mock.Anything, withdrawalData.ID, mock.Anything).Return(withdrawalData, nil).Once()
+		withdrawalRepo.On("UpdateWithMapTx", mock.Anything, withdrawalData.ID, mock.MatchedBy(func(payload map[string]any) bool {
+			return payload["status"] == wallet.WithdrawalStatusProcessing
+		}), mock.Anything).Return(processing, nil)
+		withdrawalRepo.On("GetByIDLockTx", mock.Anything, withdrawalData.ID, mock.Anything).Return(processing, nil).Once()
+		withdrawalRepo.On("UpdateWithMapTx", mock.Anything, withdrawalData.ID, map[string]any{
+			"status":         wallet.WithdrawalStatusFailed,
+			"failure_reason": "account closed",
+		}, mock.Anything).Return(wallet.Withdrawal{}, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountWithdrawalPending, &withdrawalData.ID, 200000),
+				ledger.Credit(ledger.AccountInvestorCash, &investorID, 200000),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		withdrawalRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, failingGateway{newGateway(), &payment.PayoutRefusedError{Reason: "account closed"}})
+		res, err := uc.ApproveWithdrawal(ctx, withdrawalData.ID, employeeID)
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewInternalServerError("payout request failed, the amount was returned to the investor", "account closed"), err)
+		withdrawalRepo.AssertExpectations(t)
+		withdrawalRepo.AssertNumberOfCalls(t, "Commit", 2)
+		withdrawalRepo.AssertNotCalled(t, "UpdateWithMap", mock.Anything, mock.Anything, mock.Anything)
+		ledgerUsecase.AssertExpectations(t)
+	})
+
+	t.Run("approve with unknown payout outcome stays processing", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		processing := withdrawalData
+		processing.Status = wallet.WithdrawalStatusProcessing
+		withdrawalRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		withdrawalRepo.On("GetByIDLockTx", mock.Anything, withdrawalData.ID, mock.Anything).Return(withdrawalData, nil).Once()
+		withdrawalRepo.On("UpdateWithMapTx", mock.Anything, withdrawalData.ID, mock.MatchedBy(func(payload map[string]any) bool {
+			return payload["status"] == wallet.WithdrawalStatusProcessing
+		}), mock.Anything).Return(processing, nil)
+		withdrawalRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, failingGateway{newGateway(), context.DeadlineExceeded})
+		res, err := uc.ApproveWithdrawal(ctx, withdrawalData.ID, employeeID)
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewInternalServerError("payout request failed, approve the withdrawal again to retry", context.DeadlineExceeded.Error()), err)
+		withdrawalRepo.AssertExpectations(t)
+		withdrawalRepo.AssertNumberOfCalls(t, "Commit", 1)
+		withdrawalRepo.AssertNotCalled(t, "UpdateWithMap", mock.Anything, mock.Anything, mock.Anything)
+		ledgerUsecase.AssertNotCalled(t, "PostWithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("approve not pending", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		rejected := withdrawalData
+		rejected.Status = wallet.WithdrawalStatusRejected
+		withdrawalRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		withdrawalRepo.On("GetByIDLockTx", mock.Anything, withdrawalData.ID, mock.Anything).Return(rejected, nil)
+		withdrawalRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, newGateway())
+		res, err := uc.ApproveWithdrawal(ctx, withdrawalData.ID, employeeID)
+
+		assert.Nil(t, res)
+		assert.Equal(t, httpError.NewBadRequestError("withdrawal is not in pending status"), err)
+		withdrawalRepo.AssertExpectations(t)
+	})
+
+	t.Run("reject returns funds", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+
+		withdrawalRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		withdrawalRepo.On("GetByIDLockTx", mock.Anything, withdrawalData.ID, mock.Anything).Return(withdrawalData, nil)
+		withdrawalRepo.On("UpdateWithMapTx", mock.Anything, withdrawalData.ID, mock.Anything, mock.Anything).Return(wallet.Withdrawal{Status: wallet.WithdrawalStatusRejected}, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountWithdrawalPending, &withdrawalData.ID, 200000),
+				ledger.Credit(ledger.AccountInvestorCash, &investorID, 200000),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		withdrawalRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, newGateway())
+		res, err := uc.RejectWithdrawal(ctx, withdrawalData.ID, employeeID, wallet.RejectWithdrawalRequest{Note: "account name mismatch"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, wallet.WithdrawalStatusRejected, res.Status)
+		withdrawalRepo.AssertExpectations(t)
+		ledgerUsecase.AssertExpectations(t)
+	})
+}
+
+func TestHandlePayoutCallback(t *testing.T) {
+	ctx := context.Background()
+	investorID := uuid.New()
+	reference := "po-123"
+	withdrawalData := wallet.Withdrawal{
+		BaseModel:       model.BaseModel{ID: uuid.New()},
+		InvestorID:      investorID,
+		Amount:          200000,
+		Status:          wallet.WithdrawalStatusProcessing,
+		PayoutReference: &reference,
+	}
+
+	t.Run("completed settles to bank", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		gateway := newGateway()
+
+		payload, signature := signedPayload(t, gateway, wallet.PayoutCallbackRequest{Reference: reference, Status: wallet.WithdrawalStatusCompleted})
+
+		withdrawalRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		withdrawalRepo.On("GetByPayoutReferenceLockTx", mock.Anything, reference, mock.Anything).Return(withdrawalData, nil)
+		withdrawalRepo.On("UpdateWithMapTx", mock.Anything, withdrawalData.ID, mock.MatchedBy(func(payload map[string]any) bool {
+			return payload["status"] == wallet.WithdrawalStatusCompleted && payload["completed_at"] != nil
+		}), mock.Anything).Return(wallet.Withdrawal{}, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountWithdrawalPending, &withdrawalData.ID, 200000),
+				ledger.Credit(ledger.AccountSettlementCash, nil, 200000),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		withdrawalRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, gateway)
+		err := uc.HandlePayoutCallback(ctx, payload, signature)
+
+		assert.NoError(t, err)
+		withdrawalRepo.AssertExpectations(t)
+		ledgerUsecase.AssertExpectations(t)
+	})
+
+	t.Run("failed refunds investor", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		gateway := newGateway()
+
+		payload, signature := signedPayload(t, gateway, wallet.PayoutCallbackRequest{Reference: reference, Status: wallet.WithdrawalStatusFailed, FailureReason: "account closed"})
+
+		withdrawalRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		withdrawalRepo.On("GetByPayoutReferenceLockTx", mock.Anything, reference, mock.Anything).Return(withdrawalData, nil)
+		withdrawalRepo.On("UpdateWithMapTx", mock.Anything, withdrawalData.ID, map[string]any{
+			"status":         wallet.WithdrawalStatusFailed,
+			"failure_reason": "account closed",
+		}, mock.Anything).Return(wallet.Withdrawal{}, nil)
+		ledgerUsecase.On("PostWithTx", mock.Anything, mock.MatchedBy(func(entry ledger.JournalEntry) bool {
+			return assert.ObjectsAreEqual([]ledger.Posting{
+				ledger.Debit(ledger.AccountWithdrawalPending, &withdrawalData.ID, 200000),
+				ledger.Credit(ledger.AccountInvestorCash, &investorID, 200000),
+			}, entry.Postings)
+		}), mock.Anything).Return(ledger.JournalEntry{}, nil)
+		withdrawalRepo.On("Commit", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, gateway)
+		err := uc.HandlePayoutCallback(ctx, payload, signature)
+
+		assert.NoError(t, err)
+		withdrawalRepo.AssertExpectations(t)
+		ledgerUsecase.AssertExpectations(t)
+	})
+
+	t.Run("invalid transition", func(t *testing.T) {
+		topupRepo := new(walletMock.MockITopupRepository)
+		withdrawalRepo := new(walletMock.MockIWithdrawalRepository)
+		investorRepo := new(investorMock.MockIInvestorRepository)
+		ledgerUsecase := new(ledgerMock.MockILedgerUsecase)
+		gateway := newGateway()
+
+		completed := withdrawalData
+		completed.Status = wallet.WithdrawalStatusCompleted
+		payload, signature := signedPayload(t, gateway, wallet.PayoutCallbackRequest{Reference: reference, Status: wallet.WithdrawalStatusFailed})
+
+		withdrawalRepo.On("BeginTransaction", mock.Anything).Return(&gorm.DB{})
+		withdrawalRepo.On("GetByPayoutReferenceLockTx", mock.Anything, reference, mock.Anything).Return(completed, nil)
+		withdrawalRepo.On("Rollback", mock.Anything).Return(&gorm.DB{})
+
+		uc := usecase.NewWalletUsecase(topupRepo, withdrawalRepo, investorRepo, ledgerUsecase, gateway)
+		err := uc.HandlePayoutCallback(ctx, payload, signature)
+
+		assert.Equal(t, httpError.NewBadRequestError("invalid payout status transition"), err)
+		withdrawalRepo.AssertExpectations(t)
+	})
+}
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"errors"
 	"time"
 
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
 	"github.com/spf13/viper"
 )
 
@@ -11,6 +13,9 @@ type Config struct {
 	Postgres    PostgresConfig
 	Mail        MailConfig
 	Jaeger      JaegerConfig
+	Payment     PaymentConfig
+	Loan        LoanConfig
+	Auth        AuthConfig
 }
 
 type ApplicationConfig struct {
@@ -41,6 +46,23 @@ type PostgresConfig struct {
 // Global config
 var APP_URL string
 var CONTEXT_TIMEOUT time.Duration
+var INVESTMENT_COOLING_OFF_PERIOD time.Duration
+
+// Concentration limits on a single investor, a zero value disables the limit
+var INVESTMENT_MAX_LOAN_SHARE float64
+var INVESTMENT_MAX_AMOUNT_PER_LOAN money.Money
+var INVESTMENT_MAX_BORROWER_SHARE float64
+
+// Eligibility rules checked before a loan is proposed, a zero maximum disables the limit
+var ELIGIBILITY_MAX_ACTIVE_LOANS int
+var ELIGIBILITY_REJECTION_COOLDOWN time.Duration
+var ELIGIBILITY_BASE_CREDIT_LIMIT money.Money
+var ELIGIBILITY_CREDIT_LIMIT_STEP money.Money
+var ELIGIBILITY_MAX_CREDIT_LIMIT money.Money
+
+// Bounds on the rate and ROI of a loan against the pricing table, in percentage points
+var PRICING_MIN_SPREAD float32
+var PRICING_MAX_DEVIATION float32
 
 type MailConfig struct {
 	Host     string `mapstructure:"MAIL_HOST"`
@@ -56,6 +78,26 @@ type JaegerConfig struct {
 	ServiceName string `mapstructure:"JAEGER_SERVICE_NAME"`
 }
 
+type PaymentConfig struct {
+	Gateway              string `mapstructure:"PAYMENT_GATEWAY"`
+	CallbackSecret       string `mapstructure:"PAYMENT_CALLBACK_SECRET"`
+	VirtualAccountExpiry int    `mapstructure:"PAYMENT_VIRTUAL_ACCOUNT_EXPIRY"`
+}
+
+type LoanConfig struct {
+	FundingWindow  int `mapstructure:"LOAN_FUNDING_WINDOW"`
+	ExpiryInterval int `mapstructure:"LOAN_EXPIRY_INTERVAL"`
+}
+
+type AuthConfig struct {
+	SigningMethod   string `mapstructure:"AUTH_SIGNING_METHOD"`
+	Secret          string `mapstructure:"AUTH_SECRET"`
+	PrivateKeyPath  string `mapstructure:"AUTH_PRIVATE_KEY_PATH"`
+	PublicKeyPath   string `mapstructure:"AUTH_PUBLIC_KEY_PATH"`
+	AccessTokenTTL  int    `mapstructure:"AUTH_ACCESS_TOKEN_TTL"`
+	RefreshTokenTTL int    `mapstructure:"AUTH_REFRESH_TOKEN_TTL"`
+}
+
 func Load() (config Config, err error) {
 	viper.AddConfigPath("./")
 	viper.SetConfigName(".env")
@@ -81,9 +123,37 @@ func Load() (config Config, err error) {
 	if err = viper.Unmarshal(&config.Jaeger); err != nil {
 		return
 	}
+	if err = viper.Unmarshal(&config.Payment); err != nil {
+		return
+	}
+	if err = viper.Unmarshal(&config.Loan); err != nil {
+		return
+	}
+	if config.Loan.FundingWindow <= 0 {
+		err = errors.New("LOAN_FUNDING_WINDOW must be greater than 0")
+		return
+	}
+	if config.Loan.ExpiryInterval <= 0 {
+		err = errors.New("LOAN_EXPIRY_INTERVAL must be greater than 0")
+		return
+	}
+	if err = viper.Unmarshal(&config.Auth); err != nil {
+		return
+	}
 
 	CONTEXT_TIMEOUT, err = time.ParseDuration(viper.GetString("CONTEXT_TIMEOUT") + "s")
 	APP_URL = viper.GetString("APP_URL")
+	INVESTMENT_COOLING_OFF_PERIOD = time.Duration(viper.GetInt("INVESTMENT_COOLING_OFF_PERIOD")) * time.Minute
+	INVESTMENT_MAX_LOAN_SHARE = viper.GetFloat64("INVESTMENT_MAX_LOAN_SHARE")
+	INVESTMENT_MAX_AMOUNT_PER_LOAN = money.Money(viper.GetInt64("INVESTMENT_MAX_AMOUNT_PER_LOAN"))
+	INVESTMENT_MAX_BORROWER_SHARE = viper.GetFloat64("INVESTMENT_MAX_BORROWER_SHARE")
+	ELIGIBILITY_MAX_ACTIVE_LOANS = viper.GetInt("ELIGIBILITY_MAX_ACTIVE_LOANS")
+	ELIGIBILITY_REJECTION_COOLDOWN = time.Duration(viper.GetInt("ELIGIBILITY_REJECTION_COOLDOWN")) * 24 * time.Hour
+	ELIGIBILITY_BASE_CREDIT_LIMIT = money.Money(viper.GetInt64("ELIGIBILITY_BASE_CREDIT_LIMIT"))
+	ELIGIBILITY_CREDIT_LIMIT_STEP = money.Money(viper.GetInt64("ELIGIBILITY_CREDIT_LIMIT_STEP"))
+	ELIGIBILITY_MAX_CREDIT_LIMIT = money.Money(viper.GetInt64("ELIGIBILITY_MAX_CREDIT_LIMIT"))
+	PRICING_MIN_SPREAD = float32(viper.GetFloat64("PRICING_MIN_SPREAD"))
+	PRICING_MAX_DEVIATION = float32(viper.GetFloat64("PRICING_MAX_DEVIATION"))
 
 	return
 }
@@ -95,4 +165,27 @@ func setDefaultConfig() {
 	viper.SetDefault("POSTGRES_MAX_OPEN_CONNECTIONS", 10)
 	viper.SetDefault("POSTGRES_MAX_IDLE_CONNECTIONS", 10)
 	viper.SetDefault("POSTGRES_CONN_MAX_LIFETIME", 300)
+
+	viper.SetDefault("PAYMENT_VIRTUAL_ACCOUNT_EXPIRY", 24)
+
+	viper.SetDefault("LOAN_FUNDING_WINDOW", 14)
+	viper.SetDefault("LOAN_EXPIRY_INTERVAL", 60)
+
+	viper.SetDefault("AUTH_SIGNING_METHOD", "HS256")
+	viper.SetDefault("AUTH_ACCESS_TOKEN_TTL", 15)
+	viper.SetDefault("AUTH_REFRESH_TOKEN_TTL", 168)
+
+	viper.SetDefault("INVESTMENT_COOLING_OFF_PERIOD", 60)
+	viper.SetDefault("INVESTMENT_MAX_LOAN_SHARE", 0)
+	viper.SetDefault("INVESTMENT_MAX_AMOUNT_PER_LOAN", 0)
+	viper.SetDefault("INVESTMENT_MAX_BORROWER_SHARE", 0)
+
+	viper.SetDefault("ELIGIBILITY_MAX_ACTIVE_LOANS", 2)
+	viper.SetDefault("ELIGIBILITY_REJECTION_COOLDOWN", 30)
+	viper.SetDefault("ELIGIBILITY_BASE_CREDIT_LIMIT", 10000000)
+	viper.SetDefault("ELIGIBILITY_CREDIT_LIMIT_STEP", 5000000)
+	viper.SetDefault("ELIGIBILITY_MAX_CREDIT_LIMIT", 100000000)
+
+	viper.SetDefault("PRICING_MIN_SPREAD", 2)
+	viper.SetDefault("PRICING_MAX_DEVIATION", 2)
 }
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/employee"
+	"github.com/google/uuid"
+)
+
+const (
+	RoleEmployee = "employee"
+	RoleInvestor = "investor"
+)
+
+// Principal is the authenticated caller of a request. Permissions and branches only apply to employees.
+type Principal struct {
+	ID          uuid.UUID
+	Role        string
+	TokenID     uuid.UUID
+	ExpiresAt   time.Time
+	Permissions []employee.Permission
+	BranchIDs   []uuid.UUID
+}
+
+func (p Principal) HasPermission(permission employee.Permission) bool {
+	return slices.Contains(p.Permissions, permission)
+}
+
+func (p Principal) BranchScope() branch.Scope {
+	if p.HasPermission(employee.PermissionBranchAll) {
+		return branch.AllBranches()
+	}
+
+	return branch.Scope{BranchIDs: p.BranchIDs}
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// RevokedToken blocks an access or refresh token until it would have expired anyway
+type RevokedToken struct {
+	model.BaseModel
+	TokenID   uuid.UUID `json:"token_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (RevokedToken) TableName() string {
+	return "revoked_tokens"
+}
@@ -0,0 +1,14 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+)
+
+type IRevokedTokenRepository interface {
+	repository.IBaseRepo[RevokedToken]
+	IsRevoked(ctx context.Context, tokenID uuid.UUID) (bool, error)
+	Revoke(ctx context.Context, revokedToken RevokedToken) (bool, error)
+}
@@ -0,0 +1,13 @@
+package auth
+
+import (
+	"context"
+)
+
+type IAuthUsecase interface {
+	LoginEmployee(ctx context.Context, req LoginRequest) (*TokenResponse, error)
+	LoginInvestor(ctx context.Context, req LoginRequest) (*TokenResponse, error)
+	Refresh(ctx context.Context, req RefreshRequest) (*TokenResponse, error)
+	Logout(ctx context.Context, principal Principal, req LogoutRequest) error
+	Authenticate(ctx context.Context, accessToken string) (Principal, error)
+}
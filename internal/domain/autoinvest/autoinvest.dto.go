@@ -0,0 +1,14 @@
+package autoinvest
+
+import (
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+)
+
+type SaveRuleRequest struct {
+	MaxAmountPerLoan money.Money `json:"max_amount_per_loan" validate:"required,min=1"`
+	MinROI           float32     `json:"min_roi" validate:"min=0"`
+	MaxTenor         int         `json:"max_tenor" validate:"required,min=1"`
+	DailyBudget      money.Money `json:"daily_budget" validate:"required,min=1"`
+	BorrowerSegments []string    `json:"borrower_segments" validate:"dive,oneof=micro small medium"`
+	Active           *bool       `json:"active"`
+}
@@ -0,0 +1,50 @@
+package autoinvest
+
+import (
+	"slices"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type Rule struct {
+	model.BaseModel
+	InvestorID       uuid.UUID      `json:"investor_id"`
+	MaxAmountPerLoan money.Money    `json:"max_amount_per_loan"`
+	MinROI           float32        `json:"min_roi"`
+	MaxTenor         int            `json:"max_tenor"`
+	DailyBudget      money.Money    `json:"daily_budget"`
+	BorrowerSegments pq.StringArray `json:"borrower_segments" gorm:"type:text[]"`
+	Active           bool           `json:"active"`
+}
+
+func (Rule) TableName() string {
+	return "auto_invest_rules"
+}
+
+// Matches reports whether a loan and its borrower fall within the rule. An empty segment list matches every borrower.
+func (r Rule) Matches(l loan.Loan, b borrower.Borrower) bool {
+	if l.ROI < r.MinROI || l.Tenor > r.MaxTenor {
+		return false
+	}
+
+	return len(r.BorrowerSegments) == 0 || slices.Contains(r.BorrowerSegments, b.Segment)
+}
+
+// AutoInvestment records an investment made on behalf of a rule, so the rule's daily budget can be tracked
+type AutoInvestment struct {
+	model.BaseModel
+	RuleID       uuid.UUID   `json:"rule_id"`
+	InvestorID   uuid.UUID   `json:"investor_id"`
+	LoanID       uuid.UUID   `json:"loan_id"`
+	InvestmentID uuid.UUID   `json:"investment_id"`
+	Amount       money.Money `json:"amount"`
+}
+
+func (AutoInvestment) TableName() string {
+	return "auto_investments"
+}
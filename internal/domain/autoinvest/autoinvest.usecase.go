@@ -0,0 +1,15 @@
+package autoinvest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type IAutoInvestUsecase interface {
+	CreateRule(ctx context.Context, investorID uuid.UUID, req SaveRuleRequest) (*Rule, error)
+	ListRule(ctx context.Context, investorID uuid.UUID) ([]Rule, error)
+	UpdateRule(ctx context.Context, investorID uuid.UUID, ruleID uuid.UUID, req SaveRuleRequest) (*Rule, error)
+	DeleteRule(ctx context.Context, investorID uuid.UUID, ruleID uuid.UUID) error
+	InvestLoan(ctx context.Context, loanID uuid.UUID) (invested int, err error)
+}
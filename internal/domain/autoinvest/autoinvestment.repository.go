@@ -0,0 +1,15 @@
+package autoinvest
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+)
+
+type IAutoInvestmentRepository interface {
+	repository.IBaseRepo[AutoInvestment]
+	GetTotalByRuleIDSince(ctx context.Context, ruleID uuid.UUID, since time.Time) (money.Money, error)
+}
@@ -0,0 +1,14 @@
+package autoinvest
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+)
+
+type IRuleRepository interface {
+	repository.IBaseRepo[Rule]
+	GetActive(ctx context.Context) ([]Rule, error)
+	GetByInvestorID(ctx context.Context, investorID uuid.UUID) ([]Rule, error)
+}
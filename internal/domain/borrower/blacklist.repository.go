@@ -0,0 +1,14 @@
+package borrower
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+)
+
+type IBlacklistRepository interface {
+	repository.IBaseRepo[BlacklistEntry]
+	GetActiveByBorrowerID(ctx context.Context, borrowerID uuid.UUID, asOf time.Time) ([]BlacklistEntry, error)
+}
@@ -0,0 +1,28 @@
+package borrower
+
+import (
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/google/uuid"
+)
+
+// BlacklistEntry bars a borrower from new loans until it expires or is lifted. Entries without ExpiresAt last until
+// they are lifted.
+type BlacklistEntry struct {
+	model.BaseModel
+	BorrowerID    uuid.UUID  `json:"borrower_id"`
+	Reason        string     `json:"reason"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	BlacklistedBy uuid.UUID  `json:"blacklisted_by"`
+	LiftedBy      *uuid.UUID `json:"lifted_by"`
+	LiftedAt      *time.Time `json:"lifted_at"`
+}
+
+func (BlacklistEntry) TableName() string {
+	return "borrower_blacklist"
+}
+
+func (e BlacklistEntry) ActiveAt(t time.Time) bool {
+	return e.LiftedAt == nil && (e.ExpiresAt == nil || e.ExpiresAt.After(t))
+}
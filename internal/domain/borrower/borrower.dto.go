@@ -0,0 +1,49 @@
+package borrower
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RegisterBorrowerRequest struct {
+	FullName     string    `json:"full_name" validate:"required"`
+	IDCardNumber string    `json:"id_card_number" validate:"required"`
+	Address      string    `json:"address" validate:"required"`
+	PhoneNumber  string    `json:"phone_number" validate:"required"`
+	Email        string    `json:"email" validate:"required,email"`
+	Segment      string    `json:"segment" validate:"required,oneof=micro small medium"`
+	BranchID     uuid.UUID `json:"branch_id" validate:"required"`
+}
+
+// UpdateBorrowerRequest changes the contact details of a borrower. The ID card number is checked through KYC and cannot
+// be changed.
+type UpdateBorrowerRequest struct {
+	FullName    string `json:"full_name" validate:"required"`
+	Address     string `json:"address" validate:"required"`
+	PhoneNumber string `json:"phone_number" validate:"required"`
+	Email       string `json:"email" validate:"required,email"`
+	Segment     string `json:"segment" validate:"required,oneof=micro small medium"`
+}
+
+// SearchBorrowerRequest narrows the borrowers list. Query matches the name, ID card number, phone number or email.
+type SearchBorrowerRequest struct {
+	Query     string    `form:"q"`
+	Status    string    `form:"status" validate:"omitempty,oneof=active inactive"`
+	KYCStatus KYCStatus `form:"kyc_status" validate:"omitempty,oneof=pending verified rejected"`
+}
+
+type SubmitKYCDocumentRequest struct {
+	Type    DocumentType `json:"type" validate:"required,oneof=id_card selfie"`
+	FileURL string       `json:"file_url" validate:"required,url"`
+}
+
+type RejectKYCDocumentRequest struct {
+	RejectReason string `json:"reject_reason" validate:"required"`
+}
+
+// BlacklistBorrowerRequest bars the borrower from new loans. Without ExpiresAt the entry lasts until it is lifted.
+type BlacklistBorrowerRequest struct {
+	Reason    string     `json:"reason" validate:"required"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
@@ -1,19 +1,49 @@
 package borrower
 
 import (
+	"errors"
+
 	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/google/uuid"
 )
 
 type Borrower struct {
 	model.BaseModel
-	FullName     string `json:"full_name"`
-	IDCardNumber string `json:"id_card_number"`
-	Address      string `json:"address"`
-	PhoneNumber  string `json:"phone_number"`
-	Email        string `json:"email"`
-	Status       string `json:"status"`
+	FullName     string           `json:"full_name"`
+	IDCardNumber string           `json:"id_card_number"`
+	Address      string           `json:"address"`
+	PhoneNumber  string           `json:"phone_number"`
+	Email        string           `json:"email"`
+	Status       string           `json:"status"`
+	KYCStatus    KYCStatus        `json:"kyc_status"`
+	Segment      string           `json:"segment"`
+	BranchID     uuid.UUID        `json:"branch_id"`
+	KYCDocuments []KYCDocument    `json:"kyc_documents,omitempty" gorm:"-"`
+	Blacklist    []BlacklistEntry `json:"blacklist,omitempty" gorm:"-"`
 }
 
 func (Borrower) TableName() string {
 	return "borrowers"
 }
+
+const (
+	SegmentMicro  = "micro"
+	SegmentSmall  = "small"
+	SegmentMedium = "medium"
+)
+
+const (
+	StatusActive   = "active"
+	StatusInactive = "inactive"
+)
+
+// CanBorrow tells why the borrower may not take a new loan, if anything
+func (b Borrower) CanBorrow() error {
+	if b.Status != StatusActive {
+		return errors.New("borrower is not active")
+	} else if b.KYCStatus != KYCStatusVerified {
+		return errors.New("borrower KYC is not verified")
+	}
+
+	return nil
+}
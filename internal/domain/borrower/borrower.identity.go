@@ -0,0 +1,83 @@
+package borrower
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ValidateNIK checks the structure of a 16-digit Indonesian NIK: a province code, the birth date as DDMMYY (with 40
+// added to the day for women) and a non-zero serial number.
+func ValidateNIK(nik string) error {
+	if len(nik) != 16 || strings.Trim(nik, "0123456789") != "" {
+		return errors.New("id_card_number must be 16 digits")
+	}
+
+	province, _ := strconv.Atoi(nik[0:2])
+	if province < 11 || province > 94 {
+		return errors.New("id_card_number has an unknown province code")
+	}
+
+	day, _ := strconv.Atoi(nik[6:8])
+	if day > 40 {
+		day -= 40
+	}
+
+	month, _ := strconv.Atoi(nik[8:10])
+	year, _ := strconv.Atoi(nik[10:12])
+	birthDate := time.Date(2000+year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if day < 1 || month < 1 || month > 12 || birthDate.Day() != day {
+		return errors.New("id_card_number has an invalid birth date")
+	}
+
+	if nik[12:16] == "0000" {
+		return errors.New("id_card_number has an invalid serial number")
+	}
+
+	return nil
+}
+
+// NormalizePhoneNumber accepts an Indonesian mobile number as 08…, 628… or +628…, ignoring spaces and dashes, and
+// returns it in the local 08… form that borrowers are stored with.
+func NormalizePhoneNumber(phone string) (string, error) {
+	normalized := strings.NewReplacer(" ", "", "-", "").Replace(phone)
+	switch {
+	case strings.HasPrefix(normalized, "+628"):
+		normalized = "0" + normalized[3:]
+	case strings.HasPrefix(normalized, "628"):
+		normalized = "0" + normalized[2:]
+	}
+
+	if !strings.HasPrefix(normalized, "08") || strings.Trim(normalized, "0123456789") != "" {
+		return "", errors.New("phone_number must be an Indonesian mobile number starting with 08, 628 or +628")
+	} else if len(normalized) < 10 || len(normalized) > 13 {
+		return "", errors.New("phone_number must have 10 to 13 digits")
+	}
+
+	return normalized, nil
+}
+
+// IdentifierConflicts lists the unique identifiers of the request that already belong to borrowers other than exceptID
+func IdentifierConflicts(borrowers []Borrower, exceptID uuid.UUID, idCardNumber string, phoneNumber string, email string) []string {
+	conflicts := []string{}
+	for _, b := range borrowers {
+		if b.ID == exceptID {
+			continue
+		}
+
+		if b.IDCardNumber == idCardNumber {
+			conflicts = append(conflicts, "id_card_number is already registered")
+		}
+		if b.PhoneNumber == phoneNumber {
+			conflicts = append(conflicts, "phone_number is already registered")
+		}
+		if b.Email == email {
+			conflicts = append(conflicts, "email is already registered")
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,109 @@
+package borrower
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/google/uuid"
+)
+
+type KYCStatus string
+
+const (
+	KYCStatusPending  KYCStatus = "pending"
+	KYCStatusVerified KYCStatus = "verified"
+	KYCStatusRejected KYCStatus = "rejected"
+)
+
+type DocumentType string
+
+const (
+	DocumentIDCard DocumentType = "id_card"
+	DocumentSelfie DocumentType = "selfie"
+)
+
+// RequiredDocuments must each have a verified document before the borrower's KYC is verified
+var RequiredDocuments = []DocumentType{DocumentIDCard, DocumentSelfie}
+
+// KYCDocument is one submission of an identity document. A rejected document is not reviewed again; the borrower
+// submits a new one instead.
+type KYCDocument struct {
+	model.BaseModel
+	BorrowerID   uuid.UUID    `json:"borrower_id"`
+	Type         DocumentType `json:"type"`
+	FileURL      string       `json:"file_url"`
+	Status       KYCStatus    `json:"status"`
+	RejectReason *string      `json:"reject_reason"`
+	SubmittedBy  uuid.UUID    `json:"submitted_by"`
+	ReviewedBy   *uuid.UUID   `json:"reviewed_by"`
+	ReviewedAt   *time.Time   `json:"reviewed_at"`
+}
+
+func (KYCDocument) TableName() string {
+	return "borrower_kyc_documents"
+}
+
+// ErrSelfReview keeps KYC maker-checker: the employee who submitted a document cannot review it
+var ErrSelfReview = errors.New("document must be reviewed by another employee than the one who submitted it")
+
+type KYCEvent string
+
+const (
+	KYCEventVerify KYCEvent = "verify"
+	KYCEventReject KYCEvent = "reject"
+)
+
+// Review checks that the document can be verified or rejected by the reviewer and returns the columns to update.
+// The error messages are meant for the caller of the API.
+func (d KYCDocument) Review(event KYCEvent, reviewerID uuid.UUID, reason string) (map[string]any, error) {
+	if d.Status != KYCStatusPending {
+		return nil, fmt.Errorf("document is not in %s state", KYCStatusPending)
+	} else if reviewerID == d.SubmittedBy {
+		return nil, ErrSelfReview
+	}
+
+	data := map[string]any{
+		"reviewed_by": reviewerID,
+		"reviewed_at": time.Now(),
+	}
+
+	switch event {
+	case KYCEventVerify:
+		data["status"] = KYCStatusVerified
+	case KYCEventReject:
+		if reason == "" {
+			return nil, errors.New("reason is required")
+		}
+
+		data["status"] = KYCStatusRejected
+		data["reject_reason"] = reason
+	default:
+		return nil, fmt.Errorf("unknown KYC event %q", event)
+	}
+
+	return data, nil
+}
+
+// KYCStatusOf derives the borrower's KYC status from the latest submission of each required document. Documents must be
+// ordered from oldest to newest.
+func KYCStatusOf(documents []KYCDocument) KYCStatus {
+	latest := map[DocumentType]KYCStatus{}
+	for _, document := range documents {
+		latest[document.Type] = document.Status
+	}
+
+	status := KYCStatusVerified
+	for _, documentType := range RequiredDocuments {
+		switch latest[documentType] {
+		case KYCStatusRejected:
+			return KYCStatusRejected
+		case KYCStatusVerified:
+		default:
+			status = KYCStatusPending
+		}
+	}
+
+	return status
+}
@@ -1,9 +1,13 @@
 package borrower
 
 import (
+	"context"
+
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
 )
 
 type IBorrowerRepository interface {
 	repository.IBaseRepo[Borrower]
+	GetByIdentifiers(ctx context.Context, idCardNumber string, phoneNumber string, email string) ([]Borrower, error)
+	Search(ctx context.Context, req SearchBorrowerRequest, page int, limit int) (repository.Pagination[Borrower], error)
 }
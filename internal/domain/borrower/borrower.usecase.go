@@ -0,0 +1,21 @@
+package borrower
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+)
+
+type IBorrowerUsecase interface {
+	RegisterBorrower(ctx context.Context, req RegisterBorrowerRequest) (*Borrower, error)
+	UpdateBorrower(ctx context.Context, borrowerID uuid.UUID, req UpdateBorrowerRequest) (*Borrower, error)
+	DeactivateBorrower(ctx context.Context, borrowerID uuid.UUID) (*Borrower, error)
+	SearchBorrower(ctx context.Context, req SearchBorrowerRequest, page int, limit int) (repository.Pagination[Borrower], error)
+	DetailBorrower(ctx context.Context, borrowerID uuid.UUID) (*Borrower, error)
+	SubmitKYCDocument(ctx context.Context, borrowerID uuid.UUID, employeeID uuid.UUID, req SubmitKYCDocumentRequest) (*KYCDocument, error)
+	VerifyKYCDocument(ctx context.Context, borrowerID uuid.UUID, documentID uuid.UUID, employeeID uuid.UUID) (*KYCDocument, error)
+	RejectKYCDocument(ctx context.Context, borrowerID uuid.UUID, documentID uuid.UUID, employeeID uuid.UUID, rejectReason string) (*KYCDocument, error)
+	BlacklistBorrower(ctx context.Context, borrowerID uuid.UUID, employeeID uuid.UUID, req BlacklistBorrowerRequest) (*BlacklistEntry, error)
+	LiftBlacklist(ctx context.Context, borrowerID uuid.UUID, entryID uuid.UUID, employeeID uuid.UUID) (*BlacklistEntry, error)
+}
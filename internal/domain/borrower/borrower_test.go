@@ -0,0 +1,125 @@
+package borrower_test
+
+import (
+	"testing"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNIK(t *testing.T) {
+	tests := []struct {
+		name    string
+		nik     string
+		wantErr string
+	}{
+		{"valid", "3174011505900001", ""},
+		{"valid woman", "3174015505900001", ""},
+		{"too short", "317401150590001", "id_card_number must be 16 digits"},
+		{"not numeric", "31740115059000A1", "id_card_number must be 16 digits"},
+		{"unknown province", "0974011505900001", "id_card_number has an unknown province code"},
+		{"invalid month", "3174011513900001", "id_card_number has an invalid birth date"},
+		{"invalid day", "3174013102900001", "id_card_number has an invalid birth date"},
+		{"zero serial", "3174011505900000", "id_card_number has an invalid serial number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := borrower.ValidateNIK(tt.nik)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		phone   string
+		want    string
+		wantErr bool
+	}{
+		{"local", "081234567890", "081234567890", false},
+		{"international", "+6281234567890", "081234567890", false},
+		{"without plus", "6281234567890", "081234567890", false},
+		{"with separators", "0812-3456 7890", "081234567890", false},
+		{"landline", "0215551234", "", true},
+		{"too short", "08123456", "", true},
+		{"too long", "0812345678901234", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := borrower.NormalizePhoneNumber(tt.phone)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKYCDocumentReview(t *testing.T) {
+	submitterID := uuid.New()
+	reviewerID := uuid.New()
+	document := borrower.KYCDocument{Status: borrower.KYCStatusPending, SubmittedBy: submitterID}
+
+	t.Run("verify", func(t *testing.T) {
+		data, err := document.Review(borrower.KYCEventVerify, reviewerID, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, borrower.KYCStatusVerified, data["status"])
+		assert.Equal(t, reviewerID, data["reviewed_by"])
+	})
+
+	t.Run("reject without reason", func(t *testing.T) {
+		_, err := document.Review(borrower.KYCEventReject, reviewerID, "")
+
+		assert.EqualError(t, err, "reason is required")
+	})
+
+	t.Run("reviewed by submitter", func(t *testing.T) {
+		_, err := document.Review(borrower.KYCEventVerify, submitterID, "")
+
+		assert.ErrorIs(t, err, borrower.ErrSelfReview)
+	})
+
+	t.Run("already reviewed", func(t *testing.T) {
+		rejected := document
+		rejected.Status = borrower.KYCStatusRejected
+
+		_, err := rejected.Review(borrower.KYCEventVerify, reviewerID, "")
+
+		assert.EqualError(t, err, "document is not in pending state")
+	})
+}
+
+func TestKYCStatusOf(t *testing.T) {
+	idCard := func(status borrower.KYCStatus) borrower.KYCDocument {
+		return borrower.KYCDocument{Type: borrower.DocumentIDCard, Status: status}
+	}
+	selfie := func(status borrower.KYCStatus) borrower.KYCDocument {
+		return borrower.KYCDocument{Type: borrower.DocumentSelfie, Status: status}
+	}
+
+	tests := []struct {
+		name      string
+		documents []borrower.KYCDocument
+		want      borrower.KYCStatus
+	}{
+		{"no documents", nil, borrower.KYCStatusPending},
+		{"missing selfie", []borrower.KYCDocument{idCard(borrower.KYCStatusVerified)}, borrower.KYCStatusPending},
+		{"all verified", []borrower.KYCDocument{idCard(borrower.KYCStatusVerified), selfie(borrower.KYCStatusVerified)}, borrower.KYCStatusVerified},
+		{"one rejected", []borrower.KYCDocument{idCard(borrower.KYCStatusVerified), selfie(borrower.KYCStatusRejected)}, borrower.KYCStatusRejected},
+		{"resubmitted after rejection", []borrower.KYCDocument{idCard(borrower.KYCStatusVerified), selfie(borrower.KYCStatusRejected), selfie(borrower.KYCStatusPending)}, borrower.KYCStatusPending},
+		{"resubmission verified", []borrower.KYCDocument{idCard(borrower.KYCStatusVerified), selfie(borrower.KYCStatusRejected), selfie(borrower.KYCStatusVerified)}, borrower.KYCStatusVerified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, borrower.KYCStatusOf(tt.documents))
+		})
+	}
+}
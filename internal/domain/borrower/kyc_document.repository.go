@@ -0,0 +1,15 @@
+package borrower
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type IKYCDocumentRepository interface {
+	repository.IBaseRepo[KYCDocument]
+	GetByBorrowerID(ctx context.Context, borrowerID uuid.UUID) ([]KYCDocument, error)
+	GetByBorrowerIDWithTx(ctx context.Context, borrowerID uuid.UUID, trx *gorm.DB) ([]KYCDocument, error)
+}
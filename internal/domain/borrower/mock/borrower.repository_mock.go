@@ -987,6 +987,86 @@ func (_c *MockIBorrowerRepository_GetByIDs_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// GetByIdentifiers provides a mock function for the type MockIBorrowerRepository
+func (_mock *MockIBorrowerRepository) GetByIdentifiers(ctx context.Context, idCardNumber string, phoneNumber string, email string) ([]borrower.Borrower, error) {
+	ret := _mock.Called(ctx, idCardNumber, phoneNumber, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIdentifiers")
+	}
+
+	var r0 []borrower.Borrower
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) ([]borrower.Borrower, error)); ok {
+		return returnFunc(ctx, idCardNumber, phoneNumber, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) []borrower.Borrower); ok {
+		r0 = returnFunc(ctx, idCardNumber, phoneNumber, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]borrower.Borrower)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, idCardNumber, phoneNumber, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIBorrowerRepository_GetByIdentifiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIdentifiers'
+type MockIBorrowerRepository_GetByIdentifiers_Call struct {
+	*mock.Call
+}
+
+// GetByIdentifiers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idCardNumber string
+//   - phoneNumber string
+//   - email string
+func (_e *MockIBorrowerRepository_Expecter) GetByIdentifiers(ctx interface{}, idCardNumber interface{}, phoneNumber interface{}, email interface{}) *MockIBorrowerRepository_GetByIdentifiers_Call {
+	return &MockIBorrowerRepository_GetByIdentifiers_Call{Call: _e.mock.On("GetByIdentifiers", ctx, idCardNumber, phoneNumber, email)}
+}
+
+func (_c *MockIBorrowerRepository_GetByIdentifiers_Call) Run(run func(ctx context.Context, idCardNumber string, phoneNumber string, email string)) *MockIBorrowerRepository_GetByIdentifiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIBorrowerRepository_GetByIdentifiers_Call) Return(borrowers []borrower.Borrower, err error) *MockIBorrowerRepository_GetByIdentifiers_Call {
+	_c.Call.Return(borrowers, err)
+	return _c
+}
+
+func (_c *MockIBorrowerRepository_GetByIdentifiers_Call) RunAndReturn(run func(ctx context.Context, idCardNumber string, phoneNumber string, email string) ([]borrower.Borrower, error)) *MockIBorrowerRepository_GetByIdentifiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Pagination provides a mock function for the type MockIBorrowerRepository
 func (_mock *MockIBorrowerRepository) Pagination(ctx context.Context, filter map[string]any, page int, limit int) (repository.Pagination[borrower.Borrower], error) {
 	ret := _mock.Called(ctx, filter, page, limit)
@@ -1118,6 +1198,84 @@ func (_c *MockIBorrowerRepository_Rollback_Call) RunAndReturn(run func(trx *gorm
 	return _c
 }
 
+// Search provides a mock function for the type MockIBorrowerRepository
+func (_mock *MockIBorrowerRepository) Search(ctx context.Context, req borrower.SearchBorrowerRequest, page int, limit int) (repository.Pagination[borrower.Borrower], error) {
+	ret := _mock.Called(ctx, req, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 repository.Pagination[borrower.Borrower]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, borrower.SearchBorrowerRequest, int, int) (repository.Pagination[borrower.Borrower], error)); ok {
+		return returnFunc(ctx, req, page, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, borrower.SearchBorrowerRequest, int, int) repository.Pagination[borrower.Borrower]); ok {
+		r0 = returnFunc(ctx, req, page, limit)
+	} else {
+		r0 = ret.Get(0).(repository.Pagination[borrower.Borrower])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, borrower.SearchBorrowerRequest, int, int) error); ok {
+		r1 = returnFunc(ctx, req, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIBorrowerRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockIBorrowerRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req borrower.SearchBorrowerRequest
+//   - page int
+//   - limit int
+func (_e *MockIBorrowerRepository_Expecter) Search(ctx interface{}, req interface{}, page interface{}, limit interface{}) *MockIBorrowerRepository_Search_Call {
+	return &MockIBorrowerRepository_Search_Call{Call: _e.mock.On("Search", ctx, req, page, limit)}
+}
+
+func (_c *MockIBorrowerRepository_Search_Call) Run(run func(ctx context.Context, req borrower.SearchBorrowerRequest, page int, limit int)) *MockIBorrowerRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 borrower.SearchBorrowerRequest
+		if args[1] != nil {
+			arg1 = args[1].(borrower.SearchBorrowerRequest)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIBorrowerRepository_Search_Call) Return(pagination repository.Pagination[borrower.Borrower], err error) *MockIBorrowerRepository_Search_Call {
+	_c.Call.Return(pagination, err)
+	return _c
+}
+
+func (_c *MockIBorrowerRepository_Search_Call) RunAndReturn(run func(ctx context.Context, req borrower.SearchBorrowerRequest, page int, limit int) (repository.Pagination[borrower.Borrower], error)) *MockIBorrowerRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockIBorrowerRepository
 func (_mock *MockIBorrowerRepository) Update(ctx context.Context, ID uuid.UUID, model borrower.Borrower) (borrower.Borrower, error) {
 	ret := _mock.Called(ctx, ID, model)
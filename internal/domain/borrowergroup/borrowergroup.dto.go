@@ -0,0 +1,32 @@
+package borrowergroup
+
+import (
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/google/uuid"
+)
+
+type CreateGroupRequest struct {
+	Name              string      `json:"name" validate:"required"`
+	LeaderBorrowerID  uuid.UUID   `json:"leader_borrower_id" validate:"required"`
+	MemberBorrowerIDs []uuid.UUID `json:"member_borrower_ids" validate:"required,min=2,unique"`
+	MeetingDay        MeetingDay  `json:"meeting_day" validate:"required,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+	MeetingTime       string      `json:"meeting_time" validate:"required,datetime=15:04"`
+	MeetingLocation   string      `json:"meeting_location" validate:"required"`
+}
+
+// CreateGroupLoanRequest proposes one loan per member. When Rate or ROI is omitted every member's loan takes it from
+// the pricing table for that member's risk grade.
+type CreateGroupLoanRequest struct {
+	Rate               *float32                `json:"rate" validate:"omitempty,gt=0"`
+	ROI                *float32                `json:"roi" validate:"omitempty,gt=0"`
+	Tenor              int                     `json:"tenor" validate:"required,min=1"`
+	RepaymentFrequency loan.RepaymentFrequency `json:"repayment_frequency" validate:"required,oneof=weekly biweekly monthly"`
+	AgreementLetterURL string                  `json:"agreement_letter_url" validate:"required,url"`
+	Members            []GroupLoanMember       `json:"members" validate:"required,min=1,unique=BorrowerID,dive"`
+}
+
+type GroupLoanMember struct {
+	BorrowerID      uuid.UUID   `json:"borrower_id" validate:"required"`
+	PrincipalAmount money.Money `json:"principal_amount" validate:"required,min=1"`
+}
@@ -0,0 +1,202 @@
+package borrowergroup
+
+import (
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/google/uuid"
+)
+
+// Group is a majelis: borrowers of one branch who meet weekly and are jointly liable for each other's group loans
+type Group struct {
+	model.BaseModel
+	Name             string     `json:"name"`
+	BranchID         uuid.UUID  `json:"branch_id"`
+	LeaderBorrowerID uuid.UUID  `json:"leader_borrower_id"`
+	MeetingDay       MeetingDay `json:"meeting_day"`
+	MeetingTime      string     `json:"meeting_time"`
+	MeetingLocation  string     `json:"meeting_location"`
+	Members          []Member   `json:"members" gorm:"-"`
+}
+
+func (Group) TableName() string {
+	return "borrower_groups"
+}
+
+// NextMeeting returns the first meeting that starts at or after the given time, in its location
+func (g Group) NextMeeting(after time.Time) time.Time {
+	clock, err := time.Parse(MeetingTimeLayout, g.MeetingTime)
+	if err != nil {
+		clock = time.Time{}
+	}
+
+	meeting := time.Date(after.Year(), after.Month(), after.Day(), clock.Hour(), clock.Minute(), 0, 0, after.Location())
+	meeting = meeting.AddDate(0, 0, (int(g.MeetingDay.Weekday())-int(meeting.Weekday())+7)%7)
+	if meeting.Before(after) {
+		meeting = meeting.AddDate(0, 0, 7)
+	}
+
+	return meeting
+}
+
+func (g Group) HasMember(borrowerID uuid.UUID) bool {
+	for _, member := range g.Members {
+		if member.BorrowerID == borrowerID {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Member struct {
+	model.BaseModel
+	GroupID    uuid.UUID `json:"group_id"`
+	BorrowerID uuid.UUID `json:"borrower_id"`
+}
+
+func (Member) TableName() string {
+	return "borrower_group_members"
+}
+
+// GroupLoan bundles the individual loans of group members, which are approved, rejected and disbursed together
+type GroupLoan struct {
+	model.BaseModel
+	GroupID         uuid.UUID   `json:"group_id"`
+	BranchID        uuid.UUID   `json:"branch_id"`
+	State           loan.State  `json:"state" gorm:"-"`
+	PrincipalAmount money.Money `json:"principal_amount" gorm:"-"`
+	Loans           []loan.Loan `json:"loans" gorm:"-"`
+}
+
+func (GroupLoan) TableName() string {
+	return "group_loans"
+}
+
+// WithLoans attaches the member loans, whose shared state becomes the state of the group loan
+func (g GroupLoan) WithLoans(loans []loan.Loan) GroupLoan {
+	g.Loans = loans
+	g.PrincipalAmount = 0
+	for _, l := range loans {
+		g.PrincipalAmount += l.PrincipalAmount
+	}
+
+	if len(loans) > 0 {
+		g.State = loans[0].State
+	}
+
+	return g
+}
+
+type MeetingDay string
+
+const (
+	MeetingDayMonday    MeetingDay = "monday"
+	MeetingDayTuesday   MeetingDay = "tuesday"
+	MeetingDayWednesday MeetingDay = "wednesday"
+	MeetingDayThursday  MeetingDay = "thursday"
+	MeetingDayFriday    MeetingDay = "friday"
+	MeetingDaySaturday  MeetingDay = "saturday"
+	MeetingDaySunday    MeetingDay = "sunday"
+)
+
+const MeetingTimeLayout = "15:04"
+
+func (d MeetingDay) Weekday() time.Weekday {
+	switch d {
+	case MeetingDayMonday:
+		return time.Monday
+	case MeetingDayTuesday:
+		return time.Tuesday
+	case MeetingDayWednesday:
+		return time.Wednesday
+	case MeetingDayThursday:
+		return time.Thursday
+	case MeetingDayFriday:
+		return time.Friday
+	case MeetingDaySaturday:
+		return time.Saturday
+	default:
+		return time.Sunday
+	}
+}
+
+// MemberArrears is what one member owes past due across the group loans
+type MemberArrears struct {
+	BorrowerID          uuid.UUID   `json:"borrower_id"`
+	FullName            string      `json:"full_name"`
+	PhoneNumber         string      `json:"phone_number"`
+	OverdueInstallments int         `json:"overdue_installments"`
+	OverdueAmount       money.Money `json:"overdue_amount"`
+	OldestDueDate       *time.Time  `json:"oldest_due_date"`
+	DaysPastDue         int         `json:"days_past_due"`
+}
+
+// GroupArrears summarizes the arrears of a group for follow up at its next meeting
+type GroupArrears struct {
+	GroupID             uuid.UUID       `json:"group_id"`
+	AsOf                time.Time       `json:"as_of"`
+	NextMeeting         time.Time       `json:"next_meeting"`
+	OverdueInstallments int             `json:"overdue_installments"`
+	OverdueAmount       money.Money     `json:"overdue_amount"`
+	MembersInArrears    int             `json:"members_in_arrears"`
+	DaysPastDue         int             `json:"days_past_due"`
+	Members             []MemberArrears `json:"members"`
+}
+
+func (a GroupArrears) InArrears() bool {
+	return a.OverdueInstallments > 0
+}
+
+// NewGroupArrears attributes the overdue installments of the group's loans to their borrowers. Every member is listed,
+// including those who are up to date, in the order of borrowers.
+func NewGroupArrears(group Group, borrowers []borrower.Borrower, loans []loan.Loan, overdue []installment.Installment, asOf time.Time) GroupArrears {
+	loanBorrowers := map[uuid.UUID]uuid.UUID{}
+	for _, l := range loans {
+		loanBorrowers[l.ID] = l.BorrowerID
+	}
+
+	arrears := GroupArrears{
+		GroupID:     group.ID,
+		AsOf:        asOf,
+		NextMeeting: group.NextMeeting(asOf),
+		Members:     make([]MemberArrears, 0, len(borrowers)),
+	}
+
+	for _, b := range borrowers {
+		member := MemberArrears{
+			BorrowerID:  b.ID,
+			FullName:    b.FullName,
+			PhoneNumber: b.PhoneNumber,
+		}
+
+		for _, inst := range overdue {
+			if loanBorrowers[inst.LoanID] != b.ID {
+				continue
+			}
+
+			member.OverdueInstallments++
+			member.OverdueAmount += inst.TotalDue()
+			if member.OldestDueDate == nil || inst.DueDate.Before(*member.OldestDueDate) {
+				dueDate := inst.DueDate
+				member.OldestDueDate = &dueDate
+			}
+		}
+
+		if member.OldestDueDate != nil {
+			member.DaysPastDue = int(asOf.Sub(*member.OldestDueDate).Hours() / 24)
+			arrears.MembersInArrears++
+		}
+
+		arrears.OverdueInstallments += member.OverdueInstallments
+		arrears.OverdueAmount += member.OverdueAmount
+		arrears.DaysPastDue = max(arrears.DaysPastDue, member.DaysPastDue)
+		arrears.Members = append(arrears.Members, member)
+	}
+
+	return arrears
+}
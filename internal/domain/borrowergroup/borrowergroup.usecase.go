@@ -0,0 +1,19 @@
+package borrowergroup
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/google/uuid"
+)
+
+type IBorrowerGroupUsecase interface {
+	CreateGroup(ctx context.Context, req CreateGroupRequest) (*Group, error)
+	DetailGroup(ctx context.Context, groupID uuid.UUID) (*Group, error)
+	GetGroupArrears(ctx context.Context, groupID uuid.UUID) (*GroupArrears, error)
+	CreateGroupLoan(ctx context.Context, groupID uuid.UUID, employeeID uuid.UUID, req CreateGroupLoanRequest) (*GroupLoan, error)
+	DetailGroupLoan(ctx context.Context, groupLoanID uuid.UUID) (*GroupLoan, error)
+	RejectGroupLoan(ctx context.Context, groupLoanID uuid.UUID, employeeID uuid.UUID, rejectReason string) (*GroupLoan, error)
+	ApproveGroupLoan(ctx context.Context, groupLoanID uuid.UUID, employeeID uuid.UUID, req loan.ApproveLoanRequest) (*GroupLoan, error)
+	DisburseGroupLoan(ctx context.Context, groupLoanID uuid.UUID, employeeID uuid.UUID, req loan.DisburseLoanRequest) (*GroupLoan, error)
+}
@@ -0,0 +1,71 @@
+package borrowergroup_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrowergroup"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextMeeting(t *testing.T) {
+	group := borrowergroup.Group{MeetingDay: borrowergroup.MeetingDayTuesday, MeetingTime: "09:00"}
+
+	// 2025-10-07 is a Tuesday
+	tests := []struct {
+		name  string
+		after time.Time
+		want  time.Time
+	}{
+		{"earlier in the week", time.Date(2025, 10, 5, 12, 0, 0, 0, time.UTC), time.Date(2025, 10, 7, 9, 0, 0, 0, time.UTC)},
+		{"meeting day before the meeting", time.Date(2025, 10, 7, 8, 0, 0, 0, time.UTC), time.Date(2025, 10, 7, 9, 0, 0, 0, time.UTC)},
+		{"meeting day after the meeting", time.Date(2025, 10, 7, 10, 0, 0, 0, time.UTC), time.Date(2025, 10, 14, 9, 0, 0, 0, time.UTC)},
+		{"later in the week", time.Date(2025, 10, 9, 12, 0, 0, 0, time.UTC), time.Date(2025, 10, 14, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, group.NextMeeting(tt.after))
+		})
+	}
+}
+
+func TestNewGroupArrears(t *testing.T) {
+	asOf := time.Date(2025, 10, 9, 12, 0, 0, 0, time.UTC)
+	group := borrowergroup.Group{
+		BaseModel:   model.BaseModel{ID: uuid.New()},
+		MeetingDay:  borrowergroup.MeetingDayTuesday,
+		MeetingTime: "09:00",
+	}
+	borrowers := []borrower.Borrower{
+		{BaseModel: model.BaseModel{ID: uuid.New()}, FullName: "Siti"},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, FullName: "Ani"},
+	}
+	loans := []loan.Loan{
+		{BaseModel: model.BaseModel{ID: uuid.New()}, BorrowerID: borrowers[0].ID},
+		{BaseModel: model.BaseModel{ID: uuid.New()}, BorrowerID: borrowers[1].ID},
+	}
+	overdue := []installment.Installment{
+		{LoanID: loans[1].ID, Sequence: 2, DueDate: time.Date(2025, 9, 25, 0, 0, 0, 0, time.UTC), PrincipalAmount: 100_000, InterestAmount: 10_000},
+		{LoanID: loans[1].ID, Sequence: 3, DueDate: time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC), PrincipalAmount: 100_000, InterestAmount: 10_000, PaidInterest: 10_000},
+	}
+
+	arrears := borrowergroup.NewGroupArrears(group, borrowers, loans, overdue, asOf)
+
+	assert.True(t, arrears.InArrears())
+	assert.Equal(t, time.Date(2025, 10, 14, 9, 0, 0, 0, time.UTC), arrears.NextMeeting)
+	assert.Equal(t, 2, arrears.OverdueInstallments)
+	assert.Equal(t, 210_000, int(arrears.OverdueAmount))
+	assert.Equal(t, 1, arrears.MembersInArrears)
+	assert.Equal(t, 14, arrears.DaysPastDue)
+	assert.Len(t, arrears.Members, 2)
+	assert.Zero(t, arrears.Members[0].OverdueInstallments)
+	assert.Nil(t, arrears.Members[0].OldestDueDate)
+	assert.Equal(t, 2, arrears.Members[1].OverdueInstallments)
+	assert.Equal(t, time.Date(2025, 9, 25, 0, 0, 0, 0, time.UTC), *arrears.Members[1].OldestDueDate)
+}
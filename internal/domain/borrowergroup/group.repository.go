@@ -0,0 +1,9 @@
+package borrowergroup
+
+import (
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+)
+
+type IGroupRepository interface {
+	repository.IBaseRepo[Group]
+}
@@ -0,0 +1,13 @@
+package borrowergroup
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+)
+
+type IGroupLoanRepository interface {
+	repository.IBaseRepo[GroupLoan]
+	GetByGroupID(ctx context.Context, groupID uuid.UUID) ([]GroupLoan, error)
+}
@@ -0,0 +1,14 @@
+package borrowergroup
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+)
+
+type IMemberRepository interface {
+	repository.IBaseRepo[Member]
+	GetByGroupID(ctx context.Context, groupID uuid.UUID) ([]Member, error)
+	GetByBorrowerIDs(ctx context.Context, borrowerIDs []uuid.UUID) ([]Member, error)
+}
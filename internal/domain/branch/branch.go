@@ -0,0 +1,79 @@
+package branch
+
+import (
+	"context"
+	"slices"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+type Branch struct {
+	model.BaseModel
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Region string `json:"region"`
+}
+
+func (Branch) TableName() string {
+	return "branches"
+}
+
+type EmployeeBranch struct {
+	model.BaseModel
+	EmployeeID uuid.UUID `json:"employee_id"`
+	BranchID   uuid.UUID `json:"branch_id"`
+}
+
+func (EmployeeBranch) TableName() string {
+	return "employee_branches"
+}
+
+// Scope limits the branches a request can reach. Head office sees every branch; an empty scope sees none.
+type Scope struct {
+	All       bool
+	BranchIDs []uuid.UUID
+}
+
+// AllBranches is the scope of head office employees, investors, background jobs and public endpoints that are
+// verified by other means, such as payment callbacks
+func AllBranches() Scope {
+	return Scope{All: true}
+}
+
+func (s Scope) Allows(branchID uuid.UUID) bool {
+	return s.All || slices.Contains(s.BranchIDs, branchID)
+}
+
+type scopeKey struct{}
+
+func WithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// ScopeFromContext returns the scope of the request. A context without a scope sees no branch.
+func ScopeFromContext(ctx context.Context) Scope {
+	scope, _ := ctx.Value(scopeKey{}).(Scope)
+	return scope
+}
+
+// ScopeCondition is the condition limiting rows to the branches of the request's scope, where column holds the branch ID
+// of each row. Head office requests are not limited and requests without a scope match no row.
+func ScopeCondition(ctx context.Context, column string) sq.Sqlizer {
+	scope := ScopeFromContext(ctx)
+	if scope.All {
+		return sq.Expr("1=1")
+	}
+
+	return sq.Eq{column: scope.BranchIDs}
+}
+
+// ApplyScope limits a query to the branches of the request's scope, see ScopeCondition
+func ApplyScope(ctx context.Context, builder sq.SelectBuilder, column string) sq.SelectBuilder {
+	if ScopeFromContext(ctx).All {
+		return builder
+	}
+
+	return builder.Where(ScopeCondition(ctx, column))
+}
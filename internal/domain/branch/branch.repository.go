@@ -0,0 +1,13 @@
+package branch
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+)
+
+type IBranchRepository interface {
+	repository.IBaseRepo[Branch]
+	GetByEmployeeID(ctx context.Context, employeeID uuid.UUID) ([]Branch, error)
+}
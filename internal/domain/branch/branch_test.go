@@ -0,0 +1,58 @@
+package branch_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyScope(t *testing.T) {
+	branchID := uuid.New()
+	builder := sq.Select("*").From("loans").Where(sq.Eq{"id": uuid.Nil})
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantSQL  string
+		wantArgs int
+	}{
+		{
+			name:     "no scope",
+			ctx:      context.Background(),
+			wantSQL:  "SELECT * FROM loans WHERE id = ? AND (1=0)",
+			wantArgs: 1,
+		},
+		{
+			name:     "head office",
+			ctx:      branch.WithScope(context.Background(), branch.AllBranches()),
+			wantSQL:  "SELECT * FROM loans WHERE id = ?",
+			wantArgs: 1,
+		},
+		{
+			name:     "employee branches",
+			ctx:      branch.WithScope(context.Background(), branch.Scope{BranchIDs: []uuid.UUID{branchID}}),
+			wantSQL:  "SELECT * FROM loans WHERE id = ? AND branch_id IN (?)",
+			wantArgs: 2,
+		},
+		{
+			name:     "employee without branches",
+			ctx:      branch.WithScope(context.Background(), branch.Scope{}),
+			wantSQL:  "SELECT * FROM loans WHERE id = ? AND (1=0)",
+			wantArgs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qry, args, err := branch.ApplyScope(tt.ctx, builder, "branch_id").ToSql()
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, qry)
+			assert.Len(t, args, tt.wantArgs)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package branch
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type IEmployeeBranchRepository interface {
+	repository.IBaseRepo[EmployeeBranch]
+	DeleteByEmployeeIDWithTx(ctx context.Context, employeeID uuid.UUID, trx *gorm.DB) error
+}
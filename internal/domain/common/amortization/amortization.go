@@ -0,0 +1,232 @@
+// Package amortization builds installment tables for a loan and derives the effective cost of one. It only does
+// arithmetic on Money, so loans, pricing quotes, agreements and investor projections all agree on every rupiah.
+package amortization
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+)
+
+// Method is how interest is charged over the life of a loan
+type Method string
+
+const (
+	// MethodFlat charges interest on the original principal every period, the usual convention for microloans
+	MethodFlat Method = "flat"
+	// MethodAnnuity keeps every payment equal, with interest on the outstanding balance
+	MethodAnnuity Method = "annuity"
+	// MethodDecliningBalance repays equal principal every period, with interest on the outstanding balance
+	MethodDecliningBalance Method = "declining_balance"
+)
+
+func (m Method) Valid() bool {
+	switch m {
+	case MethodFlat, MethodAnnuity, MethodDecliningBalance:
+		return true
+	default:
+		return false
+	}
+}
+
+// Terms describe the loan to amortize. AnnualRate is the nominal annual interest in percent.
+type Terms struct {
+	Principal      money.Money
+	AnnualRate     float64
+	Periods        int
+	PeriodsPerYear int
+	Method         Method
+}
+
+// Installment is one row of the table. Balance is the principal still owed after it is paid.
+type Installment struct {
+	Period    int         `json:"period"`
+	Principal money.Money `json:"principal"`
+	Interest  money.Money `json:"interest"`
+	Payment   money.Money `json:"payment"`
+	Balance   money.Money `json:"balance"`
+}
+
+type Schedule struct {
+	Terms        Terms         `json:"-"`
+	Installments []Installment `json:"installments"`
+}
+
+// New builds the installment table of the terms. Amounts are rounded to whole rupiah and the last installment
+// absorbs the rounding remainder, so the principal parts always add up to the principal.
+func New(t Terms) (Schedule, error) {
+	switch {
+	case !t.Method.Valid():
+		return Schedule{}, fmt.Errorf("amortization: unknown method %q", t.Method)
+	case t.Principal <= 0:
+		return Schedule{}, fmt.Errorf("amortization: principal must be positive, got %d", t.Principal)
+	case t.Periods <= 0 || t.PeriodsPerYear <= 0:
+		return Schedule{}, fmt.Errorf("amortization: %d periods at %d per year is not a schedule", t.Periods, t.PeriodsPerYear)
+	case t.AnnualRate < 0:
+		return Schedule{}, fmt.Errorf("amortization: rate must not be negative, got %v", t.AnnualRate)
+	}
+
+	var installments []Installment
+	switch t.Method {
+	case MethodFlat:
+		installments = flat(t)
+	case MethodAnnuity:
+		installments = annuity(t)
+	default:
+		installments = decliningBalance(t)
+	}
+
+	return Schedule{Terms: t, Installments: installments}, nil
+}
+
+func (s Schedule) TotalPrincipal() money.Money {
+	total := money.Money(0)
+	for _, i := range s.Installments {
+		total += i.Principal
+	}
+
+	return total
+}
+
+func (s Schedule) TotalInterest() money.Money {
+	total := money.Money(0)
+	for _, i := range s.Installments {
+		total += i.Interest
+	}
+
+	return total
+}
+
+func (s Schedule) TotalPayment() money.Money {
+	return s.TotalPrincipal() + s.TotalInterest()
+}
+
+// APR is the nominal annual rate in percent that discounts the payments back to the principal. For the balance
+// methods it is the quoted rate; flat interest costs well above its quoted rate, nearly twice on long tenors.
+func (s Schedule) APR() float64 {
+	return s.periodRate() * float64(s.Terms.PeriodsPerYear) * 100
+}
+
+// EIR is the effective annual rate in percent, the APR compounded once per period
+func (s Schedule) EIR() float64 {
+	return (math.Pow(1+s.periodRate(), float64(s.Terms.PeriodsPerYear)) - 1) * 100
+}
+
+// periodRate finds the internal rate of return per period by bisection. The present value of the payments falls as
+// the rate rises, so the root is bracketed between 0 and a rate at which the payments are worth less than the
+// principal.
+func (s Schedule) periodRate() float64 {
+	principal := float64(s.Terms.Principal)
+	if len(s.Installments) == 0 || float64(s.TotalPayment()) <= principal {
+		return 0
+	}
+
+	presentValue := func(rate float64) float64 {
+		value := 0.0
+		for _, i := range s.Installments {
+			value += float64(i.Payment) / math.Pow(1+rate, float64(i.Period))
+		}
+
+		return value
+	}
+
+	low, high := 0.0, 1.0
+	for presentValue(high) > principal {
+		high *= 2
+	}
+
+	for range 100 {
+		mid := (low + high) / 2
+		if presentValue(mid) > principal {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	return (low + high) / 2
+}
+
+func periodicRate(t Terms) float64 {
+	return t.AnnualRate / 100 / float64(t.PeriodsPerYear)
+}
+
+func flat(t Terms) []Installment {
+	periods := money.Money(t.Periods)
+	totalInterest := money.Round(float64(t.Principal) * t.AnnualRate / 100 * float64(t.Periods) / float64(t.PeriodsPerYear))
+	principalPerPeriod := t.Principal / periods
+	interestPerPeriod := totalInterest / periods
+
+	installments := make([]Installment, 0, t.Periods)
+	balance := t.Principal
+	paidInterest := money.Money(0)
+	for period := 1; period <= t.Periods; period++ {
+		principal := principalPerPeriod
+		interest := interestPerPeriod
+		if period == t.Periods {
+			principal = balance
+			interest = totalInterest - paidInterest
+		}
+
+		balance -= principal
+		paidInterest += interest
+		installments = append(installments, newInstallment(period, principal, interest, balance))
+	}
+
+	return installments
+}
+
+func annuity(t Terms) []Installment {
+	rate := periodicRate(t)
+	if rate == 0 {
+		return decliningBalance(t)
+	}
+
+	payment := money.Round(float64(t.Principal) * rate / (1 - math.Pow(1+rate, -float64(t.Periods))))
+
+	installments := make([]Installment, 0, t.Periods)
+	balance := t.Principal
+	for period := 1; period <= t.Periods; period++ {
+		interest := money.Round(float64(balance) * rate)
+		principal := min(payment-interest, balance)
+		if period == t.Periods {
+			principal = balance
+		}
+
+		balance -= principal
+		installments = append(installments, newInstallment(period, principal, interest, balance))
+	}
+
+	return installments
+}
+
+func decliningBalance(t Terms) []Installment {
+	rate := periodicRate(t)
+	principalPerPeriod := t.Principal / money.Money(t.Periods)
+
+	installments := make([]Installment, 0, t.Periods)
+	balance := t.Principal
+	for period := 1; period <= t.Periods; period++ {
+		interest := money.Round(float64(balance) * rate)
+		principal := principalPerPeriod
+		if period == t.Periods {
+			principal = balance
+		}
+
+		balance -= principal
+		installments = append(installments, newInstallment(period, principal, interest, balance))
+	}
+
+	return installments
+}
+
+func newInstallment(period int, principal, interest, balance money.Money) Installment {
+	return Installment{
+		Period:    period,
+		Principal: principal,
+		Interest:  interest,
+		Payment:   principal + interest,
+		Balance:   balance,
+	}
+}
@@ -0,0 +1,224 @@
+package amortization_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/amortization"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/stretchr/testify/assert"
+)
+
+// randomTerms generates terms across every method and repayment frequency, from a single rupiah to ten billion
+// over up to five years of weekly installments
+type randomTerms amortization.Terms
+
+func (randomTerms) Generate(r *rand.Rand, size int) reflect.Value {
+	methods := []amortization.Method{amortization.MethodFlat, amortization.MethodAnnuity, amortization.MethodDecliningBalance}
+	periodsPerYear := []int{52, 26, 12}
+
+	return reflect.ValueOf(randomTerms{
+		Principal:      money.Money(1 + r.Int63n(10_000_000_000)),
+		AnnualRate:     float64(r.Intn(6001)) / 100,
+		Periods:        1 + r.Intn(260),
+		PeriodsPerYear: periodsPerYear[r.Intn(len(periodsPerYear))],
+		Method:         methods[r.Intn(len(methods))],
+	})
+}
+
+func check(t *testing.T, property func(amortization.Terms, amortization.Schedule) bool) {
+	t.Helper()
+
+	err := quick.Check(func(rt randomTerms) bool {
+		terms := amortization.Terms(rt)
+		schedule, err := amortization.New(terms)
+		return err == nil && property(terms, schedule)
+	}, &quick.Config{MaxCount: 500})
+	assert.NoError(t, err)
+}
+
+func TestScheduleProperties(t *testing.T) {
+	t.Run("principal parts sum exactly to the principal", func(t *testing.T) {
+		check(t, func(terms amortization.Terms, s amortization.Schedule) bool {
+			return s.TotalPrincipal() == terms.Principal
+		})
+	})
+
+	t.Run("one installment per period", func(t *testing.T) {
+		check(t, func(terms amortization.Terms, s amortization.Schedule) bool {
+			if len(s.Installments) != terms.Periods {
+				return false
+			}
+			for n, i := range s.Installments {
+				if i.Period != n+1 {
+					return false
+				}
+			}
+
+			return true
+		})
+	})
+
+	t.Run("balance runs down to zero", func(t *testing.T) {
+		check(t, func(terms amortization.Terms, s amortization.Schedule) bool {
+			balance := terms.Principal
+			for _, i := range s.Installments {
+				balance -= i.Principal
+				if i.Balance != balance || i.Balance < 0 {
+					return false
+				}
+			}
+
+			return balance == 0
+		})
+	})
+
+	t.Run("no negative parts and payments add up", func(t *testing.T) {
+		check(t, func(terms amortization.Terms, s amortization.Schedule) bool {
+			for _, i := range s.Installments {
+				if i.Principal < 0 || i.Interest < 0 || i.Payment != i.Principal+i.Interest {
+					return false
+				}
+			}
+
+			return s.TotalPayment() == terms.Principal+s.TotalInterest()
+		})
+	})
+
+	t.Run("flat interest is charged on the original principal", func(t *testing.T) {
+		check(t, func(terms amortization.Terms, s amortization.Schedule) bool {
+			if terms.Method != amortization.MethodFlat {
+				return true
+			}
+
+			expected := money.Round(float64(terms.Principal) * terms.AnnualRate / 100 * float64(terms.Periods) / float64(terms.PeriodsPerYear))
+			return s.TotalInterest() == expected
+		})
+	})
+
+	t.Run("annuity payments are level until the last", func(t *testing.T) {
+		check(t, func(terms amortization.Terms, s amortization.Schedule) bool {
+			if terms.Method != amortization.MethodAnnuity || terms.AnnualRate == 0 {
+				return true
+			}
+
+			for _, i := range s.Installments[:len(s.Installments)-1] {
+				if i.Payment != s.Installments[0].Payment {
+					return false
+				}
+			}
+
+			return true
+		})
+	})
+
+	t.Run("declining balance interest never rises", func(t *testing.T) {
+		check(t, func(terms amortization.Terms, s amortization.Schedule) bool {
+			if terms.Method != amortization.MethodDecliningBalance {
+				return true
+			}
+
+			for n := 1; n < len(s.Installments); n++ {
+				if s.Installments[n].Interest > s.Installments[n-1].Interest {
+					return false
+				}
+			}
+
+			return true
+		})
+	})
+
+	t.Run("balance methods cost their quoted rate", func(t *testing.T) {
+		check(t, func(terms amortization.Terms, s amortization.Schedule) bool {
+			// Rounding each installment to the rupiah moves the rate of small loans noticeably
+			if terms.Method == amortization.MethodFlat || terms.Principal < 10_000_000 {
+				return true
+			}
+
+			return math.Abs(s.APR()-terms.AnnualRate) < 0.01
+		})
+	})
+
+	t.Run("flat costs at least its quoted rate and compounding only adds", func(t *testing.T) {
+		check(t, func(terms amortization.Terms, s amortization.Schedule) bool {
+			if terms.Principal < 10_000_000 {
+				return true
+			}
+
+			return s.APR() > terms.AnnualRate-0.01 && s.EIR() >= s.APR()-1e-9
+		})
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Run("flat", func(t *testing.T) {
+		s, err := amortization.New(amortization.Terms{Principal: 1_000_000, AnnualRate: 12, Periods: 3, PeriodsPerYear: 12, Method: amortization.MethodFlat})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []amortization.Installment{
+			{Period: 1, Principal: 333_333, Interest: 10_000, Payment: 343_333, Balance: 666_667},
+			{Period: 2, Principal: 333_333, Interest: 10_000, Payment: 343_333, Balance: 333_334},
+			{Period: 3, Principal: 333_334, Interest: 10_000, Payment: 343_334, Balance: 0},
+		}, s.Installments)
+	})
+
+	t.Run("annuity", func(t *testing.T) {
+		s, err := amortization.New(amortization.Terms{Principal: 1_000_000, AnnualRate: 12, Periods: 3, PeriodsPerYear: 12, Method: amortization.MethodAnnuity})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []amortization.Installment{
+			{Period: 1, Principal: 330_022, Interest: 10_000, Payment: 340_022, Balance: 669_978},
+			{Period: 2, Principal: 333_322, Interest: 6_700, Payment: 340_022, Balance: 336_656},
+			{Period: 3, Principal: 336_656, Interest: 3_367, Payment: 340_023, Balance: 0},
+		}, s.Installments)
+	})
+
+	t.Run("declining balance", func(t *testing.T) {
+		s, err := amortization.New(amortization.Terms{Principal: 1_000_000, AnnualRate: 12, Periods: 3, PeriodsPerYear: 12, Method: amortization.MethodDecliningBalance})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []amortization.Installment{
+			{Period: 1, Principal: 333_333, Interest: 10_000, Payment: 343_333, Balance: 666_667},
+			{Period: 2, Principal: 333_333, Interest: 6_667, Payment: 340_000, Balance: 333_334},
+			{Period: 3, Principal: 333_334, Interest: 3_333, Payment: 336_667, Balance: 0},
+		}, s.Installments)
+	})
+
+	t.Run("invalid terms", func(t *testing.T) {
+		for _, terms := range []amortization.Terms{
+			{Principal: 1_000_000, AnnualRate: 12, Periods: 3, PeriodsPerYear: 12, Method: "balloon"},
+			{Principal: 0, AnnualRate: 12, Periods: 3, PeriodsPerYear: 12, Method: amortization.MethodFlat},
+			{Principal: 1_000_000, AnnualRate: 12, Periods: 0, PeriodsPerYear: 12, Method: amortization.MethodFlat},
+			{Principal: 1_000_000, AnnualRate: -1, Periods: 3, PeriodsPerYear: 12, Method: amortization.MethodFlat},
+		} {
+			_, err := amortization.New(terms)
+			assert.Error(t, err, terms)
+		}
+	})
+}
+
+func TestScheduleRates(t *testing.T) {
+	t.Run("flat rate costs about twice its quote", func(t *testing.T) {
+		s, _ := amortization.New(amortization.Terms{Principal: 12_000_000, AnnualRate: 12, Periods: 12, PeriodsPerYear: 12, Method: amortization.MethodFlat})
+
+		assert.InDelta(t, 21.45, s.APR(), 0.01)
+		assert.InDelta(t, 23.70, s.EIR(), 0.01)
+	})
+
+	t.Run("annuity costs its quote", func(t *testing.T) {
+		s, _ := amortization.New(amortization.Terms{Principal: 12_000_000, AnnualRate: 12, Periods: 12, PeriodsPerYear: 12, Method: amortization.MethodAnnuity})
+
+		assert.InDelta(t, 12, s.APR(), 0.01)
+		assert.InDelta(t, 12.68, s.EIR(), 0.01)
+	})
+
+	t.Run("interest free", func(t *testing.T) {
+		s, _ := amortization.New(amortization.Terms{Principal: 12_000_000, Periods: 12, PeriodsPerYear: 12, Method: amortization.MethodFlat})
+
+		assert.Equal(t, float64(0), s.APR())
+		assert.Equal(t, float64(0), s.EIR())
+	})
+}
@@ -0,0 +1,109 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Money is an exact amount in whole rupiah. Rupiah has no minor unit in circulation, so every amount the platform
+// books, from installments to investor shares, is rounded to the rupiah before it becomes Money.
+type Money int64
+
+// Parse reads a decimal rupiah amount such as "1500000" or "1500000.00". Non-zero fractions are rejected rather than
+// rounded so that no amount silently changes on its way in.
+func Parse(s string) (Money, error) {
+	whole, fraction, _ := strings.Cut(strings.TrimSpace(s), ".")
+	if strings.Trim(fraction, "0") != "" {
+		return 0, fmt.Errorf("money: %q is not a whole rupiah amount", s)
+	}
+
+	amount, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("money: invalid amount %q", s)
+	}
+
+	return Money(amount), nil
+}
+
+// Round converts a computed rupiah amount to Money, rounding half away from zero.
+func Round(amount float64) Money {
+	return Money(math.Round(amount))
+}
+
+// Floor converts a computed rupiah amount to Money, rounding down.
+func Floor(amount float64) Money {
+	return Money(math.Floor(amount))
+}
+
+func Min(a, b Money) Money {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// MulDiv returns m * numerator / denominator rounded down, without overflowing on large amounts.
+func (m Money) MulDiv(numerator, denominator Money) Money {
+	product := new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(int64(numerator)))
+	quotient := new(big.Int).Div(product, big.NewInt(int64(denominator)))
+	return Money(quotient.Int64())
+}
+
+func (m Money) String() string {
+	return strconv.FormatInt(int64(m), 10)
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return int64(m), nil
+}
+
+func (m *Money) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*m = 0
+	case int64:
+		*m = Money(v)
+	case float64:
+		if v != math.Trunc(v) {
+			return fmt.Errorf("money: %v is not a whole rupiah amount", v)
+		}
+		*m = Money(v)
+	case []byte:
+		return m.parseInto(string(v))
+	case string:
+		return m.parseInto(v)
+	default:
+		return fmt.Errorf("money: cannot scan %T", src)
+	}
+
+	return nil
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(m), 10), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a numeric string.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+
+	return m.parseInto(strings.Trim(s, `"`))
+}
+
+func (m *Money) parseInto(s string) error {
+	amount, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*m = amount
+	return nil
+}
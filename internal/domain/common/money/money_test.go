@@ -0,0 +1,73 @@
+package money_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("whole amounts", func(t *testing.T) {
+		for input, expected := range map[string]money.Money{
+			"0":                0,
+			"1500000":          1500000,
+			"1500000.00":       1500000,
+			"-250":             -250,
+			"9007199254740993": 9007199254740993,
+		} {
+			amount, err := money.Parse(input)
+			assert.NoError(t, err, input)
+			assert.Equal(t, expected, amount, input)
+		}
+	})
+
+	t.Run("rejects fractions and garbage", func(t *testing.T) {
+		for _, input := range []string{"1500000.50", "0.1", "", "abc", "1e6"} {
+			_, err := money.Parse(input)
+			assert.Error(t, err, input)
+		}
+	})
+}
+
+func TestScan(t *testing.T) {
+	var amount money.Money
+
+	assert.NoError(t, amount.Scan([]byte("2500000")))
+	assert.Equal(t, money.Money(2500000), amount)
+
+	assert.NoError(t, amount.Scan(int64(42)))
+	assert.Equal(t, money.Money(42), amount)
+
+	assert.NoError(t, amount.Scan(float64(1000)))
+	assert.Equal(t, money.Money(1000), amount)
+
+	assert.Error(t, amount.Scan(float64(0.3)))
+	assert.Error(t, amount.Scan(true))
+}
+
+func TestJSON(t *testing.T) {
+	var body struct {
+		Amount money.Money `json:"amount"`
+	}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount": 1000000}`), &body))
+	assert.Equal(t, money.Money(1000000), body.Amount)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount": "750000"}`), &body))
+	assert.Equal(t, money.Money(750000), body.Amount)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount": 10.5}`), &body))
+
+	out, err := json.Marshal(body)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount": 750000}`, string(out))
+}
+
+func TestMulDiv(t *testing.T) {
+	assert.Equal(t, money.Money(333), money.Money(1000).MulDiv(1, 3))
+	assert.Equal(t, money.Money(666), money.Money(1000).MulDiv(2, 3))
+	// would overflow int64 if multiplied directly
+	assert.Equal(t, money.Money(5_000_000_000_000), money.Money(10_000_000_000_000).MulDiv(5_000_000_000_000, 10_000_000_000_000))
+}
@@ -0,0 +1,126 @@
+package eligibility
+
+import (
+	"slices"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/google/uuid"
+)
+
+// Facts is what the rules know about a borrower applying for a new loan
+type Facts struct {
+	Borrower        borrower.Borrower
+	PrincipalAmount money.Money
+	Loans           []loan.Loan               // every existing loan of the borrower
+	Installments    []installment.Installment // the installments of those loans
+	Rejections      []loan.StateHistory       // the rejections of those loans, from their state history
+	Blacklist       []borrower.BlacklistEntry // the entries active at Now
+	Now             time.Time
+}
+
+// ActiveStates are the states of a loan the borrower still holds, from proposal until it is paid off
+var ActiveStates = []loan.State{loan.StateProposed, loan.StateApproved, loan.StateInvested, loan.StateDisbursed}
+
+func (f Facts) ActiveLoans() []loan.Loan {
+	active := []loan.Loan{}
+	for _, l := range f.Loans {
+		if slices.Contains(ActiveStates, l.State) {
+			active = append(active, l)
+		}
+	}
+
+	return active
+}
+
+func (f Facts) PaidOffLoans() int {
+	count := 0
+	for _, l := range f.Loans {
+		if l.State == loan.StatePaidOff {
+			count++
+		}
+	}
+
+	return count
+}
+
+// LateInstallments counts the installments paid after their due date and those still overdue at Now
+func (f Facts) LateInstallments() int {
+	count := 0
+	for _, i := range f.Installments {
+		paidLate := i.PaidAt != nil && i.PaidAt.After(i.DueDate)
+		overdue := i.Status != installment.StatusPaid && i.DueDate.Before(f.Now)
+		if paidLate || overdue {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Exposure is the principal the borrower still owes or has been promised: the full principal of loans not disbursed
+// yet and the unpaid principal of disbursed ones
+func (f Facts) Exposure() money.Money {
+	outstanding := map[uuid.UUID]money.Money{}
+	for _, i := range f.Installments {
+		outstanding[i.LoanID] += i.PrincipalDue()
+	}
+
+	var exposure money.Money
+	for _, l := range f.ActiveLoans() {
+		if amount, ok := outstanding[l.ID]; ok && l.State == loan.StateDisbursed {
+			exposure += amount
+		} else {
+			exposure += l.PrincipalAmount
+		}
+	}
+
+	return exposure
+}
+
+// LastRejection returns when the borrower's most recent rejected loan was rejected. It reads the state history, which
+// later writes to the loan do not change.
+func (f Facts) LastRejection() (time.Time, bool) {
+	var last time.Time
+	for _, h := range f.Rejections {
+		if h.CreatedAt != nil && h.CreatedAt.After(last) {
+			last = *h.CreatedAt
+		}
+	}
+
+	return last, !last.IsZero()
+}
+
+// Rule decides one aspect of whether a borrower may take a new loan. The reason is stored on the loan either way, so
+// it should explain the outcome to someone reviewing the proposal.
+type Rule interface {
+	Name() string
+	Evaluate(facts Facts) (passed bool, reason string)
+}
+
+// Engine runs a fixed list of rules. New rules are added by implementing Rule and passing them to NewEngine.
+type Engine struct {
+	rules []Rule
+}
+
+func NewEngine(rules ...Rule) Engine {
+	return Engine{rules: rules}
+}
+
+// Evaluate runs every rule rather than stopping at the first failure, so a refused proposal lists all its reasons
+func (e Engine) Evaluate(facts Facts) loan.EligibilityChecks {
+	checks := make(loan.EligibilityChecks, 0, len(e.rules))
+	for _, rule := range e.rules {
+		passed, reason := rule.Evaluate(facts)
+		checks = append(checks, loan.EligibilityCheck{
+			Rule:   rule.Name(),
+			Passed: passed,
+			Reason: reason,
+		})
+	}
+
+	return checks
+}
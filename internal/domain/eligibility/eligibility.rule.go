@@ -0,0 +1,129 @@
+package eligibility
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+)
+
+const dateLayout = "2006-01-02"
+
+// BorrowerStatusRule requires an active borrower whose KYC is verified
+type BorrowerStatusRule struct{}
+
+func (BorrowerStatusRule) Name() string {
+	return "borrower_status"
+}
+
+func (BorrowerStatusRule) Evaluate(facts Facts) (bool, string) {
+	if err := facts.Borrower.CanBorrow(); err != nil {
+		return false, err.Error()
+	}
+
+	return true, "borrower is active and KYC is verified"
+}
+
+// BlacklistRule refuses borrowers with an active blacklist entry
+type BlacklistRule struct{}
+
+func (BlacklistRule) Name() string {
+	return "blacklist"
+}
+
+func (BlacklistRule) Evaluate(facts Facts) (bool, string) {
+	if len(facts.Blacklist) == 0 {
+		return true, "borrower is not blacklisted"
+	}
+
+	reasons := make([]string, 0, len(facts.Blacklist))
+	for _, entry := range facts.Blacklist {
+		if entry.ExpiresAt != nil {
+			reasons = append(reasons, fmt.Sprintf("%s (until %s)", entry.Reason, entry.ExpiresAt.Format(dateLayout)))
+		} else {
+			reasons = append(reasons, entry.Reason)
+		}
+	}
+
+	return false, "borrower is blacklisted: " + strings.Join(reasons, "; ")
+}
+
+// MaxActiveLoansRule caps the loans a borrower holds at once, counting proposals. A Max of zero disables the rule.
+type MaxActiveLoansRule struct {
+	Max int
+}
+
+func (MaxActiveLoansRule) Name() string {
+	return "max_active_loans"
+}
+
+func (r MaxActiveLoansRule) Evaluate(facts Facts) (bool, string) {
+	active := len(facts.ActiveLoans())
+	if r.Max <= 0 {
+		return true, fmt.Sprintf("borrower has %d active loans and no limit applies", active)
+	} else if active >= r.Max {
+		return false, fmt.Sprintf("borrower already has %d active loans, the maximum is %d", active, r.Max)
+	}
+
+	return true, fmt.Sprintf("borrower has %d of at most %d active loans", active, r.Max)
+}
+
+// RejectionCooldownRule makes a borrower wait Period after a rejected loan before a new one is proposed. A zero Period
+// disables the rule.
+type RejectionCooldownRule struct {
+	Period time.Duration
+}
+
+func (RejectionCooldownRule) Name() string {
+	return "rejection_cooldown"
+}
+
+func (r RejectionCooldownRule) Evaluate(facts Facts) (bool, string) {
+	rejectedAt, ok := facts.LastRejection()
+	if !ok {
+		return true, "borrower has no rejected loan"
+	}
+
+	until := rejectedAt.Add(r.Period)
+	if facts.Now.Before(until) {
+		return false, fmt.Sprintf("last loan was rejected on %s, a new loan can be proposed from %s", rejectedAt.Format(dateLayout), until.Format(dateLayout))
+	}
+
+	return true, fmt.Sprintf("last loan was rejected on %s, outside the cool-down", rejectedAt.Format(dateLayout))
+}
+
+// CreditLimitRule limits what a borrower may owe, including the requested principal. The limit starts at BaseLimit,
+// grows by Step for every paid-off loan and shrinks by Step for every late installment, between zero and MaxLimit.
+// A MaxLimit of zero leaves the limit uncapped.
+type CreditLimitRule struct {
+	BaseLimit money.Money
+	Step      money.Money
+	MaxLimit  money.Money
+}
+
+func (CreditLimitRule) Name() string {
+	return "credit_limit"
+}
+
+// Limit is the credit limit earned by the borrower's repayment history
+func (r CreditLimitRule) Limit(facts Facts) money.Money {
+	limit := r.BaseLimit + r.Step*money.Money(facts.PaidOffLoans()) - r.Step*money.Money(facts.LateInstallments())
+	if r.MaxLimit > 0 {
+		limit = money.Min(limit, r.MaxLimit)
+	}
+
+	return max(limit, 0)
+}
+
+func (r CreditLimitRule) Evaluate(facts Facts) (bool, string) {
+	limit := r.Limit(facts)
+	exposure := facts.Exposure()
+	history := fmt.Sprintf("%d paid-off loans, %d late installments", facts.PaidOffLoans(), facts.LateInstallments())
+
+	if exposure+facts.PrincipalAmount > limit {
+		return false, fmt.Sprintf("outstanding %s plus requested %s exceeds the credit limit of %s (%s)", exposure, facts.PrincipalAmount, limit, history)
+	}
+
+	return true, fmt.Sprintf("outstanding %s plus requested %s is within the credit limit of %s (%s)", exposure, facts.PrincipalAmount, limit, history)
+}
@@ -0,0 +1,13 @@
+package eligibility
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+)
+
+type IEligibilityUsecase interface {
+	Evaluate(ctx context.Context, b borrower.Borrower, principalAmount money.Money) (loan.EligibilityChecks, error)
+}
@@ -0,0 +1,200 @@
+package eligibility_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/eligibility"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2025, 10, 9, 12, 0, 0, 0, time.UTC)
+
+func newLoan(state loan.State, principal money.Money, updatedAt time.Time) loan.Loan {
+	return loan.Loan{
+		BaseModel:       model.BaseModel{ID: uuid.New(), UpdatedAt: &updatedAt},
+		PrincipalAmount: principal,
+		State:           state,
+	}
+}
+
+func TestEngineEvaluate(t *testing.T) {
+	engine := eligibility.NewEngine(
+		eligibility.BorrowerStatusRule{},
+		eligibility.BlacklistRule{},
+		eligibility.MaxActiveLoansRule{Max: 1},
+	)
+	facts := eligibility.Facts{
+		Borrower: borrower.Borrower{Status: borrower.StatusInactive, KYCStatus: borrower.KYCStatusVerified},
+		Loans:    []loan.Loan{newLoan(loan.StateDisbursed, 1_000_000, now)},
+		Now:      now,
+	}
+
+	checks := engine.Evaluate(facts)
+
+	assert.Equal(t, loan.EligibilityChecks{
+		{Rule: "borrower_status", Passed: false, Reason: "borrower is not active"},
+		{Rule: "blacklist", Passed: true, Reason: "borrower is not blacklisted"},
+		{Rule: "max_active_loans", Passed: false, Reason: "borrower already has 1 active loans, the maximum is 1"},
+	}, checks)
+	assert.False(t, checks.Passed())
+	assert.Equal(t, []string{
+		"borrower_status: borrower is not active",
+		"max_active_loans: borrower already has 1 active loans, the maximum is 1",
+	}, checks.Failures())
+}
+
+func TestBlacklistRule(t *testing.T) {
+	expiresAt := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	facts := eligibility.Facts{
+		Blacklist: []borrower.BlacklistEntry{
+			{Reason: "fraudulent documents"},
+			{Reason: "disputed repayment", ExpiresAt: &expiresAt},
+		},
+		Now: now,
+	}
+
+	passed, reason := eligibility.BlacklistRule{}.Evaluate(facts)
+
+	assert.False(t, passed)
+	assert.Equal(t, "borrower is blacklisted: fraudulent documents; disputed repayment (until 2026-01-31)", reason)
+}
+
+func TestMaxActiveLoansRule(t *testing.T) {
+	loans := []loan.Loan{
+		newLoan(loan.StateProposed, 1_000_000, now),
+		newLoan(loan.StateDisbursed, 1_000_000, now),
+		newLoan(loan.StatePaidOff, 1_000_000, now),
+		newLoan(loan.StateRejected, 1_000_000, now),
+	}
+
+	tests := []struct {
+		name   string
+		max    int
+		passed bool
+	}{
+		{"below the maximum", 3, true},
+		{"at the maximum", 2, false},
+		{"disabled", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passed, _ := eligibility.MaxActiveLoansRule{Max: tt.max}.Evaluate(eligibility.Facts{Loans: loans, Now: now})
+			assert.Equal(t, tt.passed, passed)
+		})
+	}
+}
+
+func TestRejectionCooldownRule(t *testing.T) {
+	rule := eligibility.RejectionCooldownRule{Period: 30 * 24 * time.Hour}
+	rejection := func(rejectedAt time.Time) loan.StateHistory {
+		return loan.StateHistory{BaseModel: model.BaseModel{ID: uuid.New(), CreatedAt: &rejectedAt}, ToState: loan.StateRejected}
+	}
+
+	tests := []struct {
+		name       string
+		rejections []loan.StateHistory
+		passed     bool
+		reason     string
+	}{
+		{"no rejection", nil, true, "borrower has no rejected loan"},
+		{"within the cool-down", []loan.StateHistory{
+			rejection(now.AddDate(0, 0, -60)),
+			rejection(now.AddDate(0, 0, -10)),
+		}, false, "last loan was rejected on 2025-09-29, a new loan can be proposed from 2025-10-29"},
+		{"after the cool-down", []loan.StateHistory{rejection(now.AddDate(0, 0, -31))}, true, "last loan was rejected on 2025-09-08, outside the cool-down"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loans := []loan.Loan{newLoan(loan.StatePaidOff, 1_000_000, now.AddDate(0, 0, -1))}
+			if len(tt.rejections) > 0 {
+				// the rejected loan was written to yesterday, which must not restart the cool-down
+				loans = []loan.Loan{newLoan(loan.StateRejected, 1_000_000, now.AddDate(0, 0, -1))}
+			}
+			passed, reason := rule.Evaluate(eligibility.Facts{Loans: loans, Rejections: tt.rejections, Now: now})
+			assert.Equal(t, tt.passed, passed)
+			assert.Equal(t, tt.reason, reason)
+		})
+	}
+}
+
+func TestCreditLimitRule(t *testing.T) {
+	rule := eligibility.CreditLimitRule{BaseLimit: 10_000_000, Step: 5_000_000, MaxLimit: 20_000_000}
+	paidAt := now.AddDate(0, -1, 0)
+	paidOff := newLoan(loan.StatePaidOff, 5_000_000, now)
+	disbursed := newLoan(loan.StateDisbursed, 6_000_000, now)
+	proposed := newLoan(loan.StateProposed, 2_000_000, now)
+	onTime := installment.Installment{LoanID: paidOff.ID, DueDate: paidAt, PrincipalAmount: 5_000_000, PaidPrincipal: 5_000_000, Status: installment.StatusPaid, PaidAt: &paidAt}
+	unpaid := installment.Installment{LoanID: disbursed.ID, DueDate: now.AddDate(0, 1, 0), PrincipalAmount: 4_000_000, Status: installment.StatusUnpaid}
+	paidLateAt := paidAt.AddDate(0, 0, 3)
+	paidLate := installment.Installment{LoanID: disbursed.ID, DueDate: paidAt, PrincipalAmount: 2_000_000, PaidPrincipal: 2_000_000, Status: installment.StatusPaid, PaidAt: &paidLateAt}
+
+	tests := []struct {
+		name      string
+		facts     eligibility.Facts
+		wantLimit int
+		passed    bool
+	}{
+		{
+			name:      "first loan within the base limit",
+			facts:     eligibility.Facts{PrincipalAmount: 10_000_000, Now: now},
+			wantLimit: 10_000_000,
+			passed:    true,
+		},
+		{
+			name:      "first loan above the base limit",
+			facts:     eligibility.Facts{PrincipalAmount: 10_000_001, Now: now},
+			wantLimit: 10_000_000,
+			passed:    false,
+		},
+		{
+			// 4,000,000 unpaid on the disbursed loan and 2,000,000 proposed count towards the limit of 15,000,000
+			name: "paid-off loan raises the limit",
+			facts: eligibility.Facts{
+				PrincipalAmount: 9_000_000,
+				Loans:           []loan.Loan{paidOff, disbursed, proposed},
+				Installments:    []installment.Installment{onTime, unpaid},
+				Now:             now,
+			},
+			wantLimit: 15_000_000,
+			passed:    true,
+		},
+		{
+			name: "late installment lowers the limit",
+			facts: eligibility.Facts{
+				PrincipalAmount: 5_000_000,
+				Loans:           []loan.Loan{paidOff, disbursed},
+				Installments:    []installment.Installment{onTime, paidLate, unpaid},
+				Now:             now,
+			},
+			wantLimit: 10_000_000,
+			passed:    true,
+		},
+		{
+			name: "limit is capped",
+			facts: eligibility.Facts{
+				PrincipalAmount: 20_000_001,
+				Loans:           []loan.Loan{paidOff, paidOff, paidOff},
+				Now:             now,
+			},
+			wantLimit: 20_000_000,
+			passed:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passed, _ := rule.Evaluate(tt.facts)
+			assert.Equal(t, tt.wantLimit, int(rule.Limit(tt.facts)))
+			assert.Equal(t, tt.passed, passed)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package employee
+
+import "github.com/google/uuid"
+
+type AssignRolesRequest struct {
+	Roles []string `json:"roles" validate:"required,min=1,dive,required"`
+}
+
+type AssignBranchesRequest struct {
+	BranchIDs []uuid.UUID `json:"branch_ids" validate:"required,min=1"`
+}
@@ -1,15 +1,92 @@
 package employee
 
 import (
+	"slices"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
 	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type Employee struct {
 	model.BaseModel
-	FullName string `json:"full_name"`
-	Email    string `json:"email"`
+	FullName     string          `json:"full_name"`
+	Email        string          `json:"email"`
+	PasswordHash string          `json:"-"`
+	Roles        []Role          `json:"roles" gorm:"-"`
+	Branches     []branch.Branch `json:"branches" gorm:"-"`
 }
 
 func (Employee) TableName() string {
 	return "employees"
 }
+
+// Permissions returns the distinct permissions granted by all roles of the employee
+func (e Employee) Permissions() []Permission {
+	permissions := []Permission{}
+	for _, role := range e.Roles {
+		for _, permission := range role.Permissions {
+			if !slices.Contains(permissions, Permission(permission)) {
+				permissions = append(permissions, Permission(permission))
+			}
+		}
+	}
+
+	return permissions
+}
+
+func (e Employee) RoleNames() []string {
+	names := make([]string, 0, len(e.Roles))
+	for _, role := range e.Roles {
+		names = append(names, role.Name)
+	}
+
+	return names
+}
+
+func (e Employee) HasPermission(permission Permission) bool {
+	return slices.Contains(e.Permissions(), permission)
+}
+
+type Role struct {
+	model.BaseModel
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Permissions pq.StringArray `json:"permissions" gorm:"type:text[]"`
+}
+
+func (Role) TableName() string {
+	return "roles"
+}
+
+type EmployeeRole struct {
+	model.BaseModel
+	EmployeeID uuid.UUID `json:"employee_id"`
+	RoleID     uuid.UUID `json:"role_id"`
+}
+
+func (EmployeeRole) TableName() string {
+	return "employee_roles"
+}
+
+type Permission string
+
+const (
+	PermissionLoanCreate        Permission = "loan.create"
+	PermissionLoanRead          Permission = "loan.read"
+	PermissionLoanReject        Permission = "loan.reject"
+	PermissionLoanApprove       Permission = "loan.approve"
+	PermissionLoanDisburse      Permission = "loan.disburse"
+	PermissionLoanActOnBehalf   Permission = "loan.act_on_behalf"
+	PermissionBorrowerRead      Permission = "borrower.read"
+	PermissionBorrowerManage    Permission = "borrower.manage"
+	PermissionKYCReview         Permission = "kyc.review"
+	PermissionBorrowerBlacklist Permission = "borrower.blacklist"
+	PermissionGroupManage       Permission = "group.manage"
+	PermissionRepaymentCreate   Permission = "repayment.create"
+	PermissionWithdrawalReview  Permission = "withdrawal.review"
+	PermissionRoleAssign        Permission = "role.assign"
+	PermissionBranchAssign      Permission = "branch.assign"
+	PermissionBranchAll         Permission = "branch.all" // head office, not limited to the employee's branches
+)
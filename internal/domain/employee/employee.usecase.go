@@ -0,0 +1,16 @@
+package employee
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/google/uuid"
+)
+
+type IEmployeeUsecase interface {
+	ListRole(ctx context.Context) ([]Role, error)
+	DetailEmployee(ctx context.Context, employeeID uuid.UUID) (*Employee, error)
+	AssignRoles(ctx context.Context, employeeID uuid.UUID, req AssignRolesRequest) (*Employee, error)
+	ListBranch(ctx context.Context) ([]branch.Branch, error)
+	AssignBranches(ctx context.Context, employeeID uuid.UUID, req AssignBranchesRequest) (*Employee, error)
+}
@@ -1,9 +1,14 @@
 package employee
 
 import (
+	"context"
+
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
 )
 
 type IEmployeeRepository interface {
 	repository.IBaseRepo[Employee]
+	GetByEmail(ctx context.Context, email string) (Employee, error)
+	GetByIDWithRoles(ctx context.Context, ID uuid.UUID) (Employee, error)
 }
@@ -0,0 +1,14 @@
+package employee
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type IEmployeeRoleRepository interface {
+	repository.IBaseRepo[EmployeeRole]
+	DeleteByEmployeeIDWithTx(ctx context.Context, employeeID uuid.UUID, trx *gorm.DB) error
+}
@@ -781,6 +781,72 @@ func (_c *MockIEmployeeRepository_GetAll_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// GetByEmail provides a mock function for the type MockIEmployeeRepository
+func (_mock *MockIEmployeeRepository) GetByEmail(ctx context.Context, email string) (employee.Employee, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByEmail")
+	}
+
+	var r0 employee.Employee
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (employee.Employee, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) employee.Employee); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Get(0).(employee.Employee)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIEmployeeRepository_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
+type MockIEmployeeRepository_GetByEmail_Call struct {
+	*mock.Call
+}
+
+// GetByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockIEmployeeRepository_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockIEmployeeRepository_GetByEmail_Call {
+	return &MockIEmployeeRepository_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+}
+
+func (_c *MockIEmployeeRepository_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockIEmployeeRepository_GetByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmployeeRepository_GetByEmail_Call) Return(employee1 employee.Employee, err error) *MockIEmployeeRepository_GetByEmail_Call {
+	_c.Call.Return(employee1, err)
+	return _c
+}
+
+func (_c *MockIEmployeeRepository_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (employee.Employee, error)) *MockIEmployeeRepository_GetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function for the type MockIEmployeeRepository
 func (_mock *MockIEmployeeRepository) GetByID(ctx context.Context, ID uuid.UUID) (employee.Employee, error) {
 	ret := _mock.Called(ctx, ID)
@@ -919,6 +985,72 @@ func (_c *MockIEmployeeRepository_GetByIDLockTx_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetByIDWithRoles provides a mock function for the type MockIEmployeeRepository
+func (_mock *MockIEmployeeRepository) GetByIDWithRoles(ctx context.Context, ID uuid.UUID) (employee.Employee, error) {
+	ret := _mock.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDWithRoles")
+	}
+
+	var r0 employee.Employee
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (employee.Employee, error)); ok {
+		return returnFunc(ctx, ID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) employee.Employee); ok {
+		r0 = returnFunc(ctx, ID)
+	} else {
+		r0 = ret.Get(0).(employee.Employee)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIEmployeeRepository_GetByIDWithRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDWithRoles'
+type MockIEmployeeRepository_GetByIDWithRoles_Call struct {
+	*mock.Call
+}
+
+// GetByIDWithRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID uuid.UUID
+func (_e *MockIEmployeeRepository_Expecter) GetByIDWithRoles(ctx interface{}, ID interface{}) *MockIEmployeeRepository_GetByIDWithRoles_Call {
+	return &MockIEmployeeRepository_GetByIDWithRoles_Call{Call: _e.mock.On("GetByIDWithRoles", ctx, ID)}
+}
+
+func (_c *MockIEmployeeRepository_GetByIDWithRoles_Call) Run(run func(ctx context.Context, ID uuid.UUID)) *MockIEmployeeRepository_GetByIDWithRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmployeeRepository_GetByIDWithRoles_Call) Return(employee1 employee.Employee, err error) *MockIEmployeeRepository_GetByIDWithRoles_Call {
+	_c.Call.Return(employee1, err)
+	return _c
+}
+
+func (_c *MockIEmployeeRepository_GetByIDWithRoles_Call) RunAndReturn(run func(ctx context.Context, ID uuid.UUID) (employee.Employee, error)) *MockIEmployeeRepository_GetByIDWithRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByIDs provides a mock function for the type MockIEmployeeRepository
 func (_mock *MockIEmployeeRepository) GetByIDs(ctx context.Context, IDs []uuid.UUID) ([]employee.Employee, error) {
 	ret := _mock.Called(ctx, IDs)
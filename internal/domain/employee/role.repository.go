@@ -0,0 +1,12 @@
+package employee
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+)
+
+type IRoleRepository interface {
+	repository.IBaseRepo[Role]
+	GetByNames(ctx context.Context, names []string) ([]Role, error)
+}
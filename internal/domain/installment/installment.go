@@ -0,0 +1,53 @@
+package installment
+
+import (
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/google/uuid"
+)
+
+type Installment struct {
+	model.BaseModel
+	LoanID             uuid.UUID   `json:"loan_id"`
+	Sequence           int         `json:"sequence"`
+	DueDate            time.Time   `json:"due_date"`
+	PrincipalAmount    money.Money `json:"principal_amount"`
+	InterestAmount     money.Money `json:"interest_amount"`
+	FeeAmount          money.Money `json:"fee_amount"`
+	OutstandingBalance money.Money `json:"outstanding_balance"`
+	PaidPrincipal      money.Money `json:"paid_principal"`
+	PaidInterest       money.Money `json:"paid_interest"`
+	PaidFee            money.Money `json:"paid_fee"`
+	Status             Status      `json:"status"`
+	PaidAt             *time.Time  `json:"paid_at"`
+}
+
+func (Installment) TableName() string {
+	return "installments"
+}
+
+type Status string
+
+const (
+	StatusUnpaid  Status = "unpaid"
+	StatusPartial Status = "partial"
+	StatusPaid    Status = "paid"
+)
+
+func (i Installment) FeeDue() money.Money {
+	return i.FeeAmount - i.PaidFee
+}
+
+func (i Installment) InterestDue() money.Money {
+	return i.InterestAmount - i.PaidInterest
+}
+
+func (i Installment) PrincipalDue() money.Money {
+	return i.PrincipalAmount - i.PaidPrincipal
+}
+
+func (i Installment) TotalDue() money.Money {
+	return i.FeeDue() + i.InterestDue() + i.PrincipalDue()
+}
@@ -0,0 +1,18 @@
+package installment
+
+import (
+	"context"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type IInstallmentRepository interface {
+	repository.IBaseRepo[Installment]
+	GetByLoanID(ctx context.Context, loanID uuid.UUID) ([]Installment, error)
+	GetUnpaidByLoanIDLockTx(ctx context.Context, loanID uuid.UUID, trx *gorm.DB) ([]Installment, error)
+	GetByLoanIDs(ctx context.Context, loanIDs []uuid.UUID) ([]Installment, error)
+	GetOverdueByLoanIDs(ctx context.Context, loanIDs []uuid.UUID, asOf time.Time) ([]Installment, error)
+}
@@ -0,0 +1,46 @@
+package investment
+
+import (
+	"fmt"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+)
+
+// ConcentrationLimits are the per-investor diversification limits. A zero limit is off.
+type ConcentrationLimits struct {
+	MaxAmountPerLoan money.Money // per loan
+	MaxLoanShare     float64     // percentage of the loan principal
+	MaxBorrowerShare float64     // percentage of the investor's portfolio, the balance plus the exposure
+}
+
+func (c ConcentrationLimits) Enabled() bool {
+	return c.MaxAmountPerLoan > 0 || c.MaxLoanShare > 0 || c.MaxBorrowerShare > 0
+}
+
+// Violations describes every limit an investor with the exposure and balance would exceed by taking amount more of
+// a loan with the principal
+func (c ConcentrationLimits) Violations(exposure Exposure, balance money.Money, principal money.Money, amount money.Money) []string {
+	var violations []string
+
+	inLoan := exposure.Loan + amount
+	if c.MaxAmountPerLoan > 0 && inLoan > c.MaxAmountPerLoan {
+		violations = append(violations, fmt.Sprintf("investment in this loan would be %s, above the maximum of %s per investor", inLoan, c.MaxAmountPerLoan))
+	}
+
+	if c.MaxLoanShare > 0 {
+		maxAmount := money.Floor(float64(principal) * c.MaxLoanShare / 100)
+		if inLoan > maxAmount {
+			violations = append(violations, fmt.Sprintf("investment in this loan would be %s, above %g%% of its principal amount (%s)", inLoan, c.MaxLoanShare, maxAmount))
+		}
+	}
+
+	if c.MaxBorrowerShare > 0 {
+		inBorrower := exposure.Borrower + amount
+		maxAmount := money.Floor(float64(balance+exposure.Total) * c.MaxBorrowerShare / 100)
+		if inBorrower > maxAmount {
+			violations = append(violations, fmt.Sprintf("investment in this borrower would be %s, above %g%% of your portfolio (%s)", inBorrower, c.MaxBorrowerShare, maxAmount))
+		}
+	}
+
+	return violations
+}
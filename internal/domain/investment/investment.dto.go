@@ -3,21 +3,83 @@ package investment
 import (
 	"time"
 
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
 	"github.com/google/uuid"
 )
 
 type CreateInvestmentRequest struct {
-	LoanID uuid.UUID `json:"loan_id" validate:"required"`
-	Amount float64   `json:"amount" validate:"required,min=1"`
+	LoanID uuid.UUID   `json:"loan_id" validate:"required"`
+	Amount money.Money `json:"amount" validate:"required,min=1"`
+}
+
+// Exposure is what an investor has at risk in loans that are still open or running, the invested principal less what
+// has been repaid: in one loan, in all loans of one borrower, and in total
+type Exposure struct {
+	Loan     money.Money
+	Borrower money.Money
+	Total    money.Money
 }
 
 type InvestmentAgreementResponse struct {
 	AgreementID      uuid.UUID
 	AgreementDate    time.Time
-	InvestmentAmount float64
+	InvestmentAmount money.Money
 	ROI              float32
 	LoanID           uuid.UUID
 	LoanTerm         int
 	InvestorName     string
 	BorrowerName     string
+	RiskGrade        *string
+	CreditScore      *int
+}
+
+// InvestmentResponse is an investment as seen by its investor. ExpectedReturn is the return over the whole tenor at
+// the loan ROI, amortized like the loan, and OutstandingExposure is the principal not yet repaid.
+type InvestmentResponse struct {
+	ID                  uuid.UUID   `json:"id"`
+	LoanID              uuid.UUID   `json:"loan_id"`
+	LoanState           loan.State  `json:"loan_state"`
+	Amount              money.Money `json:"amount"`
+	ROI                 float32     `json:"roi"`
+	Tenor               int         `json:"tenor"`
+	ExpectedReturn      money.Money `json:"expected_return"`
+	RepaidPrincipal     money.Money `json:"repaid_principal"`
+	ReturnReceived      money.Money `json:"return_received"`
+	OutstandingExposure money.Money `json:"outstanding_exposure"`
+	CreatedAt           *time.Time  `json:"created_at"`
+}
+
+type InvestmentPagination struct {
+	Data    []InvestmentResponse `json:"data"`
+	HasNext bool                 `json:"has_next"`
+	HasPrev bool                 `json:"has_prev"`
+}
+
+type PortfolioTotal struct {
+	InvestmentCount     int         `json:"investment_count"`
+	Amount              money.Money `json:"amount"`
+	ExpectedReturn      money.Money `json:"expected_return"`
+	RepaidPrincipal     money.Money `json:"repaid_principal"`
+	ReturnReceived      money.Money `json:"return_received"`
+	OutstandingExposure money.Money `json:"outstanding_exposure"`
+}
+
+func (t *PortfolioTotal) Add(item InvestmentResponse) {
+	t.InvestmentCount++
+	t.Amount += item.Amount
+	t.ExpectedReturn += item.ExpectedReturn
+	t.RepaidPrincipal += item.RepaidPrincipal
+	t.ReturnReceived += item.ReturnReceived
+	t.OutstandingExposure += item.OutstandingExposure
+}
+
+type PortfolioStateTotal struct {
+	LoanState loan.State `json:"loan_state"`
+	PortfolioTotal
+}
+
+type PortfolioSummaryResponse struct {
+	Total       PortfolioTotal        `json:"total"`
+	ByLoanState []PortfolioStateTotal `json:"by_loan_state"`
 }
@@ -3,11 +3,17 @@ package investment
 import (
 	"context"
 
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type IInvestmentRepository interface {
 	repository.IBaseRepo[Investment]
-	GetTotalInvestmentByLoanID(ctx context.Context, loanID uuid.UUID) (float64, error)
+	GetTotalInvestmentByLoanID(ctx context.Context, loanID uuid.UUID) (money.Money, error)
+	GetTotalInvestmentByLoanIDs(ctx context.Context, loanIDs []uuid.UUID) (map[uuid.UUID]money.Money, error)
+	GetByLoanIDLockTx(ctx context.Context, loanID uuid.UUID, trx *gorm.DB) ([]Investment, error)
+	GetByInvestorID(ctx context.Context, investorID uuid.UUID) ([]Investment, error)
+	GetExposureTx(ctx context.Context, investorID uuid.UUID, loanID uuid.UUID, borrowerID uuid.UUID, trx *gorm.DB) (Exposure, error)
 }
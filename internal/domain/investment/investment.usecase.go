@@ -2,6 +2,7 @@ package investment
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -9,4 +10,9 @@ import (
 type IInvestmentUsecase interface {
 	AddInvestment(ctx context.Context, investorID uuid.UUID, req CreateInvestmentRequest) (res *Investment, err error)
 	GetInvestmentAgreementDetail(ctx context.Context, investmentID uuid.UUID) (*InvestmentAgreementResponse, error)
+	CancelInvestment(ctx context.Context, investorID uuid.UUID, investmentID uuid.UUID) (res *Investment, err error)
+	ExpireLoans(ctx context.Context, approvedBefore time.Time) (expired int, err error)
+	ListInvestment(ctx context.Context, investorID uuid.UUID, page int, limit int) (InvestmentPagination, error)
+	DetailInvestment(ctx context.Context, investorID uuid.UUID, investmentID uuid.UUID) (*InvestmentResponse, error)
+	GetPortfolioSummary(ctx context.Context, investorID uuid.UUID) (*PortfolioSummaryResponse, error)
 }
@@ -7,6 +7,7 @@ package investment
 import (
 	"context"
 
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
 	"github.com/BagusAK95/amarta_test/internal/domain/investment"
 	"github.com/google/uuid"
@@ -987,23 +988,249 @@ func (_c *MockIInvestmentRepository_GetByIDs_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// GetByInvestorID provides a mock function for the type MockIInvestmentRepository
+func (_mock *MockIInvestmentRepository) GetByInvestorID(ctx context.Context, investorID uuid.UUID) ([]investment.Investment, error) {
+	ret := _mock.Called(ctx, investorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByInvestorID")
+	}
+
+	var r0 []investment.Investment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]investment.Investment, error)); ok {
+		return returnFunc(ctx, investorID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []investment.Investment); ok {
+		r0 = returnFunc(ctx, investorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]investment.Investment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, investorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIInvestmentRepository_GetByInvestorID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByInvestorID'
+type MockIInvestmentRepository_GetByInvestorID_Call struct {
+	*mock.Call
+}
+
+// GetByInvestorID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - investorID uuid.UUID
+func (_e *MockIInvestmentRepository_Expecter) GetByInvestorID(ctx interface{}, investorID interface{}) *MockIInvestmentRepository_GetByInvestorID_Call {
+	return &MockIInvestmentRepository_GetByInvestorID_Call{Call: _e.mock.On("GetByInvestorID", ctx, investorID)}
+}
+
+func (_c *MockIInvestmentRepository_GetByInvestorID_Call) Run(run func(ctx context.Context, investorID uuid.UUID)) *MockIInvestmentRepository_GetByInvestorID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIInvestmentRepository_GetByInvestorID_Call) Return(investments []investment.Investment, err error) *MockIInvestmentRepository_GetByInvestorID_Call {
+	_c.Call.Return(investments, err)
+	return _c
+}
+
+func (_c *MockIInvestmentRepository_GetByInvestorID_Call) RunAndReturn(run func(ctx context.Context, investorID uuid.UUID) ([]investment.Investment, error)) *MockIInvestmentRepository_GetByInvestorID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByLoanIDLockTx provides a mock function for the type MockIInvestmentRepository
+func (_mock *MockIInvestmentRepository) GetByLoanIDLockTx(ctx context.Context, loanID uuid.UUID, trx *gorm.DB) ([]investment.Investment, error) {
+	ret := _mock.Called(ctx, loanID, trx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByLoanIDLockTx")
+	}
+
+	var r0 []investment.Investment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *gorm.DB) ([]investment.Investment, error)); ok {
+		return returnFunc(ctx, loanID, trx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *gorm.DB) []investment.Investment); ok {
+		r0 = returnFunc(ctx, loanID, trx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]investment.Investment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *gorm.DB) error); ok {
+		r1 = returnFunc(ctx, loanID, trx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIInvestmentRepository_GetByLoanIDLockTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByLoanIDLockTx'
+type MockIInvestmentRepository_GetByLoanIDLockTx_Call struct {
+	*mock.Call
+}
+
+// GetByLoanIDLockTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uuid.UUID
+//   - trx *gorm.DB
+func (_e *MockIInvestmentRepository_Expecter) GetByLoanIDLockTx(ctx interface{}, loanID interface{}, trx interface{}) *MockIInvestmentRepository_GetByLoanIDLockTx_Call {
+	return &MockIInvestmentRepository_GetByLoanIDLockTx_Call{Call: _e.mock.On("GetByLoanIDLockTx", ctx, loanID, trx)}
+}
+
+func (_c *MockIInvestmentRepository_GetByLoanIDLockTx_Call) Run(run func(ctx context.Context, loanID uuid.UUID, trx *gorm.DB)) *MockIInvestmentRepository_GetByLoanIDLockTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *gorm.DB
+		if args[2] != nil {
+			arg2 = args[2].(*gorm.DB)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIInvestmentRepository_GetByLoanIDLockTx_Call) Return(investments []investment.Investment, err error) *MockIInvestmentRepository_GetByLoanIDLockTx_Call {
+	_c.Call.Return(investments, err)
+	return _c
+}
+
+func (_c *MockIInvestmentRepository_GetByLoanIDLockTx_Call) RunAndReturn(run func(ctx context.Context, loanID uuid.UUID, trx *gorm.DB) ([]investment.Investment, error)) *MockIInvestmentRepository_GetByLoanIDLockTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExposureTx provides a mock function for the type MockIInvestmentRepository
+func (_mock *MockIInvestmentRepository) GetExposureTx(ctx context.Context, investorID uuid.UUID, loanID uuid.UUID, borrowerID uuid.UUID, trx *gorm.DB) (investment.Exposure, error) {
+	ret := _mock.Called(ctx, investorID, loanID, borrowerID, trx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExposureTx")
+	}
+
+	var r0 investment.Exposure
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *gorm.DB) (investment.Exposure, error)); ok {
+		return returnFunc(ctx, investorID, loanID, borrowerID, trx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *gorm.DB) investment.Exposure); ok {
+		r0 = returnFunc(ctx, investorID, loanID, borrowerID, trx)
+	} else {
+		r0 = ret.Get(0).(investment.Exposure)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, *gorm.DB) error); ok {
+		r1 = returnFunc(ctx, investorID, loanID, borrowerID, trx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIInvestmentRepository_GetExposureTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExposureTx'
+type MockIInvestmentRepository_GetExposureTx_Call struct {
+	*mock.Call
+}
+
+// GetExposureTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - investorID uuid.UUID
+//   - loanID uuid.UUID
+//   - borrowerID uuid.UUID
+//   - trx *gorm.DB
+func (_e *MockIInvestmentRepository_Expecter) GetExposureTx(ctx interface{}, investorID interface{}, loanID interface{}, borrowerID interface{}, trx interface{}) *MockIInvestmentRepository_GetExposureTx_Call {
+	return &MockIInvestmentRepository_GetExposureTx_Call{Call: _e.mock.On("GetExposureTx", ctx, investorID, loanID, borrowerID, trx)}
+}
+
+func (_c *MockIInvestmentRepository_GetExposureTx_Call) Run(run func(ctx context.Context, investorID uuid.UUID, loanID uuid.UUID, borrowerID uuid.UUID, trx *gorm.DB)) *MockIInvestmentRepository_GetExposureTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(uuid.UUID)
+		}
+		var arg4 *gorm.DB
+		if args[4] != nil {
+			arg4 = args[4].(*gorm.DB)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIInvestmentRepository_GetExposureTx_Call) Return(exposure investment.Exposure, err error) *MockIInvestmentRepository_GetExposureTx_Call {
+	_c.Call.Return(exposure, err)
+	return _c
+}
+
+func (_c *MockIInvestmentRepository_GetExposureTx_Call) RunAndReturn(run func(ctx context.Context, investorID uuid.UUID, loanID uuid.UUID, borrowerID uuid.UUID, trx *gorm.DB) (investment.Exposure, error)) *MockIInvestmentRepository_GetExposureTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTotalInvestmentByLoanID provides a mock function for the type MockIInvestmentRepository
-func (_mock *MockIInvestmentRepository) GetTotalInvestmentByLoanID(ctx context.Context, loanID uuid.UUID) (float64, error) {
+func (_mock *MockIInvestmentRepository) GetTotalInvestmentByLoanID(ctx context.Context, loanID uuid.UUID) (money.Money, error) {
 	ret := _mock.Called(ctx, loanID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTotalInvestmentByLoanID")
 	}
 
-	var r0 float64
+	var r0 money.Money
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (float64, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (money.Money, error)); ok {
 		return returnFunc(ctx, loanID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) float64); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) money.Money); ok {
 		r0 = returnFunc(ctx, loanID)
 	} else {
-		r0 = ret.Get(0).(float64)
+		r0 = ret.Get(0).(money.Money)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
 		r1 = returnFunc(ctx, loanID)
@@ -1043,12 +1270,80 @@ func (_c *MockIInvestmentRepository_GetTotalInvestmentByLoanID_Call) Run(run fun
 	return _c
 }
 
-func (_c *MockIInvestmentRepository_GetTotalInvestmentByLoanID_Call) Return(f float64, err error) *MockIInvestmentRepository_GetTotalInvestmentByLoanID_Call {
-	_c.Call.Return(f, err)
+func (_c *MockIInvestmentRepository_GetTotalInvestmentByLoanID_Call) Return(money1 money.Money, err error) *MockIInvestmentRepository_GetTotalInvestmentByLoanID_Call {
+	_c.Call.Return(money1, err)
+	return _c
+}
+
+func (_c *MockIInvestmentRepository_GetTotalInvestmentByLoanID_Call) RunAndReturn(run func(ctx context.Context, loanID uuid.UUID) (money.Money, error)) *MockIInvestmentRepository_GetTotalInvestmentByLoanID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTotalInvestmentByLoanIDs provides a mock function for the type MockIInvestmentRepository
+func (_mock *MockIInvestmentRepository) GetTotalInvestmentByLoanIDs(ctx context.Context, loanIDs []uuid.UUID) (map[uuid.UUID]money.Money, error) {
+	ret := _mock.Called(ctx, loanIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalInvestmentByLoanIDs")
+	}
+
+	var r0 map[uuid.UUID]money.Money
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]money.Money, error)); ok {
+		return returnFunc(ctx, loanIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]money.Money); ok {
+		r0 = returnFunc(ctx, loanIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]money.Money)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, loanIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIInvestmentRepository_GetTotalInvestmentByLoanIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTotalInvestmentByLoanIDs'
+type MockIInvestmentRepository_GetTotalInvestmentByLoanIDs_Call struct {
+	*mock.Call
+}
+
+// GetTotalInvestmentByLoanIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanIDs []uuid.UUID
+func (_e *MockIInvestmentRepository_Expecter) GetTotalInvestmentByLoanIDs(ctx interface{}, loanIDs interface{}) *MockIInvestmentRepository_GetTotalInvestmentByLoanIDs_Call {
+	return &MockIInvestmentRepository_GetTotalInvestmentByLoanIDs_Call{Call: _e.mock.On("GetTotalInvestmentByLoanIDs", ctx, loanIDs)}
+}
+
+func (_c *MockIInvestmentRepository_GetTotalInvestmentByLoanIDs_Call) Run(run func(ctx context.Context, loanIDs []uuid.UUID)) *MockIInvestmentRepository_GetTotalInvestmentByLoanIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIInvestmentRepository_GetTotalInvestmentByLoanIDs_Call) Return(moneyMap map[uuid.UUID]money.Money, err error) *MockIInvestmentRepository_GetTotalInvestmentByLoanIDs_Call {
+	_c.Call.Return(moneyMap, err)
 	return _c
 }
 
-func (_c *MockIInvestmentRepository_GetTotalInvestmentByLoanID_Call) RunAndReturn(run func(ctx context.Context, loanID uuid.UUID) (float64, error)) *MockIInvestmentRepository_GetTotalInvestmentByLoanID_Call {
+func (_c *MockIInvestmentRepository_GetTotalInvestmentByLoanIDs_Call) RunAndReturn(run func(ctx context.Context, loanIDs []uuid.UUID) (map[uuid.UUID]money.Money, error)) *MockIInvestmentRepository_GetTotalInvestmentByLoanIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2,13 +2,15 @@ package investor
 
 import (
 	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
 )
 
 type Investor struct {
 	model.BaseModel
-	FullName string  `json:"full_name"`
-	Email    string  `json:"email"`
-	Balance  float64 `json:"balance"`
+	FullName     string      `json:"full_name"`
+	Email        string      `json:"email"`
+	Balance      money.Money `json:"balance"`
+	PasswordHash string      `json:"-"`
 }
 
 func (Investor) TableName() string {
@@ -1,9 +1,12 @@
 package investor
 
 import (
+	"context"
+
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
 )
 
 type IInvestorRepository interface {
 	repository.IBaseRepo[Investor]
+	GetByEmail(ctx context.Context, email string) (Investor, error)
 }
@@ -781,6 +781,72 @@ func (_c *MockIInvestorRepository_GetAll_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// GetByEmail provides a mock function for the type MockIInvestorRepository
+func (_mock *MockIInvestorRepository) GetByEmail(ctx context.Context, email string) (investor.Investor, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByEmail")
+	}
+
+	var r0 investor.Investor
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (investor.Investor, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) investor.Investor); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Get(0).(investor.Investor)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIInvestorRepository_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
+type MockIInvestorRepository_GetByEmail_Call struct {
+	*mock.Call
+}
+
+// GetByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockIInvestorRepository_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockIInvestorRepository_GetByEmail_Call {
+	return &MockIInvestorRepository_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+}
+
+func (_c *MockIInvestorRepository_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockIInvestorRepository_GetByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIInvestorRepository_GetByEmail_Call) Return(investor1 investor.Investor, err error) *MockIInvestorRepository_GetByEmail_Call {
+	_c.Call.Return(investor1, err)
+	return _c
+}
+
+func (_c *MockIInvestorRepository_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (investor.Investor, error)) *MockIInvestorRepository_GetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function for the type MockIInvestorRepository
 func (_mock *MockIInvestorRepository) GetByID(ctx context.Context, ID uuid.UUID) (investor.Investor, error) {
 	ret := _mock.Called(ctx, ID)
@@ -0,0 +1,9 @@
+package ledger
+
+import (
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+)
+
+type IJournalEntryRepository interface {
+	repository.IBaseRepo[JournalEntry]
+}
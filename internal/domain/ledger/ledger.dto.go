@@ -0,0 +1,25 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/google/uuid"
+)
+
+type InvestorLedgerResponse struct {
+	ID             uuid.UUID     `json:"id"`
+	JournalEntryID uuid.UUID     `json:"journal_entry_id"`
+	ReferenceType  ReferenceType `json:"reference_type"`
+	ReferenceID    uuid.UUID     `json:"reference_id"`
+	Description    string        `json:"description"`
+	Direction      Direction     `json:"direction"`
+	Amount         money.Money   `json:"amount"`
+	CreatedAt      *time.Time    `json:"created_at"`
+}
+
+type InvestorLedgerPagination struct {
+	Data    []InvestorLedgerResponse `json:"data"`
+	HasNext bool                     `json:"has_next"`
+	HasPrev bool                     `json:"has_prev"`
+}
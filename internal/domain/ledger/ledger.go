@@ -0,0 +1,87 @@
+package ledger
+
+import (
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/google/uuid"
+)
+
+type Account string
+
+const (
+	// AccountSettlementCash is the platform's cash held at the bank.
+	AccountSettlementCash Account = "settlement_cash"
+	// AccountInvestorCash is the spendable balance the platform owes an investor, owned by the investor.
+	AccountInvestorCash Account = "investor_cash"
+	// AccountLoanFunding is the money investors have committed to a loan, owned by the loan.
+	AccountLoanFunding Account = "loan_funding"
+	// AccountBorrowerReceivable is the principal a borrower still owes, owned by the loan.
+	AccountBorrowerReceivable Account = "borrower_receivable"
+	// AccountPlatformFee is the platform's revenue from fees and interest spread.
+	AccountPlatformFee Account = "platform_fee"
+	// AccountWithdrawalPending holds investor cash requested for withdrawal until the payout settles, owned by the withdrawal.
+	AccountWithdrawalPending Account = "withdrawal_pending"
+)
+
+type Direction string
+
+const (
+	DirectionDebit  Direction = "debit"
+	DirectionCredit Direction = "credit"
+)
+
+type ReferenceType string
+
+const (
+	ReferenceOpeningBalance ReferenceType = "opening_balance"
+	ReferenceInvestment     ReferenceType = "investment"
+	ReferenceDisbursement   ReferenceType = "disbursement"
+	ReferenceRepayment      ReferenceType = "repayment"
+	ReferenceTopup          ReferenceType = "topup"
+	ReferenceWithdrawal     ReferenceType = "withdrawal"
+	ReferenceLoanExpiry     ReferenceType = "loan_expiry"
+	ReferenceCancellation   ReferenceType = "investment_cancellation"
+	ReferenceTransfer       ReferenceType = "investment_transfer"
+)
+
+type JournalEntry struct {
+	model.BaseModel
+	ReferenceType ReferenceType `json:"reference_type"`
+	ReferenceID   uuid.UUID     `json:"reference_id"`
+	Description   string        `json:"description"`
+	Postings      []Posting     `json:"postings" gorm:"-"`
+}
+
+func (JournalEntry) TableName() string {
+	return "journal_entries"
+}
+
+type Posting struct {
+	model.BaseModel
+	JournalEntryID uuid.UUID   `json:"journal_entry_id"`
+	Account        Account     `json:"account"`
+	OwnerID        *uuid.UUID  `json:"owner_id"`
+	Direction      Direction   `json:"direction"`
+	Amount         money.Money `json:"amount"`
+}
+
+func (Posting) TableName() string {
+	return "postings"
+}
+
+func Debit(account Account, ownerID *uuid.UUID, amount money.Money) Posting {
+	return Posting{Account: account, OwnerID: ownerID, Direction: DirectionDebit, Amount: amount}
+}
+
+func Credit(account Account, ownerID *uuid.UUID, amount money.Money) Posting {
+	return Posting{Account: account, OwnerID: ownerID, Direction: DirectionCredit, Amount: amount}
+}
+
+// SignedAmount returns the amount as seen by a credit-normal account such as investor cash.
+func (p Posting) SignedAmount() money.Money {
+	if p.Direction == DirectionDebit {
+		return -p.Amount
+	}
+
+	return p.Amount
+}
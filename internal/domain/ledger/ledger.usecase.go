@@ -0,0 +1,13 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ILedgerUsecase interface {
+	PostWithTx(ctx context.Context, entry JournalEntry, trx *gorm.DB) (JournalEntry, error)
+	ListInvestorLedger(ctx context.Context, investorID uuid.UUID, page int, limit int) (InvestorLedgerPagination, error)
+}
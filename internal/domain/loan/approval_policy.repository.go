@@ -0,0 +1,13 @@
+package loan
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+)
+
+type IApprovalPolicyRepository interface {
+	repository.IBaseRepo[ApprovalPolicy]
+	GetByAmount(ctx context.Context, amount money.Money) (ApprovalPolicy, error)
+}
@@ -0,0 +1,15 @@
+package loan
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type IApprovalVoteRepository interface {
+	repository.IBaseRepo[ApprovalVote]
+	GetByLoanID(ctx context.Context, loanID uuid.UUID) ([]ApprovalVote, error)
+	GetByLoanIDWithTx(ctx context.Context, loanID uuid.UUID, trx *gorm.DB) ([]ApprovalVote, error)
+}
@@ -0,0 +1,87 @@
+package loan
+
+import (
+	"slices"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ApprovalPolicy applies to loans whose principal is above MinAmount. A loan is approved once RequiredApprovals
+// distinct employees holding one of RequiredRoles have voted, each vote recorded by a different employee; an empty
+// RequiredRoles accepts any approver.
+type ApprovalPolicy struct {
+	model.BaseModel
+	MinAmount         money.Money    `json:"min_amount"`
+	RequiredApprovals int            `json:"required_approvals"`
+	RequiredRoles     pq.StringArray `json:"required_roles" gorm:"type:text[]"`
+}
+
+func (ApprovalPolicy) TableName() string {
+	return "loan_approval_policies"
+}
+
+// ApprovalVote is one approval of a proposed loan. RecordedBy differs from ValidatorEmployeeID when the vote was
+// recorded on behalf of the validator.
+type ApprovalVote struct {
+	model.BaseModel
+	LoanID               uuid.UUID `json:"loan_id"`
+	ValidatorEmployeeID  uuid.UUID `json:"validator_employee_id"`
+	VisitProofPictureURL string    `json:"visit_proof_picture_url"`
+	RecordedBy           uuid.UUID `json:"recorded_by"`
+}
+
+func (ApprovalVote) TableName() string {
+	return "loan_approval_votes"
+}
+
+type ApprovalProgress struct {
+	RequiredApprovals int            `json:"required_approvals"`
+	RequiredRoles     []string       `json:"required_roles"`
+	Approvals         int            `json:"approvals"`
+	Votes             []ApprovalVote `json:"votes"`
+}
+
+// NewApprovalProgress counts the votes against the policy. Loans that match no policy, given as the zero value,
+// need a single approval from any approver.
+func NewApprovalProgress(policy ApprovalPolicy, votes []ApprovalVote) ApprovalProgress {
+	requiredApprovals := max(policy.RequiredApprovals, 1)
+
+	return ApprovalProgress{
+		RequiredApprovals: requiredApprovals,
+		RequiredRoles:     append([]string{}, policy.RequiredRoles...),
+		Approvals:         len(votes),
+		Votes:             votes,
+	}
+}
+
+func (p ApprovalProgress) Complete() bool {
+	return p.Approvals >= p.RequiredApprovals
+}
+
+func (p ApprovalProgress) HasVoted(validatorEmployeeID uuid.UUID) bool {
+	return slices.ContainsFunc(p.Votes, func(vote ApprovalVote) bool {
+		return vote.ValidatorEmployeeID == validatorEmployeeID
+	})
+}
+
+// HasRecorded reports whether the employee already recorded a vote, for themselves or on behalf of a validator. An
+// employee records at most one vote per loan, so one supervisor cannot supply every approval by naming validators.
+func (p ApprovalProgress) HasRecorded(employeeID uuid.UUID) bool {
+	return slices.ContainsFunc(p.Votes, func(vote ApprovalVote) bool {
+		return vote.RecordedBy == employeeID
+	})
+}
+
+// AcceptsRoles reports whether an approver holding the given roles may vote
+func (p ApprovalProgress) AcceptsRoles(roleNames []string) bool {
+	if len(p.RequiredRoles) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(roleNames, func(name string) bool {
+		return slices.Contains(p.RequiredRoles, name)
+	})
+}
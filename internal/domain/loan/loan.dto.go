@@ -3,15 +3,54 @@ package loan
 import (
 	"time"
 
+	"github.com/BagusAK95/amarta_test/internal/domain/common/amortization"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
 	"github.com/google/uuid"
 )
 
+// CreateLoanRequest proposes a loan. Rate and ROI are taken from the pricing table when omitted, and interest is flat
+// unless another amortization method is given.
 type CreateLoanRequest struct {
-	BorrowerID         uuid.UUID `json:"borrower_id" validate:"required"`
-	PrincipalAmount    float64   `json:"principal_amount" validate:"required,min=1"`
-	Rate               float32   `json:"rate" validate:"required,min=0"`
-	ROI                float32   `json:"roi" validate:"required,min=0"`
-	AgreementLetterURL string    `json:"agreement_letter_url" validate:"required,url"`
+	BorrowerID         uuid.UUID           `json:"borrower_id" validate:"required"`
+	PrincipalAmount    money.Money         `json:"principal_amount" validate:"required,min=1"`
+	Rate               *float32            `json:"rate" validate:"omitempty,gt=0"`
+	ROI                *float32            `json:"roi" validate:"omitempty,gt=0"`
+	Tenor              int                 `json:"tenor" validate:"required,min=1"`
+	RepaymentFrequency RepaymentFrequency  `json:"repayment_frequency" validate:"required,oneof=weekly biweekly monthly"`
+	AmortizationMethod amortization.Method `json:"amortization_method" validate:"omitempty,oneof=flat annuity declining_balance"`
+	AgreementLetterURL string              `json:"agreement_letter_url" validate:"required,url"`
+}
+
+// QuoteLoanRequest previews the pricing of a loan without proposing it
+type QuoteLoanRequest struct {
+	BorrowerID         uuid.UUID           `json:"borrower_id" validate:"required"`
+	PrincipalAmount    money.Money         `json:"principal_amount" validate:"required,min=1"`
+	Rate               *float32            `json:"rate" validate:"omitempty,gt=0"`
+	ROI                *float32            `json:"roi" validate:"omitempty,gt=0"`
+	Tenor              int                 `json:"tenor" validate:"required,min=1"`
+	RepaymentFrequency RepaymentFrequency  `json:"repayment_frequency" validate:"required,oneof=weekly biweekly monthly"`
+	AmortizationMethod amortization.Method `json:"amortization_method" validate:"omitempty,oneof=flat annuity declining_balance"`
+}
+
+type LoanQuoteResponse struct {
+	BorrowerID         uuid.UUID           `json:"borrower_id"`
+	PrincipalAmount    money.Money         `json:"principal_amount"`
+	Tenor              int                 `json:"tenor"`
+	RepaymentFrequency RepaymentFrequency  `json:"repayment_frequency"`
+	AmortizationMethod amortization.Method `json:"amortization_method"`
+	CreditScore        int                 `json:"credit_score"`
+	RiskGrade          string              `json:"risk_grade"`
+	ScorecardVersion   int                 `json:"scorecard_version"`
+	Rate               float32             `json:"rate"`
+	ROI                float32             `json:"roi"`
+	SuggestedRate      float32             `json:"suggested_rate"`
+	SuggestedROI       float32             `json:"suggested_roi"`
+	Spread             float32             `json:"spread"`
+	TotalInterest      money.Money         `json:"total_interest"`
+	InvestorReturn     money.Money         `json:"investor_return"`
+	PlatformRevenue    money.Money         `json:"platform_revenue"`
+	APR                float64             `json:"apr"`
+	EIR                float64             `json:"eir"`
 }
 
 type RejectLoanRequest struct {
@@ -24,10 +63,20 @@ type ApproveLoanRequest struct {
 }
 
 type LoanAgreementResponse struct {
-	LoanID          uuid.UUID
-	PrincipalAmount float64
-	InterestRate    float32
-	BorrowerName    string
+	LoanID             uuid.UUID
+	PrincipalAmount    money.Money
+	InterestRate       float32
+	Tenor              int
+	RepaymentFrequency RepaymentFrequency
+	AmortizationMethod amortization.Method
+	BorrowerName       string
+	RiskGrade          *string
+	CreditScore        *int
+	TotalInterest      money.Money
+	TotalRepayment     money.Money
+	APR                float64
+	EIR                float64
+	Schedule           []amortization.Installment
 }
 
 type DisburseLoanRequest struct {
@@ -35,3 +84,16 @@ type DisburseLoanRequest struct {
 	OfficerEmployeeID  uuid.UUID `json:"officer_employee_id" validate:"required"`
 	DisbursementDate   time.Time `json:"disbursement_date" validate:"required"`
 }
+
+// ListOpenLoanFilter narrows the approved loans shown to investors. Nil bounds are not applied.
+type ListOpenLoanFilter struct {
+	MinROI    *float32
+	MaxROI    *float32
+	MinAmount *money.Money
+	MaxAmount *money.Money
+}
+
+// LoanApprovedEvent is published on the loan bus once a loan is approved and open for funding
+type LoanApprovedEvent struct {
+	LoanID uuid.UUID
+}
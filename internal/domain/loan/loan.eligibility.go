@@ -0,0 +1,40 @@
+package loan
+
+import (
+	"database/sql/driver"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+)
+
+// EligibilityCheck is the outcome of one eligibility rule, kept on the loan to explain why it could be proposed
+type EligibilityCheck struct {
+	Rule   string `json:"rule"`
+	Passed bool   `json:"passed"`
+	Reason string `json:"reason"`
+}
+
+type EligibilityChecks []EligibilityCheck
+
+func (c EligibilityChecks) Value() (driver.Value, error) {
+	return repository.Value(c)
+}
+
+func (c *EligibilityChecks) Scan(value any) error {
+	return repository.Scan(c, value)
+}
+
+func (c EligibilityChecks) Passed() bool {
+	return len(c.Failures()) == 0
+}
+
+// Failures returns the reasons of the failed checks as "<rule>: <reason>"
+func (c EligibilityChecks) Failures() []string {
+	failures := []string{}
+	for _, check := range c {
+		if !check.Passed {
+			failures = append(failures, check.Rule+": "+check.Reason)
+		}
+	}
+
+	return failures
+}
@@ -3,27 +3,71 @@ package loan
 import (
 	"time"
 
+	"github.com/BagusAK95/amarta_test/internal/domain/common/amortization"
 	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
 	"github.com/google/uuid"
 )
 
 type Loan struct {
 	model.BaseModel
 	BorrowerID          uuid.UUID           `json:"borrower_id"`
-	PrincipalAmount     float64             `json:"principal_amount"`
+	BranchID            uuid.UUID           `json:"branch_id"`
+	PrincipalAmount     money.Money         `json:"principal_amount"`
 	Rate                float32             `json:"rate"`
 	ROI                 float32             `json:"roi"`
+	Tenor               int                 `json:"tenor"`
+	RepaymentFrequency  RepaymentFrequency  `json:"repayment_frequency"`
+	AmortizationMethod  amortization.Method `json:"amortization_method"`
 	State               State               `json:"state"`
 	AgreementLetterURL  string              `json:"agreement_letter_url"`
 	ApprovalDetails     ApprovalDetails     `json:"approval_details" gorm:"embedded"`
 	DisbursementDetails DisbursementDetails `json:"disbursement_details" gorm:"embedded"`
 	RejectReason        *string             `json:"reject_reason"`
+	ProposedBy          *uuid.UUID          `json:"proposed_by"`
+	RejectedBy          *uuid.UUID          `json:"rejected_by"`
+	GroupLoanID         *uuid.UUID          `json:"group_loan_id"`
+	EligibilityChecks   EligibilityChecks   `json:"eligibility_checks" gorm:"type:jsonb"`
+	RiskAssessment      RiskAssessment      `json:"risk_assessment" gorm:"embedded"`
+	ApprovalProgress    *ApprovalProgress   `json:"approval_progress,omitempty" gorm:"-"`
 }
 
 func (Loan) TableName() string {
 	return "loans"
 }
 
+// AmortizationTerms returns the terms the repayment schedule of the loan is built from. Loans without a method are
+// flat, as every loan was before other methods were offered.
+func (l Loan) AmortizationTerms() amortization.Terms {
+	method := l.AmortizationMethod
+	if method == "" {
+		method = amortization.MethodFlat
+	}
+
+	return amortization.Terms{
+		Principal:      l.PrincipalAmount,
+		AnnualRate:     float64(l.Rate),
+		Periods:        l.Tenor,
+		PeriodsPerYear: l.RepaymentFrequency.PeriodsPerYear(),
+		Method:         method,
+	}
+}
+
+// StateHistory records one state change of a loan and who made it
+type StateHistory struct {
+	model.BaseModel
+	LoanID    uuid.UUID  `json:"loan_id"`
+	FromState State      `json:"from_state"`
+	ToState   State      `json:"to_state"`
+	ActorType ActorType  `json:"actor_type"`
+	ActorID   *uuid.UUID `json:"actor_id"`
+	Reason    *string    `json:"reason"`
+}
+
+func (StateHistory) TableName() string {
+	return "loan_state_history"
+}
+
 type State string
 
 const (
@@ -32,16 +76,52 @@ const (
 	StateRejected  State = "rejected"
 	StateInvested  State = "invested"
 	StateDisbursed State = "disbursed"
+	StatePaidOff   State = "paid_off"
+	StateExpired   State = "expired"
 )
 
+type RepaymentFrequency string
+
+const (
+	FrequencyWeekly   RepaymentFrequency = "weekly"
+	FrequencyBiweekly RepaymentFrequency = "biweekly"
+	FrequencyMonthly  RepaymentFrequency = "monthly"
+)
+
+// PeriodsPerYear returns how many installments of this frequency fall in a year
+func (f RepaymentFrequency) PeriodsPerYear() int {
+	switch f {
+	case FrequencyWeekly:
+		return 52
+	case FrequencyBiweekly:
+		return 26
+	default:
+		return 12
+	}
+}
+
+// DueDate returns the due date of the n-th installment counted from start
+func (f RepaymentFrequency) DueDate(start time.Time, n int) time.Time {
+	switch f {
+	case FrequencyWeekly:
+		return start.AddDate(0, 0, 7*n)
+	case FrequencyBiweekly:
+		return start.AddDate(0, 0, 14*n)
+	default:
+		return start.AddDate(0, n, 0)
+	}
+}
+
 type ApprovalDetails struct {
 	ValidatorEmployeeID  *string    `json:"validator_employee_id"`
 	VisitProofPictureURL *string    `json:"visit_proof_picture_url"`
 	ApprovalDate         *time.Time `json:"approval_date"`
+	ApprovedBy           *uuid.UUID `json:"approved_by"`
 }
 
 type DisbursementDetails struct {
 	OfficerEmployeeID  *string    `json:"officer_employee_id"`
 	SignedAgreementURL *string    `json:"signed_agreement_url"`
 	DisbursementDate   *time.Time `json:"disbursement_date"`
+	DisbursedBy        *uuid.UUID `json:"disbursed_by"`
 }
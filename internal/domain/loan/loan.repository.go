@@ -1,9 +1,22 @@
 package loan
 
 import (
+	"context"
+	"time"
+
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type ILoanRepository interface {
 	repository.IBaseRepo[Loan]
+	GetApprovedBefore(ctx context.Context, approvedBefore time.Time) ([]Loan, error)
+	PaginationOpen(ctx context.Context, filter ListOpenLoanFilter, page int, limit int) (repository.Pagination[Loan], error)
+	TransitionWithTx(ctx context.Context, loanID uuid.UUID, change StateChange, data map[string]any, trx *gorm.DB) (Loan, error)
+	GetStateHistory(ctx context.Context, loanID uuid.UUID) ([]StateHistory, error)
+	GetStateHistoryByLoanIDs(ctx context.Context, loanIDs []uuid.UUID, toState State) ([]StateHistory, error)
+	GetByGroupLoanIDs(ctx context.Context, groupLoanIDs []uuid.UUID) ([]Loan, error)
+	GetByGroupLoanIDLockTx(ctx context.Context, groupLoanID uuid.UUID, trx *gorm.DB) ([]Loan, error)
+	GetByBorrowerID(ctx context.Context, borrowerID uuid.UUID) ([]Loan, error)
 }
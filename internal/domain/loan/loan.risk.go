@@ -0,0 +1,22 @@
+package loan
+
+import "time"
+
+// RiskAssessment is the credit score of the loan when it was last scored, at proposal and again at approval. Loans
+// proposed before scoring existed have none.
+type RiskAssessment struct {
+	CreditScore      *int       `json:"credit_score"`
+	RiskGrade        *string    `json:"risk_grade"`
+	ScorecardVersion *int       `json:"scorecard_version"`
+	ScoredAt         *time.Time `json:"scored_at"`
+}
+
+// Columns returns the loan columns that store the assessment
+func (a RiskAssessment) Columns() map[string]any {
+	return map[string]any{
+		"credit_score":      a.CreditScore,
+		"risk_grade":        a.RiskGrade,
+		"scorecard_version": a.ScorecardVersion,
+		"scored_at":         a.ScoredAt,
+	}
+}
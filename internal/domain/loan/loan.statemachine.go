@@ -0,0 +1,149 @@
+package loan
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/google/uuid"
+)
+
+type Event string
+
+const (
+	EventApprove  Event = "approve"
+	EventReject   Event = "reject"
+	EventFund     Event = "fund"
+	EventDisburse Event = "disburse"
+	EventPayOff   Event = "pay_off"
+	EventExpire   Event = "expire"
+)
+
+type ActorType string
+
+const (
+	ActorEmployee ActorType = "employee"
+	ActorInvestor ActorType = "investor"
+	ActorSystem   ActorType = "system"
+)
+
+// Actor is who caused a state change. System changes, such as expiry, have no ID.
+type Actor struct {
+	Type ActorType
+	ID   *uuid.UUID
+}
+
+func EmployeeActor(employeeID uuid.UUID) Actor {
+	return Actor{Type: ActorEmployee, ID: &employeeID}
+}
+
+func InvestorActor(investorID uuid.UUID) Actor {
+	return Actor{Type: ActorInvestor, ID: &investorID}
+}
+
+func SystemActor() Actor {
+	return Actor{Type: ActorSystem}
+}
+
+// TransitionInput is what the guards check besides the loan itself
+type TransitionInput struct {
+	Actor       Actor
+	Reason      string
+	Invested    money.Money // total invested in the loan, checked when it is funded
+	Outstanding money.Money // amount still due, checked when it is paid off
+}
+
+// StateChange is an allowed transition, ready to be persisted together with its history row
+type StateChange struct {
+	From   State
+	To     State
+	Actor  Actor
+	Reason string
+}
+
+type guard func(l Loan, input TransitionInput) error
+
+type transition struct {
+	from   State
+	to     State
+	guards []guard
+}
+
+// transitions lists every allowed state change. A loan moves through them in order, branching off to rejected or
+// expired before it is funded.
+var transitions = map[Event]transition{
+	EventApprove:  {from: StateProposed, to: StateApproved, guards: []guard{requireActor(ActorEmployee)}},
+	EventReject:   {from: StateProposed, to: StateRejected, guards: []guard{requireActor(ActorEmployee), requireReason}},
+	EventFund:     {from: StateApproved, to: StateInvested, guards: []guard{requireActor(ActorInvestor), requireFullyInvested}},
+	EventDisburse: {from: StateInvested, to: StateDisbursed, guards: []guard{requireActor(ActorEmployee)}},
+	EventPayOff:   {from: StateDisbursed, to: StatePaidOff, guards: []guard{requireNothingOutstanding}},
+	EventExpire:   {from: StateApproved, to: StateExpired, guards: []guard{requireActor(ActorSystem), requireApprovalDate}},
+}
+
+// Transition checks that the event is allowed from the loan's current state and that its guards pass, and returns the
+// resulting state change. The error messages are meant for the caller of the API.
+func (l Loan) Transition(event Event, input TransitionInput) (StateChange, error) {
+	t, ok := transitions[event]
+	if !ok {
+		return StateChange{}, fmt.Errorf("unknown loan event %q", event)
+	}
+
+	if l.State != t.from {
+		return StateChange{}, fmt.Errorf("loan is not in %s state", t.from)
+	}
+
+	for _, g := range t.guards {
+		if err := g(l, input); err != nil {
+			return StateChange{}, err
+		}
+	}
+
+	return StateChange{
+		From:   t.from,
+		To:     t.to,
+		Actor:  input.Actor,
+		Reason: input.Reason,
+	}, nil
+}
+
+func requireActor(actorType ActorType) guard {
+	return func(_ Loan, input TransitionInput) error {
+		if input.Actor.Type != actorType || (actorType != ActorSystem && input.Actor.ID == nil) {
+			return fmt.Errorf("loan can only be moved to this state by %s", actorType)
+		}
+
+		return nil
+	}
+}
+
+func requireReason(_ Loan, input TransitionInput) error {
+	if input.Reason == "" {
+		return errors.New("reason is required")
+	}
+
+	return nil
+}
+
+func requireFullyInvested(l Loan, input TransitionInput) error {
+	if input.Invested != l.PrincipalAmount {
+		return errors.New("loan is not fully invested")
+	}
+
+	return nil
+}
+
+func requireNothingOutstanding(_ Loan, input TransitionInput) error {
+	if input.Outstanding != 0 {
+		return errors.New("loan still has an outstanding balance")
+	}
+
+	return nil
+}
+
+func requireApprovalDate(l Loan, _ TransitionInput) error {
+	if l.ApprovalDetails.ApprovalDate == nil {
+		return errors.New("loan has no approval date")
+	}
+
+	return nil
+}
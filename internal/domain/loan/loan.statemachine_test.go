@@ -0,0 +1,83 @@
+package loan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransition(t *testing.T) {
+	employeeID := uuid.New()
+	investorID := uuid.New()
+	approvalDate := time.Now()
+
+	t.Run("allowed transitions", func(t *testing.T) {
+		for _, tc := range []struct {
+			state loan.State
+			event loan.Event
+			input loan.TransitionInput
+			to    loan.State
+		}{
+			{loan.StateProposed, loan.EventApprove, loan.TransitionInput{Actor: loan.EmployeeActor(employeeID)}, loan.StateApproved},
+			{loan.StateProposed, loan.EventReject, loan.TransitionInput{Actor: loan.EmployeeActor(employeeID), Reason: "incomplete documents"}, loan.StateRejected},
+			{loan.StateApproved, loan.EventFund, loan.TransitionInput{Actor: loan.InvestorActor(investorID), Invested: 1000}, loan.StateInvested},
+			{loan.StateInvested, loan.EventDisburse, loan.TransitionInput{Actor: loan.EmployeeActor(employeeID)}, loan.StateDisbursed},
+			{loan.StateDisbursed, loan.EventPayOff, loan.TransitionInput{Actor: loan.SystemActor()}, loan.StatePaidOff},
+			{loan.StateApproved, loan.EventExpire, loan.TransitionInput{Actor: loan.SystemActor()}, loan.StateExpired},
+		} {
+			l := loan.Loan{State: tc.state, PrincipalAmount: 1000}
+			l.ApprovalDetails.ApprovalDate = &approvalDate
+
+			change, err := l.Transition(tc.event, tc.input)
+			assert.NoError(t, err, tc.event)
+			assert.Equal(t, tc.state, change.From, tc.event)
+			assert.Equal(t, tc.to, change.To, tc.event)
+			assert.Equal(t, tc.input.Actor, change.Actor, tc.event)
+		}
+	})
+
+	t.Run("wrong state", func(t *testing.T) {
+		for _, tc := range []struct {
+			state   loan.State
+			event   loan.Event
+			message string
+		}{
+			{loan.StateApproved, loan.EventApprove, "loan is not in proposed state"},
+			{loan.StateRejected, loan.EventReject, "loan is not in proposed state"},
+			{loan.StateProposed, loan.EventFund, "loan is not in approved state"},
+			{loan.StateApproved, loan.EventDisburse, "loan is not in invested state"},
+			{loan.StateInvested, loan.EventPayOff, "loan is not in disbursed state"},
+			{loan.StateInvested, loan.EventExpire, "loan is not in approved state"},
+		} {
+			_, err := loan.Loan{State: tc.state}.Transition(tc.event, loan.TransitionInput{Actor: loan.SystemActor()})
+			assert.EqualError(t, err, tc.message, tc.event)
+		}
+	})
+
+	t.Run("guards", func(t *testing.T) {
+		l := loan.Loan{State: loan.StateProposed, PrincipalAmount: 1000}
+
+		_, err := l.Transition(loan.EventApprove, loan.TransitionInput{Actor: loan.SystemActor()})
+		assert.EqualError(t, err, "loan can only be moved to this state by employee")
+
+		_, err = l.Transition(loan.EventReject, loan.TransitionInput{Actor: loan.SystemActor(), Reason: "incomplete documents"})
+		assert.EqualError(t, err, "loan can only be moved to this state by employee")
+
+		_, err = l.Transition(loan.EventReject, loan.TransitionInput{Actor: loan.EmployeeActor(employeeID)})
+		assert.EqualError(t, err, "reason is required")
+
+		l.State = loan.StateApproved
+		_, err = l.Transition(loan.EventFund, loan.TransitionInput{Actor: loan.InvestorActor(investorID), Invested: 999})
+		assert.EqualError(t, err, "loan is not fully invested")
+
+		_, err = l.Transition(loan.EventExpire, loan.TransitionInput{Actor: loan.SystemActor()})
+		assert.EqualError(t, err, "loan has no approval date")
+
+		l.State = loan.StateDisbursed
+		_, err = l.Transition(loan.EventPayOff, loan.TransitionInput{Outstanding: 1})
+		assert.EqualError(t, err, "loan still has an outstanding balance")
+	})
+}
@@ -4,15 +4,23 @@ import (
 	"context"
 
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type ILoanUsecase interface {
-	CreateLoan(ctx context.Context, req CreateLoanRequest) (*Loan, error)
-	RejectLoan(ctx context.Context, loanID uuid.UUID, rejectReason string) (*Loan, error)
-	ApproveLoan(ctx context.Context, loanID uuid.UUID, req ApproveLoanRequest) (*Loan, error)
-	DisburseLoan(ctx context.Context, loanID uuid.UUID, req DisburseLoanRequest) (*Loan, error)
+	CreateLoan(ctx context.Context, employeeID uuid.UUID, req CreateLoanRequest) (*Loan, error)
+	QuoteLoan(ctx context.Context, req QuoteLoanRequest) (*LoanQuoteResponse, error)
+	RejectLoan(ctx context.Context, loanID uuid.UUID, employeeID uuid.UUID, rejectReason string) (*Loan, error)
+	ApproveLoan(ctx context.Context, loanID uuid.UUID, employeeID uuid.UUID, req ApproveLoanRequest) (*Loan, error)
+	DisburseLoan(ctx context.Context, loanID uuid.UUID, employeeID uuid.UUID, req DisburseLoanRequest) (*Loan, error)
+	RejectLoansWithTx(ctx context.Context, loans []Loan, employeeID uuid.UUID, rejectReason string, trx *gorm.DB) ([]Loan, error)
+	ApproveLoansWithTx(ctx context.Context, loans []Loan, employeeID uuid.UUID, req ApproveLoanRequest, trx *gorm.DB) ([]Loan, bool, error)
+	DisburseLoansWithTx(ctx context.Context, loans []Loan, employeeID uuid.UUID, req DisburseLoanRequest, trx *gorm.DB) ([]Loan, error)
 	ListLoan(ctx context.Context, state *string, page int, limit int) (repository.Pagination[Loan], error)
 	DetailLoan(ctx context.Context, loanID uuid.UUID) (*Loan, error)
 	GetLoanAgreementDetail(ctx context.Context, loanID uuid.UUID) (*LoanAgreementResponse, error)
+	GetLoanSchedule(ctx context.Context, loanID uuid.UUID) ([]installment.Installment, error)
+	GetLoanHistory(ctx context.Context, loanID uuid.UUID) ([]StateHistory, error)
 }
@@ -6,6 +6,7 @@ package loan
 
 import (
 	"context"
+	"time"
 
 	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
 	"github.com/BagusAK95/amarta_test/internal/domain/loan"
@@ -781,6 +782,284 @@ func (_c *MockILoanRepository_GetAll_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// GetApprovedBefore provides a mock function for the type MockILoanRepository
+func (_mock *MockILoanRepository) GetApprovedBefore(ctx context.Context, approvedBefore time.Time) ([]loan.Loan, error) {
+	ret := _mock.Called(ctx, approvedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApprovedBefore")
+	}
+
+	var r0 []loan.Loan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]loan.Loan, error)); ok {
+		return returnFunc(ctx, approvedBefore)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []loan.Loan); ok {
+		r0 = returnFunc(ctx, approvedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]loan.Loan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, approvedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILoanRepository_GetApprovedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApprovedBefore'
+type MockILoanRepository_GetApprovedBefore_Call struct {
+	*mock.Call
+}
+
+// GetApprovedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - approvedBefore time.Time
+func (_e *MockILoanRepository_Expecter) GetApprovedBefore(ctx interface{}, approvedBefore interface{}) *MockILoanRepository_GetApprovedBefore_Call {
+	return &MockILoanRepository_GetApprovedBefore_Call{Call: _e.mock.On("GetApprovedBefore", ctx, approvedBefore)}
+}
+
+func (_c *MockILoanRepository_GetApprovedBefore_Call) Run(run func(ctx context.Context, approvedBefore time.Time)) *MockILoanRepository_GetApprovedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoanRepository_GetApprovedBefore_Call) Return(loans []loan.Loan, err error) *MockILoanRepository_GetApprovedBefore_Call {
+	_c.Call.Return(loans, err)
+	return _c
+}
+
+func (_c *MockILoanRepository_GetApprovedBefore_Call) RunAndReturn(run func(ctx context.Context, approvedBefore time.Time) ([]loan.Loan, error)) *MockILoanRepository_GetApprovedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByBorrowerID provides a mock function for the type MockILoanRepository
+func (_mock *MockILoanRepository) GetByBorrowerID(ctx context.Context, borrowerID uuid.UUID) ([]loan.Loan, error) {
+	ret := _mock.Called(ctx, borrowerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByBorrowerID")
+	}
+
+	var r0 []loan.Loan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]loan.Loan, error)); ok {
+		return returnFunc(ctx, borrowerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []loan.Loan); ok {
+		r0 = returnFunc(ctx, borrowerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]loan.Loan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, borrowerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILoanRepository_GetByBorrowerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByBorrowerID'
+type MockILoanRepository_GetByBorrowerID_Call struct {
+	*mock.Call
+}
+
+// GetByBorrowerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - borrowerID uuid.UUID
+func (_e *MockILoanRepository_Expecter) GetByBorrowerID(ctx interface{}, borrowerID interface{}) *MockILoanRepository_GetByBorrowerID_Call {
+	return &MockILoanRepository_GetByBorrowerID_Call{Call: _e.mock.On("GetByBorrowerID", ctx, borrowerID)}
+}
+
+func (_c *MockILoanRepository_GetByBorrowerID_Call) Run(run func(ctx context.Context, borrowerID uuid.UUID)) *MockILoanRepository_GetByBorrowerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoanRepository_GetByBorrowerID_Call) Return(loans []loan.Loan, err error) *MockILoanRepository_GetByBorrowerID_Call {
+	_c.Call.Return(loans, err)
+	return _c
+}
+
+func (_c *MockILoanRepository_GetByBorrowerID_Call) RunAndReturn(run func(ctx context.Context, borrowerID uuid.UUID) ([]loan.Loan, error)) *MockILoanRepository_GetByBorrowerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByGroupLoanIDLockTx provides a mock function for the type MockILoanRepository
+func (_mock *MockILoanRepository) GetByGroupLoanIDLockTx(ctx context.Context, groupLoanID uuid.UUID, trx *gorm.DB) ([]loan.Loan, error) {
+	ret := _mock.Called(ctx, groupLoanID, trx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByGroupLoanIDLockTx")
+	}
+
+	var r0 []loan.Loan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *gorm.DB) ([]loan.Loan, error)); ok {
+		return returnFunc(ctx, groupLoanID, trx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *gorm.DB) []loan.Loan); ok {
+		r0 = returnFunc(ctx, groupLoanID, trx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]loan.Loan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *gorm.DB) error); ok {
+		r1 = returnFunc(ctx, groupLoanID, trx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILoanRepository_GetByGroupLoanIDLockTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByGroupLoanIDLockTx'
+type MockILoanRepository_GetByGroupLoanIDLockTx_Call struct {
+	*mock.Call
+}
+
+// GetByGroupLoanIDLockTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupLoanID uuid.UUID
+//   - trx *gorm.DB
+func (_e *MockILoanRepository_Expecter) GetByGroupLoanIDLockTx(ctx interface{}, groupLoanID interface{}, trx interface{}) *MockILoanRepository_GetByGroupLoanIDLockTx_Call {
+	return &MockILoanRepository_GetByGroupLoanIDLockTx_Call{Call: _e.mock.On("GetByGroupLoanIDLockTx", ctx, groupLoanID, trx)}
+}
+
+func (_c *MockILoanRepository_GetByGroupLoanIDLockTx_Call) Run(run func(ctx context.Context, groupLoanID uuid.UUID, trx *gorm.DB)) *MockILoanRepository_GetByGroupLoanIDLockTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *gorm.DB
+		if args[2] != nil {
+			arg2 = args[2].(*gorm.DB)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoanRepository_GetByGroupLoanIDLockTx_Call) Return(loans []loan.Loan, err error) *MockILoanRepository_GetByGroupLoanIDLockTx_Call {
+	_c.Call.Return(loans, err)
+	return _c
+}
+
+func (_c *MockILoanRepository_GetByGroupLoanIDLockTx_Call) RunAndReturn(run func(ctx context.Context, groupLoanID uuid.UUID, trx *gorm.DB) ([]loan.Loan, error)) *MockILoanRepository_GetByGroupLoanIDLockTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByGroupLoanIDs provides a mock function for the type MockILoanRepository
+func (_mock *MockILoanRepository) GetByGroupLoanIDs(ctx context.Context, groupLoanIDs []uuid.UUID) ([]loan.Loan, error) {
+	ret := _mock.Called(ctx, groupLoanIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByGroupLoanIDs")
+	}
+
+	var r0 []loan.Loan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]loan.Loan, error)); ok {
+		return returnFunc(ctx, groupLoanIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []loan.Loan); ok {
+		r0 = returnFunc(ctx, groupLoanIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]loan.Loan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, groupLoanIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILoanRepository_GetByGroupLoanIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByGroupLoanIDs'
+type MockILoanRepository_GetByGroupLoanIDs_Call struct {
+	*mock.Call
+}
+
+// GetByGroupLoanIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupLoanIDs []uuid.UUID
+func (_e *MockILoanRepository_Expecter) GetByGroupLoanIDs(ctx interface{}, groupLoanIDs interface{}) *MockILoanRepository_GetByGroupLoanIDs_Call {
+	return &MockILoanRepository_GetByGroupLoanIDs_Call{Call: _e.mock.On("GetByGroupLoanIDs", ctx, groupLoanIDs)}
+}
+
+func (_c *MockILoanRepository_GetByGroupLoanIDs_Call) Run(run func(ctx context.Context, groupLoanIDs []uuid.UUID)) *MockILoanRepository_GetByGroupLoanIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoanRepository_GetByGroupLoanIDs_Call) Return(loans []loan.Loan, err error) *MockILoanRepository_GetByGroupLoanIDs_Call {
+	_c.Call.Return(loans, err)
+	return _c
+}
+
+func (_c *MockILoanRepository_GetByGroupLoanIDs_Call) RunAndReturn(run func(ctx context.Context, groupLoanIDs []uuid.UUID) ([]loan.Loan, error)) *MockILoanRepository_GetByGroupLoanIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function for the type MockILoanRepository
 func (_mock *MockILoanRepository) GetByID(ctx context.Context, ID uuid.UUID) (loan.Loan, error) {
 	ret := _mock.Called(ctx, ID)
@@ -987,6 +1266,148 @@ func (_c *MockILoanRepository_GetByIDs_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// GetStateHistory provides a mock function for the type MockILoanRepository
+func (_mock *MockILoanRepository) GetStateHistory(ctx context.Context, loanID uuid.UUID) ([]loan.StateHistory, error) {
+	ret := _mock.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStateHistory")
+	}
+
+	var r0 []loan.StateHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]loan.StateHistory, error)); ok {
+		return returnFunc(ctx, loanID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []loan.StateHistory); ok {
+		r0 = returnFunc(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]loan.StateHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILoanRepository_GetStateHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStateHistory'
+type MockILoanRepository_GetStateHistory_Call struct {
+	*mock.Call
+}
+
+// GetStateHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uuid.UUID
+func (_e *MockILoanRepository_Expecter) GetStateHistory(ctx interface{}, loanID interface{}) *MockILoanRepository_GetStateHistory_Call {
+	return &MockILoanRepository_GetStateHistory_Call{Call: _e.mock.On("GetStateHistory", ctx, loanID)}
+}
+
+func (_c *MockILoanRepository_GetStateHistory_Call) Run(run func(ctx context.Context, loanID uuid.UUID)) *MockILoanRepository_GetStateHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoanRepository_GetStateHistory_Call) Return(stateHistorys []loan.StateHistory, err error) *MockILoanRepository_GetStateHistory_Call {
+	_c.Call.Return(stateHistorys, err)
+	return _c
+}
+
+func (_c *MockILoanRepository_GetStateHistory_Call) RunAndReturn(run func(ctx context.Context, loanID uuid.UUID) ([]loan.StateHistory, error)) *MockILoanRepository_GetStateHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStateHistoryByLoanIDs provides a mock function for the type MockILoanRepository
+func (_mock *MockILoanRepository) GetStateHistoryByLoanIDs(ctx context.Context, loanIDs []uuid.UUID, toState loan.State) ([]loan.StateHistory, error) {
+	ret := _mock.Called(ctx, loanIDs, toState)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStateHistoryByLoanIDs")
+	}
+
+	var r0 []loan.StateHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, loan.State) ([]loan.StateHistory, error)); ok {
+		return returnFunc(ctx, loanIDs, toState)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, loan.State) []loan.StateHistory); ok {
+		r0 = returnFunc(ctx, loanIDs, toState)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]loan.StateHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, loan.State) error); ok {
+		r1 = returnFunc(ctx, loanIDs, toState)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILoanRepository_GetStateHistoryByLoanIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStateHistoryByLoanIDs'
+type MockILoanRepository_GetStateHistoryByLoanIDs_Call struct {
+	*mock.Call
+}
+
+// GetStateHistoryByLoanIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanIDs []uuid.UUID
+//   - toState loan.State
+func (_e *MockILoanRepository_Expecter) GetStateHistoryByLoanIDs(ctx interface{}, loanIDs interface{}, toState interface{}) *MockILoanRepository_GetStateHistoryByLoanIDs_Call {
+	return &MockILoanRepository_GetStateHistoryByLoanIDs_Call{Call: _e.mock.On("GetStateHistoryByLoanIDs", ctx, loanIDs, toState)}
+}
+
+func (_c *MockILoanRepository_GetStateHistoryByLoanIDs_Call) Run(run func(ctx context.Context, loanIDs []uuid.UUID, toState loan.State)) *MockILoanRepository_GetStateHistoryByLoanIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		var arg2 loan.State
+		if args[2] != nil {
+			arg2 = args[2].(loan.State)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoanRepository_GetStateHistoryByLoanIDs_Call) Return(stateHistorys []loan.StateHistory, err error) *MockILoanRepository_GetStateHistoryByLoanIDs_Call {
+	_c.Call.Return(stateHistorys, err)
+	return _c
+}
+
+func (_c *MockILoanRepository_GetStateHistoryByLoanIDs_Call) RunAndReturn(run func(ctx context.Context, loanIDs []uuid.UUID, toState loan.State) ([]loan.StateHistory, error)) *MockILoanRepository_GetStateHistoryByLoanIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Pagination provides a mock function for the type MockILoanRepository
 func (_mock *MockILoanRepository) Pagination(ctx context.Context, filter map[string]any, page int, limit int) (repository.Pagination[loan.Loan], error) {
 	ret := _mock.Called(ctx, filter, page, limit)
@@ -1065,6 +1486,84 @@ func (_c *MockILoanRepository_Pagination_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// PaginationOpen provides a mock function for the type MockILoanRepository
+func (_mock *MockILoanRepository) PaginationOpen(ctx context.Context, filter loan.ListOpenLoanFilter, page int, limit int) (repository.Pagination[loan.Loan], error) {
+	ret := _mock.Called(ctx, filter, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaginationOpen")
+	}
+
+	var r0 repository.Pagination[loan.Loan]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, loan.ListOpenLoanFilter, int, int) (repository.Pagination[loan.Loan], error)); ok {
+		return returnFunc(ctx, filter, page, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, loan.ListOpenLoanFilter, int, int) repository.Pagination[loan.Loan]); ok {
+		r0 = returnFunc(ctx, filter, page, limit)
+	} else {
+		r0 = ret.Get(0).(repository.Pagination[loan.Loan])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, loan.ListOpenLoanFilter, int, int) error); ok {
+		r1 = returnFunc(ctx, filter, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILoanRepository_PaginationOpen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PaginationOpen'
+type MockILoanRepository_PaginationOpen_Call struct {
+	*mock.Call
+}
+
+// PaginationOpen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter loan.ListOpenLoanFilter
+//   - page int
+//   - limit int
+func (_e *MockILoanRepository_Expecter) PaginationOpen(ctx interface{}, filter interface{}, page interface{}, limit interface{}) *MockILoanRepository_PaginationOpen_Call {
+	return &MockILoanRepository_PaginationOpen_Call{Call: _e.mock.On("PaginationOpen", ctx, filter, page, limit)}
+}
+
+func (_c *MockILoanRepository_PaginationOpen_Call) Run(run func(ctx context.Context, filter loan.ListOpenLoanFilter, page int, limit int)) *MockILoanRepository_PaginationOpen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 loan.ListOpenLoanFilter
+		if args[1] != nil {
+			arg1 = args[1].(loan.ListOpenLoanFilter)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoanRepository_PaginationOpen_Call) Return(pagination repository.Pagination[loan.Loan], err error) *MockILoanRepository_PaginationOpen_Call {
+	_c.Call.Return(pagination, err)
+	return _c
+}
+
+func (_c *MockILoanRepository_PaginationOpen_Call) RunAndReturn(run func(ctx context.Context, filter loan.ListOpenLoanFilter, page int, limit int) (repository.Pagination[loan.Loan], error)) *MockILoanRepository_PaginationOpen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Rollback provides a mock function for the type MockILoanRepository
 func (_mock *MockILoanRepository) Rollback(trx *gorm.DB) *gorm.DB {
 	ret := _mock.Called(trx)
@@ -1118,6 +1617,90 @@ func (_c *MockILoanRepository_Rollback_Call) RunAndReturn(run func(trx *gorm.DB)
 	return _c
 }
 
+// TransitionWithTx provides a mock function for the type MockILoanRepository
+func (_mock *MockILoanRepository) TransitionWithTx(ctx context.Context, loanID uuid.UUID, change loan.StateChange, data map[string]any, trx *gorm.DB) (loan.Loan, error) {
+	ret := _mock.Called(ctx, loanID, change, data, trx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionWithTx")
+	}
+
+	var r0 loan.Loan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, loan.StateChange, map[string]any, *gorm.DB) (loan.Loan, error)); ok {
+		return returnFunc(ctx, loanID, change, data, trx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, loan.StateChange, map[string]any, *gorm.DB) loan.Loan); ok {
+		r0 = returnFunc(ctx, loanID, change, data, trx)
+	} else {
+		r0 = ret.Get(0).(loan.Loan)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, loan.StateChange, map[string]any, *gorm.DB) error); ok {
+		r1 = returnFunc(ctx, loanID, change, data, trx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILoanRepository_TransitionWithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionWithTx'
+type MockILoanRepository_TransitionWithTx_Call struct {
+	*mock.Call
+}
+
+// TransitionWithTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uuid.UUID
+//   - change loan.StateChange
+//   - data map[string]any
+//   - trx *gorm.DB
+func (_e *MockILoanRepository_Expecter) TransitionWithTx(ctx interface{}, loanID interface{}, change interface{}, data interface{}, trx interface{}) *MockILoanRepository_TransitionWithTx_Call {
+	return &MockILoanRepository_TransitionWithTx_Call{Call: _e.mock.On("TransitionWithTx", ctx, loanID, change, data, trx)}
+}
+
+func (_c *MockILoanRepository_TransitionWithTx_Call) Run(run func(ctx context.Context, loanID uuid.UUID, change loan.StateChange, data map[string]any, trx *gorm.DB)) *MockILoanRepository_TransitionWithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 loan.StateChange
+		if args[2] != nil {
+			arg2 = args[2].(loan.StateChange)
+		}
+		var arg3 map[string]any
+		if args[3] != nil {
+			arg3 = args[3].(map[string]any)
+		}
+		var arg4 *gorm.DB
+		if args[4] != nil {
+			arg4 = args[4].(*gorm.DB)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoanRepository_TransitionWithTx_Call) Return(loan1 loan.Loan, err error) *MockILoanRepository_TransitionWithTx_Call {
+	_c.Call.Return(loan1, err)
+	return _c
+}
+
+func (_c *MockILoanRepository_TransitionWithTx_Call) RunAndReturn(run func(ctx context.Context, loanID uuid.UUID, change loan.StateChange, data map[string]any, trx *gorm.DB) (loan.Loan, error)) *MockILoanRepository_TransitionWithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockILoanRepository
 func (_mock *MockILoanRepository) Update(ctx context.Context, ID uuid.UUID, model loan.Loan) (loan.Loan, error) {
 	ret := _mock.Called(ctx, ID, model)
@@ -0,0 +1,45 @@
+package marketplace
+
+import (
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/google/uuid"
+)
+
+type ListLoanRequest struct {
+	MinROI    *float32     `form:"min_roi" validate:"omitempty,min=0"`
+	MaxROI    *float32     `form:"max_roi" validate:"omitempty,min=0"`
+	MinAmount *money.Money `form:"min_amount" validate:"omitempty,min=0"`
+	MaxAmount *money.Money `form:"max_amount" validate:"omitempty,min=0"`
+}
+
+// BorrowerProfile is the anonymised view of a borrower shown to investors. It must never carry contact or identity
+// details such as the ID card number, phone number, email or address.
+type BorrowerProfile struct {
+	Initials    string     `json:"initials"`
+	Status      string     `json:"status"`
+	MemberSince *time.Time `json:"member_since"`
+}
+
+type LoanResponse struct {
+	LoanID             uuid.UUID               `json:"loan_id"`
+	PrincipalAmount    money.Money             `json:"principal_amount"`
+	FundedAmount       money.Money             `json:"funded_amount"`
+	RemainingAmount    money.Money             `json:"remaining_amount"`
+	Rate               float32                 `json:"rate"`
+	ROI                float32                 `json:"roi"`
+	Tenor              int                     `json:"tenor"`
+	RepaymentFrequency loan.RepaymentFrequency `json:"repayment_frequency"`
+	ApprovalDate       *time.Time              `json:"approval_date"`
+	RiskGrade          *string                 `json:"risk_grade"`
+	CreditScore        *int                    `json:"credit_score"`
+	Borrower           BorrowerProfile         `json:"borrower"`
+}
+
+type LoanPagination struct {
+	Data    []LoanResponse `json:"data"`
+	HasNext bool           `json:"has_next"`
+	HasPrev bool           `json:"has_prev"`
+}
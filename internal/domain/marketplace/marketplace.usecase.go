@@ -0,0 +1,12 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type IMarketplaceUsecase interface {
+	ListLoan(ctx context.Context, req ListLoanRequest, page int, limit int) (LoanPagination, error)
+	DetailLoan(ctx context.Context, loanID uuid.UUID) (*LoanResponse, error)
+}
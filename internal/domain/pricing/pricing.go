@@ -0,0 +1,141 @@
+package pricing
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/amortization"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+)
+
+// Tier is the price of loans of one risk grade whose tenor, in months, is between MinTenorMonths and MaxTenorMonths
+type Tier struct {
+	model.BaseModel
+	RiskGrade      scoring.Grade `json:"risk_grade"`
+	MinTenorMonths int           `json:"min_tenor_months"`
+	MaxTenorMonths int           `json:"max_tenor_months"`
+	Rate           float32       `json:"rate"`
+	ROI            float32       `json:"roi"`
+}
+
+func (Tier) TableName() string {
+	return "pricing_tiers"
+}
+
+// Terms are the annual borrower rate and investor ROI of a loan, both in percent
+type Terms struct {
+	Rate float32 `json:"rate"`
+	ROI  float32 `json:"roi"`
+}
+
+// Spread is what the platform keeps of the borrower rate, in percentage points
+func (t Terms) Spread() float32 {
+	return t.Rate - t.ROI
+}
+
+// Policy bounds the terms an officer may set on a loan
+type Policy struct {
+	// MinSpread is the smallest spread the platform accepts, in percentage points
+	MinSpread float32
+	// MaxDeviation is how far the rate and ROI may move from the tier, in percentage points. 0 enforces the tier.
+	MaxDeviation float32
+}
+
+// Input is the loan being priced. A nil Rate or ROI is taken from the tier, and an empty AmortizationMethod is flat.
+type Input struct {
+	PrincipalAmount    money.Money
+	Tenor              int
+	RepaymentFrequency loan.RepaymentFrequency
+	AmortizationMethod amortization.Method
+	Rate               *float32
+	ROI                *float32
+}
+
+// Quote is the price of a loan and what its interest pays to investors and the platform over the whole tenor
+type Quote struct {
+	Terms
+	RiskGrade       scoring.Grade `json:"risk_grade"`
+	Suggested       Terms         `json:"suggested"`
+	Spread          float32       `json:"spread"`
+	TotalInterest   money.Money   `json:"total_interest"`
+	InvestorReturn  money.Money   `json:"investor_return"`
+	PlatformRevenue money.Money   `json:"platform_revenue"`
+	APR             float64       `json:"apr"`
+	EIR             float64       `json:"eir"`
+}
+
+// TenorMonths returns the length of a loan in whole months, rounded up
+func TenorMonths(tenor int, f loan.RepaymentFrequency) int {
+	return int(math.Ceil(float64(tenor) * 12 / float64(f.PeriodsPerYear())))
+}
+
+// Resolve returns the terms of a loan priced with the tier, along with every reason the policy refuses them
+func (p Policy) Resolve(tier Tier, input Input) (Terms, []string) {
+	suggested := Terms{Rate: tier.Rate, ROI: tier.ROI}
+	terms := suggested
+	if input.Rate != nil {
+		terms.Rate = *input.Rate
+	}
+	if input.ROI != nil {
+		terms.ROI = *input.ROI
+	}
+
+	failures := []string{}
+	if deviation(terms.Rate, suggested.Rate) > p.MaxDeviation {
+		failures = append(failures, fmt.Sprintf("rate %v is more than %v points from %v for grade %s", terms.Rate, p.MaxDeviation, suggested.Rate, tier.RiskGrade))
+	}
+	if deviation(terms.ROI, suggested.ROI) > p.MaxDeviation {
+		failures = append(failures, fmt.Sprintf("roi %v is more than %v points from %v for grade %s", terms.ROI, p.MaxDeviation, suggested.ROI, tier.RiskGrade))
+	}
+	if terms.ROI >= terms.Rate {
+		failures = append(failures, fmt.Sprintf("roi %v must be below the rate %v", terms.ROI, terms.Rate))
+	} else if terms.Spread() < p.MinSpread {
+		failures = append(failures, fmt.Sprintf("spread %v is below the minimum of %v points", terms.Spread(), p.MinSpread))
+	}
+
+	return terms, failures
+}
+
+// NewQuote prices the input at the given terms. Interest is that of the repayment schedule the loan would get, and
+// investors receive ROI/Rate of it.
+func NewQuote(tier Tier, terms Terms, input Input) (Quote, error) {
+	schedule, err := amortization.New(input.loan(terms).AmortizationTerms())
+	if err != nil {
+		return Quote{}, err
+	}
+
+	totalInterest := schedule.TotalInterest()
+	investorReturn := money.Money(0)
+	if terms.Rate > 0 {
+		investorReturn = money.Floor(float64(totalInterest) * float64(terms.ROI) / float64(terms.Rate))
+	}
+
+	return Quote{
+		Terms:           terms,
+		RiskGrade:       tier.RiskGrade,
+		Suggested:       Terms{Rate: tier.Rate, ROI: tier.ROI},
+		Spread:          terms.Spread(),
+		TotalInterest:   totalInterest,
+		InvestorReturn:  investorReturn,
+		PlatformRevenue: totalInterest - investorReturn,
+		APR:             schedule.APR(),
+		EIR:             schedule.EIR(),
+	}, nil
+}
+
+func (i Input) loan(terms Terms) loan.Loan {
+	return loan.Loan{
+		PrincipalAmount:    i.PrincipalAmount,
+		Rate:               terms.Rate,
+		Tenor:              i.Tenor,
+		RepaymentFrequency: i.RepaymentFrequency,
+		AmortizationMethod: i.AmortizationMethod,
+	}
+}
+
+func deviation(a, b float32) float32 {
+	return float32(math.Abs(float64(a - b)))
+}
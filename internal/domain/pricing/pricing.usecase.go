@@ -0,0 +1,11 @@
+package pricing
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+)
+
+type IPricingUsecase interface {
+	Price(ctx context.Context, grade scoring.Grade, input Input) (Quote, error)
+}
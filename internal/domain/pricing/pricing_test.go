@@ -0,0 +1,115 @@
+package pricing_test
+
+import (
+	"testing"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/amortization"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/pricing"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	"github.com/stretchr/testify/assert"
+)
+
+var tier = pricing.Tier{RiskGrade: scoring.GradeB, MinTenorMonths: 7, MaxTenorMonths: 12, Rate: 20, ROI: 16}
+
+func ptr(v float32) *float32 {
+	return &v
+}
+
+func TestTenorMonths(t *testing.T) {
+	assert.Equal(t, 6, pricing.TenorMonths(25, loan.FrequencyWeekly))
+	assert.Equal(t, 12, pricing.TenorMonths(26, loan.FrequencyBiweekly))
+	assert.Equal(t, 12, pricing.TenorMonths(12, loan.FrequencyMonthly))
+}
+
+func TestPolicyResolve(t *testing.T) {
+	policy := pricing.Policy{MinSpread: 2, MaxDeviation: 2}
+
+	t.Run("terms taken from the tier", func(t *testing.T) {
+		terms, failures := policy.Resolve(tier, pricing.Input{})
+
+		assert.Equal(t, pricing.Terms{Rate: 20, ROI: 16}, terms)
+		assert.Empty(t, failures)
+		assert.Equal(t, float32(4), terms.Spread())
+	})
+
+	t.Run("rate chosen within the deviation", func(t *testing.T) {
+		terms, failures := policy.Resolve(tier, pricing.Input{Rate: ptr(21.5)})
+
+		assert.Equal(t, pricing.Terms{Rate: 21.5, ROI: 16}, terms)
+		assert.Empty(t, failures)
+	})
+
+	t.Run("rate and roi too far from the tier", func(t *testing.T) {
+		_, failures := policy.Resolve(tier, pricing.Input{Rate: ptr(23), ROI: ptr(13)})
+
+		assert.Equal(t, []string{
+			"rate 23 is more than 2 points from 20 for grade B",
+			"roi 13 is more than 2 points from 16 for grade B",
+		}, failures)
+	})
+
+	t.Run("spread below the minimum", func(t *testing.T) {
+		_, failures := policy.Resolve(tier, pricing.Input{Rate: ptr(19), ROI: ptr(18)})
+
+		assert.Equal(t, []string{"spread 1 is below the minimum of 2 points"}, failures)
+	})
+
+	t.Run("roi not below the rate", func(t *testing.T) {
+		loose := pricing.Policy{MaxDeviation: 5}
+
+		_, failures := loose.Resolve(tier, pricing.Input{Rate: ptr(18), ROI: ptr(18)})
+
+		assert.Equal(t, []string{"roi 18 must be below the rate 18"}, failures)
+	})
+
+	t.Run("zero deviation enforces the tier", func(t *testing.T) {
+		strict := pricing.Policy{MinSpread: 2}
+
+		_, failures := strict.Resolve(tier, pricing.Input{Rate: ptr(20.5)})
+
+		assert.Equal(t, []string{"rate 20.5 is more than 0 points from 20 for grade B"}, failures)
+	})
+}
+
+func TestNewQuote(t *testing.T) {
+	input := pricing.Input{PrincipalAmount: 12_000_000, Tenor: 12, RepaymentFrequency: loan.FrequencyMonthly}
+
+	t.Run("flat", func(t *testing.T) {
+		quote, err := pricing.NewQuote(tier, pricing.Terms{Rate: 24, ROI: 18}, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, pricing.Terms{Rate: 24, ROI: 18}, quote.Terms)
+		assert.Equal(t, scoring.GradeB, quote.RiskGrade)
+		assert.Equal(t, pricing.Terms{Rate: 20, ROI: 16}, quote.Suggested)
+		assert.Equal(t, float32(6), quote.Spread)
+		assert.Equal(t, 2_880_000, int(quote.TotalInterest))
+		assert.Equal(t, 2_160_000, int(quote.InvestorReturn))
+		assert.Equal(t, 720_000, int(quote.PlatformRevenue))
+		assert.InDelta(t, 41.70, quote.APR, 0.01)
+		assert.InDelta(t, 50.67, quote.EIR, 0.01)
+	})
+
+	t.Run("annuity", func(t *testing.T) {
+		annuity := input
+		annuity.AmortizationMethod = amortization.MethodAnnuity
+
+		quote, err := pricing.NewQuote(tier, pricing.Terms{Rate: 24, ROI: 18}, annuity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1_616_583, int(quote.TotalInterest))
+		assert.Equal(t, 1_212_437, int(quote.InvestorReturn))
+		assert.Equal(t, 404_146, int(quote.PlatformRevenue))
+		assert.InDelta(t, 24, quote.APR, 0.01)
+		assert.InDelta(t, 26.82, quote.EIR, 0.01)
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		balloon := input
+		balloon.AmortizationMethod = "balloon"
+
+		_, err := pricing.NewQuote(tier, pricing.Terms{Rate: 24, ROI: 18}, balloon)
+
+		assert.Error(t, err)
+	})
+}
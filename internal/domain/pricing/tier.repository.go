@@ -0,0 +1,13 @@
+package pricing
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+)
+
+type ITierRepository interface {
+	repository.IBaseRepo[Tier]
+	GetByGradeAndTenor(ctx context.Context, grade scoring.Grade, tenorMonths int) (Tier, error)
+}
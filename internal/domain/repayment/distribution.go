@@ -0,0 +1,28 @@
+package repayment
+
+import (
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/google/uuid"
+)
+
+type Distribution struct {
+	model.BaseModel
+	RepaymentID     uuid.UUID   `json:"repayment_id"`
+	LoanID          uuid.UUID   `json:"loan_id"`
+	InvestmentID    uuid.UUID   `json:"investment_id"`
+	InvestorID      uuid.UUID   `json:"investor_id"`
+	PrincipalAmount money.Money `json:"principal_amount"`
+	ReturnAmount    money.Money `json:"return_amount"`
+}
+
+func (Distribution) TableName() string {
+	return "repayment_distributions"
+}
+
+// InvestmentTotal sums the distributions paid out to one investment.
+type InvestmentTotal struct {
+	InvestmentID    uuid.UUID   `json:"investment_id"`
+	PrincipalAmount money.Money `json:"principal_amount"`
+	ReturnAmount    money.Money `json:"return_amount"`
+}
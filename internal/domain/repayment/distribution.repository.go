@@ -0,0 +1,13 @@
+package repayment
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+)
+
+type IDistributionRepository interface {
+	repository.IBaseRepo[Distribution]
+	GetTotalsByInvestmentIDs(ctx context.Context, investmentIDs []uuid.UUID) ([]InvestmentTotal, error)
+}
@@ -0,0 +1,12 @@
+package repayment
+
+import (
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+)
+
+type CreateRepaymentRequest struct {
+	Amount      money.Money `json:"amount" validate:"required,min=1"`
+	PaymentDate time.Time   `json:"payment_date" validate:"required"`
+}
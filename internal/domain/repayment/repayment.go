@@ -0,0 +1,23 @@
+package repayment
+
+import (
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/google/uuid"
+)
+
+type Repayment struct {
+	model.BaseModel
+	LoanID          uuid.UUID   `json:"loan_id"`
+	Amount          money.Money `json:"amount"`
+	FeeAmount       money.Money `json:"fee_amount"`
+	InterestAmount  money.Money `json:"interest_amount"`
+	PrincipalAmount money.Money `json:"principal_amount"`
+	PaymentDate     time.Time   `json:"payment_date"`
+}
+
+func (Repayment) TableName() string {
+	return "repayments"
+}
@@ -0,0 +1,9 @@
+package repayment
+
+import (
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+)
+
+type IRepaymentRepository interface {
+	repository.IBaseRepo[Repayment]
+}
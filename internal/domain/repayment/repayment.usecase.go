@@ -0,0 +1,11 @@
+package repayment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type IRepaymentUsecase interface {
+	CreateRepayment(ctx context.Context, loanID uuid.UUID, employeeID uuid.UUID, req CreateRepaymentRequest) (*Repayment, error)
+}
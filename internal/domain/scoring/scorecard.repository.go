@@ -0,0 +1,12 @@
+package scoring
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+)
+
+type IScorecardRepository interface {
+	repository.IBaseRepo[Scorecard]
+	GetActive(ctx context.Context) (Scorecard, error)
+}
@@ -0,0 +1,207 @@
+package scoring
+
+import (
+	"database/sql/driver"
+	"math"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+)
+
+type Grade string
+
+const (
+	GradeA Grade = "A"
+	GradeB Grade = "B"
+	GradeC Grade = "C"
+	GradeD Grade = "D"
+	GradeE Grade = "E"
+)
+
+// Factor names, also the keys of the scorecard weights
+const (
+	FactorRepaymentHistory = "repayment_history"
+	FactorLoanHistory      = "loan_history"
+	FactorTenure           = "tenure"
+	FactorSegment          = "segment"
+	FactorLoanSize         = "loan_size"
+)
+
+const (
+	// neutralScore is given for repayment performance to borrowers who have not had an installment due yet
+	neutralScore = 50
+	// paidOffLoansForFullScore and monthsForFullScore are where the loan history and tenure factors top out
+	paidOffLoansForFullScore = 5
+	monthsForFullScore       = 24
+)
+
+// Scorecard is one version of the scoring model. A new version is added as a new row and activated rather than
+// editing an existing one, so the version stored on a loan always tells how its score was computed.
+type Scorecard struct {
+	model.BaseModel
+	Version int `json:"version"`
+	// Weights gives the relative weight of every factor, factors without a weight do not count
+	Weights Table `json:"weights" gorm:"type:jsonb"`
+	// SegmentScores is the 0–100 score of each borrower segment, unknown segments score 0
+	SegmentScores Table `json:"segment_scores" gorm:"type:jsonb"`
+	// GradeCutoffs is the lowest score of grades A to D, anything below D is E
+	GradeCutoffs GradeCutoffs `json:"grade_cutoffs" gorm:"type:jsonb"`
+	// LoanSizeReference is the principal at which the loan size factor reaches 0
+	LoanSizeReference money.Money `json:"loan_size_reference"`
+	Active            bool        `json:"active"`
+}
+
+func (Scorecard) TableName() string {
+	return "credit_scorecards"
+}
+
+// Table maps factor names or segments to a number, stored as JSON
+type Table map[string]float64
+
+func (t Table) Value() (driver.Value, error) {
+	return repository.Value(t)
+}
+
+func (t *Table) Scan(value any) error {
+	return repository.Scan(t, value)
+}
+
+type GradeCutoffs map[Grade]int
+
+func (c GradeCutoffs) Value() (driver.Value, error) {
+	return repository.Value(c)
+}
+
+func (c *GradeCutoffs) Scan(value any) error {
+	return repository.Scan(c, value)
+}
+
+// Grade returns the best grade whose cut-off the score reaches
+func (c GradeCutoffs) Grade(score int) Grade {
+	for _, grade := range []Grade{GradeA, GradeB, GradeC, GradeD} {
+		if cutoff, ok := c[grade]; ok && score >= cutoff {
+			return grade
+		}
+	}
+
+	return GradeE
+}
+
+// Input is what the scorecard knows about a borrower and the loan being scored
+type Input struct {
+	Borrower        borrower.Borrower
+	PrincipalAmount money.Money
+	Loans           []loan.Loan               // every loan of the borrower
+	Installments    []installment.Installment // the installments of those loans
+	Now             time.Time
+}
+
+type Factor struct {
+	Name   string  `json:"name"`
+	Score  float64 `json:"score"`
+	Weight float64 `json:"weight"`
+}
+
+type Result struct {
+	Score            int      `json:"score"`
+	Grade            Grade    `json:"grade"`
+	ScorecardVersion int      `json:"scorecard_version"`
+	Factors          []Factor `json:"factors"`
+}
+
+// Assessment returns the result in the form stored on the loan
+func (r Result) Assessment(scoredAt time.Time) loan.RiskAssessment {
+	grade := string(r.Grade)
+
+	return loan.RiskAssessment{
+		CreditScore:      &r.Score,
+		RiskGrade:        &grade,
+		ScorecardVersion: &r.ScorecardVersion,
+		ScoredAt:         &scoredAt,
+	}
+}
+
+// Score rates every factor from 0 to 100 and combines them into a weighted average, also from 0 to 100
+func (s Scorecard) Score(input Input) Result {
+	factors := []Factor{
+		{Name: FactorRepaymentHistory, Score: repaymentHistoryScore(input)},
+		{Name: FactorLoanHistory, Score: loanHistoryScore(input)},
+		{Name: FactorTenure, Score: tenureScore(input)},
+		{Name: FactorSegment, Score: s.SegmentScores[input.Borrower.Segment]},
+		{Name: FactorLoanSize, Score: s.loanSizeScore(input)},
+	}
+
+	var weighted, totalWeight float64
+	for i := range factors {
+		factors[i].Weight = s.Weights[factors[i].Name]
+		weighted += factors[i].Score * factors[i].Weight
+		totalWeight += factors[i].Weight
+	}
+
+	score := 0
+	if totalWeight > 0 {
+		score = int(math.Round(weighted / totalWeight))
+	}
+
+	return Result{
+		Score:            score,
+		Grade:            s.GradeCutoffs.Grade(score),
+		ScorecardVersion: s.Version,
+		Factors:          factors,
+	}
+}
+
+// repaymentHistoryScore is the share of installments due so far that were paid on time
+func repaymentHistoryScore(input Input) float64 {
+	onTime, due := 0, 0
+	for _, i := range input.Installments {
+		switch {
+		case i.PaidAt != nil && !i.PaidAt.After(i.DueDate):
+			onTime++
+			due++
+		case i.PaidAt != nil || i.DueDate.Before(input.Now):
+			due++
+		}
+	}
+
+	if due == 0 {
+		return neutralScore
+	}
+
+	return 100 * float64(onTime) / float64(due)
+}
+
+func loanHistoryScore(input Input) float64 {
+	paidOff := 0
+	for _, l := range input.Loans {
+		if l.State == loan.StatePaidOff {
+			paidOff++
+		}
+	}
+
+	return 100 * float64(min(paidOff, paidOffLoansForFullScore)) / paidOffLoansForFullScore
+}
+
+func tenureScore(input Input) float64 {
+	if input.Borrower.CreatedAt == nil {
+		return 0
+	}
+
+	months := input.Now.Sub(*input.Borrower.CreatedAt).Hours() / 24 / 30
+	return 100 * math.Max(0, math.Min(months, monthsForFullScore)) / monthsForFullScore
+}
+
+// loanSizeScore falls from 100 for the smallest loans to 0 at the reference principal
+func (s Scorecard) loanSizeScore(input Input) float64 {
+	if s.LoanSizeReference <= 0 {
+		return 100
+	}
+
+	ratio := float64(input.PrincipalAmount) / float64(s.LoanSizeReference)
+	return 100 * (1 - math.Min(ratio, 1))
+}
@@ -0,0 +1,12 @@
+package scoring
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+)
+
+type IScoringUsecase interface {
+	Score(ctx context.Context, b borrower.Borrower, principalAmount money.Money) (Result, error)
+}
@@ -0,0 +1,158 @@
+package scoring_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/installment"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/scoring"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2025, 10, 9, 12, 0, 0, 0, time.UTC)
+
+var scorecard = scoring.Scorecard{
+	Version: 1,
+	Weights: scoring.Table{
+		scoring.FactorRepaymentHistory: 35,
+		scoring.FactorLoanHistory:      20,
+		scoring.FactorTenure:           15,
+		scoring.FactorSegment:          10,
+		scoring.FactorLoanSize:         20,
+	},
+	SegmentScores: scoring.Table{
+		borrower.SegmentMicro:  70,
+		borrower.SegmentSmall:  60,
+		borrower.SegmentMedium: 50,
+	},
+	GradeCutoffs:      scoring.GradeCutoffs{scoring.GradeA: 80, scoring.GradeB: 65, scoring.GradeC: 50, scoring.GradeD: 35},
+	LoanSizeReference: 50_000_000,
+}
+
+func factorScores(result scoring.Result) map[string]float64 {
+	scores := map[string]float64{}
+	for _, f := range result.Factors {
+		scores[f.Name] = f.Score
+	}
+
+	return scores
+}
+
+func TestScorecardScore(t *testing.T) {
+	t.Run("returning borrower", func(t *testing.T) {
+		createdAt := now.AddDate(0, 0, -360)
+		paidOnTime := now.AddDate(0, -3, -1)
+		paidLate := now.AddDate(0, 0, -5)
+		input := scoring.Input{
+			Borrower:        borrower.Borrower{BaseModel: model.BaseModel{CreatedAt: &createdAt}, Segment: borrower.SegmentSmall},
+			PrincipalAmount: 10_000_000,
+			Loans: []loan.Loan{
+				{State: loan.StatePaidOff},
+				{State: loan.StateDisbursed},
+			},
+			Installments: []installment.Installment{
+				{DueDate: now.AddDate(0, -3, 0), PaidAt: &paidOnTime},
+				{DueDate: now.AddDate(0, -2, 0), PaidAt: &paidOnTime},
+				{DueDate: now.AddDate(0, -1, 0), PaidAt: &paidOnTime},
+				{DueDate: now.AddDate(0, 0, -10), PaidAt: &paidLate},
+				{DueDate: now.AddDate(0, 1, 0)},
+			},
+			Now: now,
+		}
+
+		result := scorecard.Score(input)
+
+		assert.Equal(t, map[string]float64{
+			scoring.FactorRepaymentHistory: 75,
+			scoring.FactorLoanHistory:      20,
+			scoring.FactorTenure:           50,
+			scoring.FactorSegment:          60,
+			scoring.FactorLoanSize:         80,
+		}, factorScores(result))
+		assert.Equal(t, 60, result.Score)
+		assert.Equal(t, scoring.GradeC, result.Grade)
+		assert.Equal(t, 1, result.ScorecardVersion)
+	})
+
+	t.Run("first-time borrower with a large loan", func(t *testing.T) {
+		createdAt := now.AddDate(0, 0, -90)
+		input := scoring.Input{
+			Borrower:        borrower.Borrower{BaseModel: model.BaseModel{CreatedAt: &createdAt}, Segment: borrower.SegmentMicro},
+			PrincipalAmount: 25_000_000,
+			Now:             now,
+		}
+
+		result := scorecard.Score(input)
+
+		assert.Equal(t, map[string]float64{
+			scoring.FactorRepaymentHistory: 50,
+			scoring.FactorLoanHistory:      0,
+			scoring.FactorTenure:           12.5,
+			scoring.FactorSegment:          70,
+			scoring.FactorLoanSize:         50,
+		}, factorScores(result))
+		assert.Equal(t, 36, result.Score)
+		assert.Equal(t, scoring.GradeD, result.Grade)
+	})
+
+	t.Run("missed installments and loans above the reference", func(t *testing.T) {
+		createdAt := now.AddDate(-3, 0, 0)
+		input := scoring.Input{
+			Borrower:        borrower.Borrower{BaseModel: model.BaseModel{CreatedAt: &createdAt}, Segment: borrower.SegmentMedium},
+			PrincipalAmount: 80_000_000,
+			Installments: []installment.Installment{
+				{DueDate: now.AddDate(0, -1, 0)},
+				{DueDate: now.AddDate(0, 0, -1)},
+			},
+			Now: now,
+		}
+
+		scores := factorScores(scorecard.Score(input))
+
+		assert.Equal(t, float64(0), scores[scoring.FactorRepaymentHistory])
+		assert.Equal(t, float64(100), scores[scoring.FactorTenure])
+		assert.Equal(t, float64(0), scores[scoring.FactorLoanSize])
+	})
+
+	t.Run("factors without a weight do not count", func(t *testing.T) {
+		onlySegment := scorecard
+		onlySegment.Weights = scoring.Table{scoring.FactorSegment: 1}
+
+		result := onlySegment.Score(scoring.Input{Borrower: borrower.Borrower{Segment: borrower.SegmentMicro}, Now: now})
+
+		assert.Equal(t, 70, result.Score)
+		assert.Equal(t, scoring.GradeB, result.Grade)
+	})
+}
+
+func TestGradeCutoffsGrade(t *testing.T) {
+	cutoffs := scorecard.GradeCutoffs
+
+	assert.Equal(t, scoring.GradeA, cutoffs.Grade(100))
+	assert.Equal(t, scoring.GradeA, cutoffs.Grade(80))
+	assert.Equal(t, scoring.GradeB, cutoffs.Grade(79))
+	assert.Equal(t, scoring.GradeC, cutoffs.Grade(50))
+	assert.Equal(t, scoring.GradeD, cutoffs.Grade(35))
+	assert.Equal(t, scoring.GradeE, cutoffs.Grade(34))
+	assert.Equal(t, scoring.GradeE, scoring.GradeCutoffs{}.Grade(100))
+}
+
+func TestResultAssessment(t *testing.T) {
+	result := scoring.Result{Score: 72, Grade: scoring.GradeB, ScorecardVersion: 3}
+
+	assessment := result.Assessment(now)
+
+	assert.Equal(t, 72, *assessment.CreditScore)
+	assert.Equal(t, "B", *assessment.RiskGrade)
+	assert.Equal(t, 3, *assessment.ScorecardVersion)
+	assert.Equal(t, now, *assessment.ScoredAt)
+	assert.Equal(t, map[string]any{
+		"credit_score":      assessment.CreditScore,
+		"risk_grade":        assessment.RiskGrade,
+		"scorecard_version": assessment.ScorecardVersion,
+		"scored_at":         assessment.ScoredAt,
+	}, assessment.Columns())
+}
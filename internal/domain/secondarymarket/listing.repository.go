@@ -0,0 +1,14 @@
+package secondarymarket
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type IListingRepository interface {
+	repository.IBaseRepo[Listing]
+	GetOpenByInvestmentIDLockTx(ctx context.Context, investmentID uuid.UUID, trx *gorm.DB) (Listing, error)
+}
@@ -0,0 +1,11 @@
+package secondarymarket
+
+import (
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/google/uuid"
+)
+
+type CreateListingRequest struct {
+	InvestmentID uuid.UUID   `json:"investment_id" validate:"required"`
+	Price        money.Money `json:"price" validate:"required,min=1"`
+}
@@ -0,0 +1,48 @@
+package secondarymarket
+
+import (
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/google/uuid"
+)
+
+type ListingStatus string
+
+const (
+	ListingStatusOpen      ListingStatus = "open"
+	ListingStatusSold      ListingStatus = "sold"
+	ListingStatusCancelled ListingStatus = "cancelled"
+)
+
+// Listing offers a whole investment on a disbursed loan for sale at a fixed price.
+type Listing struct {
+	model.BaseModel
+	InvestmentID     uuid.UUID     `json:"investment_id"`
+	LoanID           uuid.UUID     `json:"loan_id"`
+	SellerInvestorID uuid.UUID     `json:"seller_investor_id"`
+	BuyerInvestorID  *uuid.UUID    `json:"buyer_investor_id"`
+	Price            money.Money   `json:"price"`
+	Status           ListingStatus `json:"status"`
+	SoldAt           *time.Time    `json:"sold_at"`
+}
+
+func (Listing) TableName() string {
+	return "investment_listings"
+}
+
+// Transfer records a change of ownership of an investment.
+type Transfer struct {
+	model.BaseModel
+	ListingID        uuid.UUID   `json:"listing_id"`
+	InvestmentID     uuid.UUID   `json:"investment_id"`
+	LoanID           uuid.UUID   `json:"loan_id"`
+	SellerInvestorID uuid.UUID   `json:"seller_investor_id"`
+	BuyerInvestorID  uuid.UUID   `json:"buyer_investor_id"`
+	Price            money.Money `json:"price"`
+}
+
+func (Transfer) TableName() string {
+	return "investment_transfers"
+}
@@ -0,0 +1,16 @@
+package secondarymarket
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+)
+
+type ISecondaryMarketUsecase interface {
+	CreateListing(ctx context.Context, investorID uuid.UUID, req CreateListingRequest) (*Listing, error)
+	CancelListing(ctx context.Context, investorID uuid.UUID, listingID uuid.UUID) (*Listing, error)
+	ListListing(ctx context.Context, page int, limit int) (repository.Pagination[Listing], error)
+	BuyListing(ctx context.Context, investorID uuid.UUID, listingID uuid.UUID) (*Transfer, error)
+	ListTransfer(ctx context.Context, investorID uuid.UUID, page int, limit int) (repository.Pagination[Transfer], error)
+}
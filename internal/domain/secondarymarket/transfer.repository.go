@@ -0,0 +1,13 @@
+package secondarymarket
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"github.com/google/uuid"
+)
+
+type ITransferRepository interface {
+	repository.IBaseRepo[Transfer]
+	PaginationByInvestorID(ctx context.Context, investorID uuid.UUID, page int, limit int) (repository.Pagination[Transfer], error)
+}
@@ -0,0 +1,13 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"gorm.io/gorm"
+)
+
+type ITopupRepository interface {
+	repository.IBaseRepo[Topup]
+	GetByExternalIDLockTx(ctx context.Context, externalID string, trx *gorm.DB) (Topup, error)
+}
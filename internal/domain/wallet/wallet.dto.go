@@ -0,0 +1,36 @@
+package wallet
+
+import (
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+)
+
+type CreateTopupRequest struct {
+	Amount money.Money `json:"amount" validate:"required,min=10000"`
+	Bank   string      `json:"bank" validate:"required,oneof=bca bni bri mandiri permata"`
+}
+
+type TopupCallbackRequest struct {
+	ExternalID string      `json:"external_id"`
+	Amount     money.Money `json:"amount"`
+	Status     TopupStatus `json:"status"`
+	PaidAt     time.Time   `json:"paid_at"`
+}
+
+type CreateWithdrawalRequest struct {
+	Amount        money.Money `json:"amount" validate:"required,min=10000"`
+	BankCode      string      `json:"bank_code" validate:"required"`
+	AccountNumber string      `json:"account_number" validate:"required,numeric,min=6,max=20"`
+	AccountName   string      `json:"account_name" validate:"required"`
+}
+
+type RejectWithdrawalRequest struct {
+	Note string `json:"note" validate:"required"`
+}
+
+type PayoutCallbackRequest struct {
+	Reference     string           `json:"reference"`
+	Status        WithdrawalStatus `json:"status"`
+	FailureReason string           `json:"failure_reason"`
+}
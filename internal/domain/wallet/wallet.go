@@ -0,0 +1,79 @@
+package wallet
+
+import (
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/model"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/google/uuid"
+)
+
+type TopupStatus string
+
+const (
+	TopupStatusPending TopupStatus = "pending"
+	TopupStatusPaid    TopupStatus = "paid"
+	TopupStatusExpired TopupStatus = "expired"
+)
+
+type Topup struct {
+	model.BaseModel
+	InvestorID           uuid.UUID   `json:"investor_id"`
+	Amount               money.Money `json:"amount"`
+	Bank                 string      `json:"bank"`
+	VirtualAccountNumber string      `json:"virtual_account_number"`
+	ExternalID           string      `json:"external_id"`
+	Status               TopupStatus `json:"status"`
+	ExpiresAt            time.Time   `json:"expires_at"`
+	PaidAt               *time.Time  `json:"paid_at"`
+}
+
+func (Topup) TableName() string {
+	return "topups"
+}
+
+type WithdrawalStatus string
+
+const (
+	WithdrawalStatusPending    WithdrawalStatus = "pending"
+	WithdrawalStatusRejected   WithdrawalStatus = "rejected"
+	WithdrawalStatusProcessing WithdrawalStatus = "processing"
+	WithdrawalStatusCompleted  WithdrawalStatus = "completed"
+	WithdrawalStatusFailed     WithdrawalStatus = "failed"
+)
+
+// withdrawalTransitions lists the statuses a withdrawal may move to from each status.
+var withdrawalTransitions = map[WithdrawalStatus][]WithdrawalStatus{
+	WithdrawalStatusPending:    {WithdrawalStatusProcessing, WithdrawalStatusRejected},
+	WithdrawalStatusProcessing: {WithdrawalStatusCompleted, WithdrawalStatusFailed},
+}
+
+func (s WithdrawalStatus) CanTransitionTo(next WithdrawalStatus) bool {
+	for _, allowed := range withdrawalTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Withdrawal struct {
+	model.BaseModel
+	InvestorID         uuid.UUID        `json:"investor_id"`
+	Amount             money.Money      `json:"amount"`
+	BankCode           string           `json:"bank_code"`
+	AccountNumber      string           `json:"account_number"`
+	AccountName        string           `json:"account_name"`
+	Status             WithdrawalStatus `json:"status"`
+	ReviewerEmployeeID *uuid.UUID       `json:"reviewer_employee_id"`
+	ReviewNote         string           `json:"review_note"`
+	ReviewedAt         *time.Time       `json:"reviewed_at"`
+	PayoutReference    *string          `json:"payout_reference"`
+	FailureReason      string           `json:"failure_reason"`
+	CompletedAt        *time.Time       `json:"completed_at"`
+}
+
+func (Withdrawal) TableName() string {
+	return "withdrawals"
+}
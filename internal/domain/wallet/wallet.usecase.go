@@ -0,0 +1,16 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type IWalletUsecase interface {
+	CreateTopup(ctx context.Context, investorID uuid.UUID, req CreateTopupRequest) (*Topup, error)
+	HandleTopupCallback(ctx context.Context, payload []byte, signature string) error
+	CreateWithdrawal(ctx context.Context, investorID uuid.UUID, req CreateWithdrawalRequest) (*Withdrawal, error)
+	ApproveWithdrawal(ctx context.Context, withdrawalID uuid.UUID, employeeID uuid.UUID) (*Withdrawal, error)
+	RejectWithdrawal(ctx context.Context, withdrawalID uuid.UUID, employeeID uuid.UUID, req RejectWithdrawalRequest) (*Withdrawal, error)
+	HandlePayoutCallback(ctx context.Context, payload []byte, signature string) error
+}
@@ -0,0 +1,13 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/repository"
+	"gorm.io/gorm"
+)
+
+type IWithdrawalRepository interface {
+	repository.IBaseRepo[Withdrawal]
+	GetByPayoutReferenceLockTx(ctx context.Context, payoutReference string, trx *gorm.DB) (Withdrawal, error)
+}
@@ -0,0 +1,136 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
+	"github.com/google/uuid"
+)
+
+type VirtualAccountRequest struct {
+	ReferenceID  uuid.UUID
+	Bank         string
+	CustomerName string
+	Amount       money.Money
+}
+
+type VirtualAccount struct {
+	ExternalID    string
+	Bank          string
+	AccountNumber string
+	Amount        money.Money
+	ExpiresAt     time.Time
+}
+
+// PayoutRequest asks for a bank transfer. Requests with the same IdempotencyKey are one payout: the gateway answers
+// a repeated request with the payout it already made instead of paying again.
+type PayoutRequest struct {
+	ReferenceID    uuid.UUID
+	IdempotencyKey string
+	BankCode       string
+	AccountNumber  string
+	AccountName    string
+	Amount         money.Money
+}
+
+type Payout struct {
+	Reference string
+}
+
+// PayoutRefusedError is returned when the gateway definitely refused a payout, so no money was sent. Any other error
+// from CreatePayout leaves the outcome unknown: the transfer may have gone out.
+type PayoutRefusedError struct {
+	Reason string
+}
+
+func (e *PayoutRefusedError) Error() string {
+	return e.Reason
+}
+
+// GatewayFake selects FakeGateway, for development and tests only
+const GatewayFake = "fake"
+
+type IGateway interface {
+	CreateVirtualAccount(ctx context.Context, req VirtualAccountRequest) (VirtualAccount, error)
+	CreatePayout(ctx context.Context, req PayoutRequest) (Payout, error)
+	VerifySignature(payload []byte, signature string) bool
+}
+
+// NewGateway returns the gateway named by PAYMENT_GATEWAY. There is no real gateway integration yet, so the fake one
+// must be chosen explicitly, and only with a callback secret since anyone could otherwise sign a callback.
+func NewGateway(cfg config.PaymentConfig) (IGateway, error) {
+	switch cfg.Gateway {
+	case GatewayFake:
+		if cfg.CallbackSecret == "" {
+			return nil, errors.New("PAYMENT_CALLBACK_SECRET is required")
+		}
+
+		return NewFakeGateway(cfg), nil
+	default:
+		return nil, fmt.Errorf("PAYMENT_GATEWAY %q is not supported", cfg.Gateway)
+	}
+}
+
+// FakeGateway stands in for a real payment gateway. It issues virtual accounts and payouts locally and signs
+// webhook payloads with HMAC-SHA256, so the whole top-up and withdrawal flow can run without external services.
+type FakeGateway struct {
+	secret   []byte
+	vaExpiry time.Duration
+}
+
+func NewFakeGateway(cfg config.PaymentConfig) *FakeGateway {
+	return &FakeGateway{
+		secret:   []byte(cfg.CallbackSecret),
+		vaExpiry: time.Duration(cfg.VirtualAccountExpiry) * time.Hour,
+	}
+}
+
+func (g *FakeGateway) CreateVirtualAccount(ctx context.Context, req VirtualAccountRequest) (VirtualAccount, error) {
+	number, err := rand.Int(rand.Reader, big.NewInt(1e12))
+	if err != nil {
+		return VirtualAccount{}, err
+	}
+
+	return VirtualAccount{
+		ExternalID:    "va-" + req.ReferenceID.String(),
+		Bank:          req.Bank,
+		AccountNumber: fmt.Sprintf("8808%012d", number.Int64()),
+		Amount:        req.Amount,
+		ExpiresAt:     time.Now().Add(g.vaExpiry),
+	}, nil
+}
+
+func (g *FakeGateway) CreatePayout(ctx context.Context, req PayoutRequest) (Payout, error) {
+	return Payout{
+		Reference: "po-" + req.IdempotencyKey,
+	}, nil
+}
+
+func (g *FakeGateway) VerifySignature(payload []byte, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(expected, g.sign(payload))
+}
+
+// Sign returns the signature the gateway sends in the callback signature header for payload.
+func (g *FakeGateway) Sign(payload []byte) string {
+	return hex.EncodeToString(g.sign(payload))
+}
+
+func (g *FakeGateway) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, g.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,32 @@
+package payment_test
+
+import (
+	"testing"
+
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/infrastructure/payment"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGateway(t *testing.T) {
+	t.Run("fake gateway", func(t *testing.T) {
+		gateway, err := payment.NewGateway(config.PaymentConfig{Gateway: payment.GatewayFake, CallbackSecret: "test-secret"})
+
+		assert.NoError(t, err)
+		assert.IsType(t, &payment.FakeGateway{}, gateway)
+	})
+
+	t.Run("fake gateway without a callback secret", func(t *testing.T) {
+		gateway, err := payment.NewGateway(config.PaymentConfig{Gateway: payment.GatewayFake})
+
+		assert.EqualError(t, err, "PAYMENT_CALLBACK_SECRET is required")
+		assert.Nil(t, gateway)
+	})
+
+	t.Run("gateway not chosen", func(t *testing.T) {
+		gateway, err := payment.NewGateway(config.PaymentConfig{CallbackSecret: "test-secret"})
+
+		assert.EqualError(t, err, `PAYMENT_GATEWAY "" is not supported`)
+		assert.Nil(t, gateway)
+	})
+}
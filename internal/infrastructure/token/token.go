@@ -0,0 +1,177 @@
+package token
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+type Type string
+
+const (
+	TypeAccess  Type = "access"
+	TypeRefresh Type = "refresh"
+)
+
+type Claims struct {
+	TokenID   uuid.UUID
+	Subject   uuid.UUID
+	Role      string
+	Type      Type
+	ExpiresAt time.Time
+}
+
+type IManager interface {
+	Issue(subject uuid.UUID, role string, tokenType Type) (string, Claims, error)
+	Parse(signed string) (Claims, error)
+	TTL(tokenType Type) time.Duration
+}
+
+type jwtClaims struct {
+	jwt.RegisteredClaims
+	Role string `json:"role"`
+	Type Type   `json:"typ"`
+}
+
+// Manager signs and verifies JWTs with either an HMAC secret (HS256) or an RSA key pair (RS256)
+type Manager struct {
+	method     jwt.SigningMethod
+	signKey    any
+	verifyKey  any
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+func NewManager(cfg config.AuthConfig) (*Manager, error) {
+	accessTTL := time.Duration(cfg.AccessTokenTTL) * time.Minute
+	refreshTTL := time.Duration(cfg.RefreshTokenTTL) * time.Hour
+
+	switch cfg.SigningMethod {
+	case jwt.SigningMethodHS256.Alg():
+		if cfg.Secret == "" {
+			return nil, errors.New("AUTH_SECRET is required for HS256")
+		}
+
+		return NewHMACManager([]byte(cfg.Secret), accessTTL, refreshTTL), nil
+	case jwt.SigningMethodRS256.Alg():
+		privatePEM, err := os.ReadFile(cfg.PrivateKeyPath)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privatePEM)
+		if err != nil {
+			return nil, err
+		}
+
+		publicKey := &privateKey.PublicKey
+		if cfg.PublicKeyPath != "" {
+			publicPEM, err := os.ReadFile(cfg.PublicKeyPath)
+			if err != nil {
+				return nil, err
+			}
+
+			publicKey, err = jwt.ParseRSAPublicKeyFromPEM(publicPEM)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		manager := NewRSAManager(privateKey, accessTTL, refreshTTL)
+		manager.verifyKey = publicKey
+
+		return manager, nil
+	default:
+		return nil, fmt.Errorf("unsupported signing method %q", cfg.SigningMethod)
+	}
+}
+
+func NewHMACManager(secret []byte, accessTTL time.Duration, refreshTTL time.Duration) *Manager {
+	return &Manager{
+		method:     jwt.SigningMethodHS256,
+		signKey:    secret,
+		verifyKey:  secret,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+func NewRSAManager(privateKey *rsa.PrivateKey, accessTTL time.Duration, refreshTTL time.Duration) *Manager {
+	return &Manager{
+		method:     jwt.SigningMethodRS256,
+		signKey:    privateKey,
+		verifyKey:  &privateKey.PublicKey,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+func (m *Manager) TTL(tokenType Type) time.Duration {
+	if tokenType == TypeRefresh {
+		return m.refreshTTL
+	}
+
+	return m.accessTTL
+}
+
+func (m *Manager) Issue(subject uuid.UUID, role string, tokenType Type) (string, Claims, error) {
+	now := time.Now()
+	claims := Claims{
+		TokenID:   uuid.New(),
+		Subject:   subject,
+		Role:      role,
+		Type:      tokenType,
+		ExpiresAt: now.Add(m.TTL(tokenType)).Truncate(time.Second),
+	}
+
+	signed, err := jwt.NewWithClaims(m.method, jwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        claims.TokenID.String(),
+			Subject:   claims.Subject.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(claims.ExpiresAt),
+		},
+		Role: role,
+		Type: tokenType,
+	}).SignedString(m.signKey)
+	if err != nil {
+		return "", Claims{}, err
+	}
+
+	return signed, claims, nil
+}
+
+// Parse verifies the signature and expiry of a token. Tokens signed with any other algorithm are rejected.
+func (m *Manager) Parse(signed string) (Claims, error) {
+	var parsed jwtClaims
+	_, err := jwt.ParseWithClaims(signed, &parsed, func(*jwt.Token) (any, error) {
+		return m.verifyKey, nil
+	}, jwt.WithValidMethods([]string{m.method.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return Claims{}, err
+	}
+
+	tokenID, err := uuid.Parse(parsed.ID)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	subject, err := uuid.Parse(parsed.Subject)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	return Claims{
+		TokenID:   tokenID,
+		Subject:   subject,
+		Role:      parsed.Role,
+		Type:      parsed.Type,
+		ExpiresAt: parsed.ExpiresAt.Time,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package token_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/infrastructure/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	return key
+}
+
+func TestManager(t *testing.T) {
+	subject := uuid.New()
+
+	managers := map[string]*token.Manager{
+		"HS256": token.NewHMACManager([]byte("test-secret"), time.Minute, time.Hour),
+		"RS256": token.NewRSAManager(newRSAKey(t), time.Minute, time.Hour),
+	}
+
+	for name, manager := range managers {
+		t.Run(name+" issue and parse", func(t *testing.T) {
+			signed, issued, err := manager.Issue(subject, "employee", token.TypeAccess)
+			assert.NoError(t, err)
+
+			claims, err := manager.Parse(signed)
+			assert.NoError(t, err)
+			assert.Equal(t, issued.TokenID, claims.TokenID)
+			assert.Equal(t, subject, claims.Subject)
+			assert.Equal(t, "employee", claims.Role)
+			assert.Equal(t, token.TypeAccess, claims.Type)
+			assert.True(t, issued.ExpiresAt.Equal(claims.ExpiresAt))
+		})
+
+		t.Run(name+" refresh ttl", func(t *testing.T) {
+			_, issued, err := manager.Issue(subject, "investor", token.TypeRefresh)
+			assert.NoError(t, err)
+
+			assert.WithinDuration(t, time.Now().Add(time.Hour), issued.ExpiresAt, 2*time.Second)
+		})
+
+		t.Run(name+" tampered token", func(t *testing.T) {
+			signed, _, err := manager.Issue(subject, "employee", token.TypeAccess)
+			assert.NoError(t, err)
+
+			_, err = manager.Parse(signed[:len(signed)-2] + "xx")
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("expired token", func(t *testing.T) {
+		manager := token.NewHMACManager([]byte("test-secret"), -time.Minute, time.Hour)
+		signed, _, err := manager.Issue(subject, "employee", token.TypeAccess)
+		assert.NoError(t, err)
+
+		_, err = manager.Parse(signed)
+		assert.ErrorContains(t, err, "token is expired")
+	})
+
+	t.Run("token signed with another key", func(t *testing.T) {
+		signed, _, err := token.NewRSAManager(newRSAKey(t), time.Minute, time.Hour).Issue(subject, "employee", token.TypeAccess)
+		assert.NoError(t, err)
+
+		_, err = managers["RS256"].Parse(signed)
+		assert.Error(t, err)
+	})
+
+	t.Run("token signed with another method", func(t *testing.T) {
+		signed, _, err := managers["HS256"].Issue(subject, "employee", token.TypeAccess)
+		assert.NoError(t, err)
+
+		_, err = managers["RS256"].Parse(signed)
+		assert.ErrorContains(t, err, "signing method HS256 is invalid")
+	})
+}
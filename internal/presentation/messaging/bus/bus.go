@@ -1,13 +1,19 @@
 package bus
 
 import (
+	autoinvesthandler "github.com/BagusAK95/amarta_test/internal/application/autoinvest/delivery/messaging"
 	mailhandler "github.com/BagusAK95/amarta_test/internal/application/mail/delivery/messaging"
+	"github.com/BagusAK95/amarta_test/internal/domain/autoinvest"
+	"github.com/BagusAK95/amarta_test/internal/domain/loan"
 	"github.com/BagusAK95/amarta_test/internal/domain/mail"
 	"github.com/BagusAK95/amarta_test/internal/infrastructure/bus"
 )
 
-func NewBusListener(mailBus bus.Bus[mail.MailSendRequest], mailUsecase mail.IMailUsecase) {
+func NewBusListener(mailBus bus.Bus[mail.MailSendRequest], mailUsecase mail.IMailUsecase, loanBus bus.Bus[loan.LoanApprovedEvent], autoInvestUsecase autoinvest.IAutoInvestUsecase) {
 	handler := mailhandler.NewMailHandler(mailUsecase)
+	autoInvestHandler := autoinvesthandler.NewAutoInvestHandler(autoInvestUsecase)
 
 	mailBus.SubscribeAsync("mail.send", handler.Send, false)
+	// Transactional so approved loans are allocated one at a time and each sees the budgets spent on the previous one
+	loanBus.SubscribeAsync("loan.approved", autoInvestHandler.InvestLoan, true)
 }
@@ -1,51 +1,98 @@
 package middleware
 
 import (
-	"net/http"
+	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/BagusAK95/amarta_test/internal/domain/auth"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/employee"
+	httpError "github.com/BagusAK95/amarta_test/internal/utils/error"
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 )
 
 const (
-	RoleEmployee = "employee"
-	RoleInvestor = "investor"
+	RoleEmployee = auth.RoleEmployee
+	RoleInvestor = auth.RoleInvestor
 )
 
-// Note: very basic authentication middleware
-func AuthMiddleware(allowedRoles ...string) gin.HandlerFunc {
+// AuthMiddleware verifies the bearer access token and rejects callers whose role is not allowed.
+// The principal is put into the request context, and its ID is also set as employeeID or investorID.
+func AuthMiddleware(authUsecase auth.IAuthUsecase, allowedRoles ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		for _, role := range allowedRoles {
-			var userIDHeader string
-
-			switch role {
-			case RoleEmployee:
-				userIDHeader = c.GetHeader("x-employee-id")
-				if userIDHeader != "" {
-					userID, err := uuid.Parse(userIDHeader)
-					if err != nil {
-						c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "invalid x-employee-id header"})
-						return
-					}
-					c.Set("employeeID", userID)
-					c.Next()
-					return
-				}
-			case RoleInvestor:
-				userIDHeader = c.GetHeader("x-investor-id")
-				if userIDHeader != "" {
-					userID, err := uuid.Parse(userIDHeader)
-					if err != nil {
-						c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "invalid x-investor-id header"})
-						return
-					}
-					c.Set("investorID", userID)
-					c.Next()
-					return
-				}
-			}
+		principal, ok := authenticate(c, authUsecase)
+		if !ok {
+			return
 		}
 
-		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "missing required role header"})
+		if !slices.Contains(allowedRoles, principal.Role) {
+			_ = c.Error(httpError.NewForbiddenError("role is not allowed"))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequirePermission verifies the bearer access token and rejects callers that do not hold the permission.
+// Only employees hold permissions, through the roles assigned to them.
+func RequirePermission(authUsecase auth.IAuthUsecase, permission employee.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := authenticate(c, authUsecase)
+		if !ok {
+			return
+		}
+
+		if !principal.HasPermission(permission) {
+			_ = c.Error(httpError.NewForbiddenError(fmt.Sprintf("%s permission is required", permission)))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func authenticate(c *gin.Context, authUsecase auth.IAuthUsecase) (auth.Principal, bool) {
+	accessToken, found := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !found || accessToken == "" {
+		_ = c.Error(httpError.NewUnauthorizedError("missing bearer token"))
+		c.Abort()
+		return auth.Principal{}, false
+	}
+
+	principal, err := authUsecase.Authenticate(c.Request.Context(), accessToken)
+	if err != nil {
+		_ = c.Error(err)
+		c.Abort()
+		return auth.Principal{}, false
+	}
+
+	ctx := auth.WithPrincipal(c.Request.Context(), principal)
+
+	switch principal.Role {
+	case RoleEmployee:
+		c.Set("employeeID", principal.ID)
+		// Repositories limit employee requests to the employee's branches
+		ctx = branch.WithScope(ctx, principal.BranchScope())
+	case RoleInvestor:
+		c.Set("investorID", principal.ID)
+		// Investors fund loans of every branch
+		ctx = branch.WithScope(ctx, branch.AllBranches())
+	}
+
+	c.Request = c.Request.WithContext(ctx)
+
+	return principal, true
+}
+
+// SystemScope lets a public endpoint, which verifies its caller by other means, reach every branch.
+// Requests without a scope see no branch.
+func SystemScope() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(branch.WithScope(c.Request.Context(), branch.AllBranches()))
+		c.Next()
 	}
 }
@@ -21,6 +21,12 @@ func ErrorHandler() gin.HandlerFunc {
 					res["errors"] = e.Errors
 				}
 				c.JSON(http.StatusNotFound, res)
+			case *error.UnauthorizedError:
+				res := gin.H{"message": e.Error()}
+				if len(e.Errors) > 0 {
+					res["errors"] = e.Errors
+				}
+				c.JSON(http.StatusUnauthorized, res)
 			case *error.ForbiddenError:
 				res := gin.H{"message": e.Error()}
 				if len(e.Errors) > 0 {
@@ -1,45 +1,172 @@
 package router
 
 import (
+	authhttp "github.com/BagusAK95/amarta_test/internal/application/auth/delivery/http"
+	autoinvesthttp "github.com/BagusAK95/amarta_test/internal/application/autoinvest/delivery/http"
+	borrowerhttp "github.com/BagusAK95/amarta_test/internal/application/borrower/delivery/http"
+	borrowergrouphttp "github.com/BagusAK95/amarta_test/internal/application/borrowergroup/delivery/http"
+	employeehttp "github.com/BagusAK95/amarta_test/internal/application/employee/delivery/http"
 	investmenthttp "github.com/BagusAK95/amarta_test/internal/application/investment/delivery/http"
+	ledgerhttp "github.com/BagusAK95/amarta_test/internal/application/ledger/delivery/http"
 	loanhttp "github.com/BagusAK95/amarta_test/internal/application/loan/delivery/http"
+	marketplacehttp "github.com/BagusAK95/amarta_test/internal/application/marketplace/delivery/http"
+	repaymenthttp "github.com/BagusAK95/amarta_test/internal/application/repayment/delivery/http"
+	secondarymarkethttp "github.com/BagusAK95/amarta_test/internal/application/secondarymarket/delivery/http"
+	wallethttp "github.com/BagusAK95/amarta_test/internal/application/wallet/delivery/http"
+	"github.com/BagusAK95/amarta_test/internal/domain/auth"
+	"github.com/BagusAK95/amarta_test/internal/domain/autoinvest"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrower"
+	"github.com/BagusAK95/amarta_test/internal/domain/borrowergroup"
+	"github.com/BagusAK95/amarta_test/internal/domain/employee"
 	"github.com/BagusAK95/amarta_test/internal/domain/investment"
+	"github.com/BagusAK95/amarta_test/internal/domain/ledger"
 	"github.com/BagusAK95/amarta_test/internal/domain/loan"
+	"github.com/BagusAK95/amarta_test/internal/domain/marketplace"
+	"github.com/BagusAK95/amarta_test/internal/domain/repayment"
+	"github.com/BagusAK95/amarta_test/internal/domain/secondarymarket"
+	"github.com/BagusAK95/amarta_test/internal/domain/wallet"
 	"github.com/BagusAK95/amarta_test/internal/presentation/rest/middleware"
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel/trace"
 )
 
-func NewRouter(loanUsecase loan.ILoanUsecase, investmentUsecase investment.IInvestmentUsecase, tracer trace.Tracer) *gin.Engine {
+func NewRouter(authUsecase auth.IAuthUsecase, employeeUsecase employee.IEmployeeUsecase, borrowerUsecase borrower.IBorrowerUsecase, loanUsecase loan.ILoanUsecase, borrowerGroupUsecase borrowergroup.IBorrowerGroupUsecase, investmentUsecase investment.IInvestmentUsecase, repaymentUsecase repayment.IRepaymentUsecase, ledgerUsecase ledger.ILedgerUsecase, walletUsecase wallet.IWalletUsecase, marketplaceUsecase marketplace.IMarketplaceUsecase, secondaryMarketUsecase secondarymarket.ISecondaryMarketUsecase, autoInvestUsecase autoinvest.IAutoInvestUsecase, tracer trace.Tracer) *gin.Engine {
 	router := gin.Default()
 	router.Use(middleware.TracingMiddleware(tracer))
 	router.Use(middleware.ErrorHandler())
 
+	authHandler := authhttp.NewAuthHandler(authUsecase)
+	employeeHandler := employeehttp.NewEmployeeHandler(employeeUsecase)
+	borrowerHandler := borrowerhttp.NewBorrowerHandler(borrowerUsecase)
 	loanHandler := loanhttp.NewLoanHandler(loanUsecase)
+	borrowerGroupHandler := borrowergrouphttp.NewBorrowerGroupHandler(borrowerGroupUsecase)
 	investmentHandler := investmenthttp.NewInvestmentHandler(investmentUsecase)
+	repaymentHandler := repaymenthttp.NewRepaymentHandler(repaymentUsecase)
+	ledgerHandler := ledgerhttp.NewLedgerHandler(ledgerUsecase)
+	walletHandler := wallethttp.NewWalletHandler(walletUsecase)
+	marketplaceHandler := marketplacehttp.NewMarketplaceHandler(marketplaceUsecase)
+	secondaryMarketHandler := secondarymarkethttp.NewSecondaryMarketHandler(secondaryMarketUsecase)
+	autoInvestHandler := autoinvesthttp.NewAutoInvestHandler(autoInvestUsecase)
 
 	// API v1 routes
 	api := router.Group("/api/v1")
 	{
+		authRoutes := api.Group("/auth")
+		{
+			authRoutes.POST("/employee/login", authHandler.LoginEmployee)
+			authRoutes.POST("/investor/login", authHandler.LoginInvestor)
+			authRoutes.POST("/refresh", authHandler.Refresh)
+			authRoutes.POST("/logout", middleware.AuthMiddleware(authUsecase, middleware.RoleEmployee, middleware.RoleInvestor), authHandler.Logout)
+		}
+
+		admin := api.Group("/admin")
+		{
+			admin.GET("/roles", middleware.RequirePermission(authUsecase, employee.PermissionRoleAssign), employeeHandler.ListRole)
+			admin.GET("/branches", middleware.RequirePermission(authUsecase, employee.PermissionBranchAssign), employeeHandler.ListBranch)
+			admin.GET("/employees/:id", middleware.RequirePermission(authUsecase, employee.PermissionRoleAssign), employeeHandler.DetailEmployee)
+			admin.PUT("/employees/:id/roles", middleware.RequirePermission(authUsecase, employee.PermissionRoleAssign), employeeHandler.AssignRoles)
+			admin.PUT("/employees/:id/branches", middleware.RequirePermission(authUsecase, employee.PermissionBranchAssign), employeeHandler.AssignBranches)
+		}
+
+		borrowers := api.Group("/borrower")
+		{
+			borrowers.POST("", middleware.RequirePermission(authUsecase, employee.PermissionBorrowerManage), borrowerHandler.RegisterBorrower)
+			borrowers.GET("", middleware.RequirePermission(authUsecase, employee.PermissionBorrowerRead), borrowerHandler.SearchBorrower)
+			borrowers.GET("/:id", middleware.RequirePermission(authUsecase, employee.PermissionBorrowerRead), borrowerHandler.DetailBorrower)
+			borrowers.PUT("/:id", middleware.RequirePermission(authUsecase, employee.PermissionBorrowerManage), borrowerHandler.UpdateBorrower)
+			borrowers.PATCH("/:id/deactivate", middleware.RequirePermission(authUsecase, employee.PermissionBorrowerManage), borrowerHandler.DeactivateBorrower)
+			borrowers.POST("/:id/kyc-document", middleware.RequirePermission(authUsecase, employee.PermissionBorrowerManage), borrowerHandler.SubmitKYCDocument)
+			borrowers.PATCH("/:id/kyc-document/:document_id/verify", middleware.RequirePermission(authUsecase, employee.PermissionKYCReview), borrowerHandler.VerifyKYCDocument)
+			borrowers.PATCH("/:id/kyc-document/:document_id/reject", middleware.RequirePermission(authUsecase, employee.PermissionKYCReview), borrowerHandler.RejectKYCDocument)
+			borrowers.POST("/:id/blacklist", middleware.RequirePermission(authUsecase, employee.PermissionBorrowerBlacklist), borrowerHandler.BlacklistBorrower)
+			borrowers.PATCH("/:id/blacklist/:entry_id/lift", middleware.RequirePermission(authUsecase, employee.PermissionBorrowerBlacklist), borrowerHandler.LiftBlacklist)
+		}
+
 		loans := api.Group("/loan")
-		loans.Use(middleware.AuthMiddleware(middleware.RoleEmployee))
 		{
-			loans.POST("", loanHandler.CreateLoan)
-			loans.GET("", loanHandler.ListLoan)
-			loans.GET("/:id", loanHandler.DetailLoan)
-			loans.PATCH("/:id/reject", loanHandler.RejectLoan)
-			loans.PATCH("/:id/approve", loanHandler.ApproveLoan)
-			loans.PATCH("/:id/disburse", loanHandler.DisburseLoan)
+			loans.POST("", middleware.RequirePermission(authUsecase, employee.PermissionLoanCreate), loanHandler.CreateLoan)
+			loans.POST("/quote", middleware.RequirePermission(authUsecase, employee.PermissionLoanCreate), loanHandler.QuoteLoan)
+			loans.GET("", middleware.RequirePermission(authUsecase, employee.PermissionLoanRead), loanHandler.ListLoan)
+			loans.GET("/:id", middleware.RequirePermission(authUsecase, employee.PermissionLoanRead), loanHandler.DetailLoan)
+			loans.GET("/:id/schedule", middleware.RequirePermission(authUsecase, employee.PermissionLoanRead), loanHandler.GetLoanSchedule)
+			loans.GET("/:id/history", middleware.RequirePermission(authUsecase, employee.PermissionLoanRead), loanHandler.GetLoanHistory)
+			loans.PATCH("/:id/reject", middleware.RequirePermission(authUsecase, employee.PermissionLoanReject), loanHandler.RejectLoan)
+			loans.PATCH("/:id/approve", middleware.RequirePermission(authUsecase, employee.PermissionLoanApprove), loanHandler.ApproveLoan)
+			loans.PATCH("/:id/disburse", middleware.RequirePermission(authUsecase, employee.PermissionLoanDisburse), loanHandler.DisburseLoan)
+			loans.POST("/:id/repayment", middleware.RequirePermission(authUsecase, employee.PermissionRepaymentCreate), repaymentHandler.CreateRepayment)
+		}
+
+		groups := api.Group("/group")
+		{
+			groups.POST("", middleware.RequirePermission(authUsecase, employee.PermissionGroupManage), borrowerGroupHandler.CreateGroup)
+			groups.GET("/:id", middleware.RequirePermission(authUsecase, employee.PermissionLoanRead), borrowerGroupHandler.DetailGroup)
+			groups.GET("/:id/arrears", middleware.RequirePermission(authUsecase, employee.PermissionLoanRead), borrowerGroupHandler.GetGroupArrears)
+			groups.POST("/:id/loan", middleware.RequirePermission(authUsecase, employee.PermissionLoanCreate), borrowerGroupHandler.CreateGroupLoan)
+		}
+
+		groupLoans := api.Group("/group-loan")
+		{
+			groupLoans.GET("/:id", middleware.RequirePermission(authUsecase, employee.PermissionLoanRead), borrowerGroupHandler.DetailGroupLoan)
+			groupLoans.PATCH("/:id/reject", middleware.RequirePermission(authUsecase, employee.PermissionLoanReject), borrowerGroupHandler.RejectGroupLoan)
+			groupLoans.PATCH("/:id/approve", middleware.RequirePermission(authUsecase, employee.PermissionLoanApprove), borrowerGroupHandler.ApproveGroupLoan)
+			groupLoans.PATCH("/:id/disburse", middleware.RequirePermission(authUsecase, employee.PermissionLoanDisburse), borrowerGroupHandler.DisburseGroupLoan)
 		}
 
 		investments := api.Group("/investment")
-		investments.Use(middleware.AuthMiddleware(middleware.RoleInvestor))
+		investments.Use(middleware.AuthMiddleware(authUsecase, middleware.RoleInvestor))
 		{
 			investments.POST("", investmentHandler.AddInvestment)
+			investments.GET("", investmentHandler.ListInvestment)
+			investments.GET("/summary", investmentHandler.GetPortfolioSummary)
+			investments.GET("/:id", investmentHandler.DetailInvestment)
+			investments.DELETE("/:id", investmentHandler.CancelInvestment)
+		}
+
+		marketplaceLoans := api.Group("/marketplace/loans")
+		marketplaceLoans.Use(middleware.AuthMiddleware(authUsecase, middleware.RoleInvestor))
+		{
+			marketplaceLoans.GET("", marketplaceHandler.ListLoan)
+			marketplaceLoans.GET("/:id", marketplaceHandler.DetailLoan)
+		}
+
+		secondaryMarket := api.Group("/secondary-market")
+		secondaryMarket.Use(middleware.AuthMiddleware(authUsecase, middleware.RoleInvestor))
+		{
+			secondaryMarket.GET("/listings", secondaryMarketHandler.ListListing)
+			secondaryMarket.POST("/listings", secondaryMarketHandler.CreateListing)
+			secondaryMarket.DELETE("/listings/:id", secondaryMarketHandler.CancelListing)
+			secondaryMarket.POST("/listings/:id/buy", secondaryMarketHandler.BuyListing)
+			secondaryMarket.GET("/transfers", secondaryMarketHandler.ListTransfer)
+		}
+
+		autoInvest := api.Group("/auto-invest")
+		autoInvest.Use(middleware.AuthMiddleware(authUsecase, middleware.RoleInvestor))
+		{
+			autoInvest.GET("/rules", autoInvestHandler.ListRule)
+			autoInvest.POST("/rules", autoInvestHandler.CreateRule)
+			autoInvest.PUT("/rules/:id", autoInvestHandler.UpdateRule)
+			autoInvest.DELETE("/rules/:id", autoInvestHandler.DeleteRule)
+		}
+
+		investors := api.Group("/investor")
+		investors.Use(middleware.AuthMiddleware(authUsecase, middleware.RoleInvestor))
+		{
+			investors.GET("/ledger", ledgerHandler.ListInvestorLedger)
+			investors.POST("/topup", walletHandler.CreateTopup)
+			investors.POST("/withdrawal", walletHandler.CreateWithdrawal)
+		}
+
+		withdrawals := api.Group("/withdrawal")
+		withdrawals.Use(middleware.RequirePermission(authUsecase, employee.PermissionWithdrawalReview))
+		{
+			withdrawals.PATCH("/:id/approve", walletHandler.ApproveWithdrawal)
+			withdrawals.PATCH("/:id/reject", walletHandler.RejectWithdrawal)
 		}
 
-		api.GET("/loan/agreement/file/:loan_id", loanHandler.GetLoanAgreementFile)
-		api.GET("/investment/agreement/file/:investment_id", investmentHandler.GetInvestmentAgreementFile)
+		api.GET("/loan/agreement/file/:loan_id", middleware.SystemScope(), loanHandler.GetLoanAgreementFile)
+		api.GET("/investment/agreement/file/:investment_id", middleware.SystemScope(), investmentHandler.GetInvestmentAgreementFile)
+		api.POST("/payment/callback/topup", middleware.SystemScope(), walletHandler.TopupCallback)
+		api.POST("/payment/callback/payout", middleware.SystemScope(), walletHandler.PayoutCallback)
 	}
 
 	return router
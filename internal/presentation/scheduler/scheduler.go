@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/config"
+	"github.com/BagusAK95/amarta_test/internal/domain/branch"
+	"github.com/BagusAK95/amarta_test/internal/domain/investment"
+)
+
+// Scheduler runs the periodic background jobs of the service.
+type Scheduler struct {
+	investmentUsecase investment.IInvestmentUsecase
+	fundingWindow     time.Duration
+	interval          time.Duration
+	stop              chan struct{}
+	done              chan struct{}
+}
+
+func NewScheduler(cfg config.LoanConfig, investmentUsecase investment.IInvestmentUsecase) *Scheduler {
+	return &Scheduler{
+		investmentUsecase: investmentUsecase,
+		fundingWindow:     time.Duration(cfg.FundingWindow) * 24 * time.Hour,
+		interval:          time.Duration(cfg.ExpiryInterval) * time.Minute,
+		stop:              make(chan struct{}),
+		done:              make(chan struct{}),
+	}
+}
+
+func (s *Scheduler) Start() {
+	go s.run()
+}
+
+// Stop waits for the running job to finish so the database can be closed safely afterwards.
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	<-s.done
+}
+
+func (s *Scheduler) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.expireLoans()
+
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) expireLoans() {
+	ctx := branch.WithScope(context.Background(), branch.AllBranches())
+
+	expired, err := s.investmentUsecase.ExpireLoans(ctx, time.Now().Add(-s.fundingWindow))
+	if err != nil {
+		log.Printf("❌ Failed to expire loans: %v", err)
+	}
+
+	if expired > 0 {
+		log.Printf("⏰ Expired %d loans past the funding window", expired)
+	}
+}
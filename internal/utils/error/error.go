@@ -6,6 +6,7 @@ type DefaultResponse struct {
 }
 
 type NotFoundError DefaultResponse       // 404 Not Found
+type UnauthorizedError DefaultResponse   // 401 Unauthorized
 type ForbiddenError DefaultResponse      // 403 Forbidden
 type BadRequestError DefaultResponse     // 400 Bad Request
 type InternalServerError DefaultResponse // 500 Internal Server Error
@@ -18,6 +19,14 @@ func (e *NotFoundError) Error() string {
 	return e.Message
 }
 
+func NewUnauthorizedError(message string, errors ...string) error {
+	return &UnauthorizedError{Message: message, Errors: errors}
+}
+
+func (e *UnauthorizedError) Error() string {
+	return e.Message
+}
+
 func NewForbiddenError(message string, errors ...string) error {
 	return &ForbiddenError{Message: message, Errors: errors}
 }
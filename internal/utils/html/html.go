@@ -6,6 +6,8 @@ import (
 	"io"
 	"strings"
 	"time"
+
+	"github.com/BagusAK95/amarta_test/internal/domain/common/money"
 )
 
 type HtmlTemplate struct {
@@ -57,14 +59,14 @@ func FormatNumber(amount float64) string {
 	return sign + strings.Join(formatted, ".")
 }
 
-func FormatCurrency(amount float64) string {
+func FormatCurrency(amount money.Money) string {
 	sign := ""
 	if amount < 0 {
 		sign = "-"
 		amount = -amount
 	}
 
-	rounded := fmt.Sprintf("%.0f", amount)
+	rounded := amount.String()
 
 	var formatted []string
 	for i := len(rounded); i > 0; i -= 3 {
@@ -0,0 +1,3 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS tenor,
+    DROP COLUMN IF EXISTS repayment_frequency;
@@ -0,0 +1,3 @@
+ALTER TABLE loans
+    ADD COLUMN tenor INT NOT NULL DEFAULT 0,
+    ADD COLUMN repayment_frequency VARCHAR NOT NULL DEFAULT 'weekly';
@@ -0,0 +1,15 @@
+CREATE TABLE installments (
+    id UUID PRIMARY KEY,
+    loan_id UUID NOT NULL REFERENCES loans(id),
+    sequence INT NOT NULL,
+    due_date TIMESTAMPTZ NOT NULL,
+    principal_amount float8 NOT NULL,
+    interest_amount float8 NOT NULL,
+    outstanding_balance float8 NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_installments_loan_id_sequence ON installments(loan_id, sequence);
+CREATE INDEX idx_installments_due_date ON installments(due_date);
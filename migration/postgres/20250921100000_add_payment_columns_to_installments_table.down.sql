@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_installments_status;
+
+ALTER TABLE installments
+    DROP COLUMN IF EXISTS fee_amount,
+    DROP COLUMN IF EXISTS paid_principal,
+    DROP COLUMN IF EXISTS paid_interest,
+    DROP COLUMN IF EXISTS paid_fee,
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS paid_at;
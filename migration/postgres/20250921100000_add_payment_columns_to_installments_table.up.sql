@@ -0,0 +1,9 @@
+ALTER TABLE installments
+    ADD COLUMN fee_amount float8 NOT NULL DEFAULT 0,
+    ADD COLUMN paid_principal float8 NOT NULL DEFAULT 0,
+    ADD COLUMN paid_interest float8 NOT NULL DEFAULT 0,
+    ADD COLUMN paid_fee float8 NOT NULL DEFAULT 0,
+    ADD COLUMN status VARCHAR NOT NULL DEFAULT 'unpaid',
+    ADD COLUMN paid_at TIMESTAMPTZ;
+
+CREATE INDEX idx_installments_status ON installments(status);
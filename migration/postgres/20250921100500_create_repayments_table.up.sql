@@ -0,0 +1,14 @@
+CREATE TABLE repayments (
+    id UUID PRIMARY KEY,
+    loan_id UUID NOT NULL REFERENCES loans(id),
+    amount float8 NOT NULL,
+    fee_amount float8 NOT NULL DEFAULT 0,
+    interest_amount float8 NOT NULL DEFAULT 0,
+    principal_amount float8 NOT NULL DEFAULT 0,
+    payment_date TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_repayments_loan_id ON repayments(loan_id);
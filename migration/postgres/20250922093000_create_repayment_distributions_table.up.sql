@@ -0,0 +1,16 @@
+CREATE TABLE repayment_distributions (
+    id UUID PRIMARY KEY,
+    repayment_id UUID NOT NULL REFERENCES repayments(id),
+    loan_id UUID NOT NULL REFERENCES loans(id),
+    investment_id UUID NOT NULL REFERENCES investments(id),
+    investor_id UUID NOT NULL REFERENCES investors(id),
+    principal_amount float8 NOT NULL,
+    return_amount float8 NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_repayment_distributions_repayment_id ON repayment_distributions(repayment_id);
+CREATE INDEX idx_repayment_distributions_investment_id ON repayment_distributions(investment_id);
+CREATE INDEX idx_repayment_distributions_investor_id ON repayment_distributions(investor_id);
@@ -0,0 +1,43 @@
+CREATE TABLE journal_entries (
+    id UUID PRIMARY KEY,
+    reference_type VARCHAR NOT NULL,
+    reference_id UUID NOT NULL,
+    description VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_journal_entries_reference ON journal_entries(reference_type, reference_id);
+
+CREATE TABLE postings (
+    id UUID PRIMARY KEY,
+    journal_entry_id UUID NOT NULL REFERENCES journal_entries(id),
+    account VARCHAR NOT NULL,
+    owner_id UUID,
+    direction VARCHAR NOT NULL CHECK (direction IN ('debit', 'credit')),
+    amount float8 NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_postings_journal_entry_id ON postings(journal_entry_id);
+CREATE INDEX idx_postings_account_owner_id ON postings(account, owner_id);
+
+-- Seeded investor balances become opening balance entries so the cached balance matches the ledger.
+INSERT INTO journal_entries (id, reference_type, reference_id, description)
+SELECT gen_random_uuid(), 'opening_balance', id, 'Opening balance'
+FROM investors
+WHERE balance > 0 AND deleted_at IS NULL;
+
+INSERT INTO postings (id, journal_entry_id, account, owner_id, direction, amount)
+SELECT gen_random_uuid(), je.id, 'settlement_cash', NULL, 'debit', i.balance
+FROM journal_entries je
+JOIN investors i ON i.id = je.reference_id
+WHERE je.reference_type = 'opening_balance'
+UNION ALL
+SELECT gen_random_uuid(), je.id, 'investor_cash', i.id, 'credit', i.balance
+FROM journal_entries je
+JOIN investors i ON i.id = je.reference_id
+WHERE je.reference_type = 'opening_balance';
@@ -0,0 +1,38 @@
+CREATE TABLE topups (
+    id UUID PRIMARY KEY,
+    investor_id UUID NOT NULL REFERENCES investors(id),
+    amount float8 NOT NULL,
+    bank VARCHAR NOT NULL,
+    virtual_account_number VARCHAR NOT NULL,
+    external_id VARCHAR UNIQUE NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'pending',
+    expires_at TIMESTAMPTZ NOT NULL,
+    paid_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_topups_investor_id ON topups(investor_id);
+
+CREATE TABLE withdrawals (
+    id UUID PRIMARY KEY,
+    investor_id UUID NOT NULL REFERENCES investors(id),
+    amount float8 NOT NULL,
+    bank_code VARCHAR NOT NULL,
+    account_number VARCHAR NOT NULL,
+    account_name VARCHAR NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'pending',
+    reviewer_employee_id UUID REFERENCES employees(id),
+    review_note VARCHAR NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMPTZ,
+    payout_reference VARCHAR UNIQUE,
+    failure_reason VARCHAR NOT NULL DEFAULT '',
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_withdrawals_investor_id ON withdrawals(investor_id);
+CREATE INDEX idx_withdrawals_status ON withdrawals(status);
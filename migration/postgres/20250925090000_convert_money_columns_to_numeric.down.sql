@@ -0,0 +1,36 @@
+ALTER TABLE investors
+    ALTER COLUMN balance TYPE float8 USING balance::float8;
+
+ALTER TABLE loans
+    ALTER COLUMN principal_amount TYPE float8 USING principal_amount::float8;
+
+ALTER TABLE investments
+    ALTER COLUMN amount TYPE float8 USING amount::float8;
+
+ALTER TABLE installments
+    ALTER COLUMN principal_amount TYPE float8 USING principal_amount::float8,
+    ALTER COLUMN interest_amount TYPE float8 USING interest_amount::float8,
+    ALTER COLUMN outstanding_balance TYPE float8 USING outstanding_balance::float8,
+    ALTER COLUMN fee_amount TYPE float8 USING fee_amount::float8,
+    ALTER COLUMN paid_principal TYPE float8 USING paid_principal::float8,
+    ALTER COLUMN paid_interest TYPE float8 USING paid_interest::float8,
+    ALTER COLUMN paid_fee TYPE float8 USING paid_fee::float8;
+
+ALTER TABLE repayments
+    ALTER COLUMN amount TYPE float8 USING amount::float8,
+    ALTER COLUMN fee_amount TYPE float8 USING fee_amount::float8,
+    ALTER COLUMN interest_amount TYPE float8 USING interest_amount::float8,
+    ALTER COLUMN principal_amount TYPE float8 USING principal_amount::float8;
+
+ALTER TABLE repayment_distributions
+    ALTER COLUMN principal_amount TYPE float8 USING principal_amount::float8,
+    ALTER COLUMN return_amount TYPE float8 USING return_amount::float8;
+
+ALTER TABLE postings
+    ALTER COLUMN amount TYPE float8 USING amount::float8;
+
+ALTER TABLE topups
+    ALTER COLUMN amount TYPE float8 USING amount::float8;
+
+ALTER TABLE withdrawals
+    ALTER COLUMN amount TYPE float8 USING amount::float8;
@@ -0,0 +1,36 @@
+ALTER TABLE investors
+    ALTER COLUMN balance TYPE NUMERIC(20, 0) USING ROUND(balance::numeric);
+
+ALTER TABLE loans
+    ALTER COLUMN principal_amount TYPE NUMERIC(20, 0) USING ROUND(principal_amount::numeric);
+
+ALTER TABLE investments
+    ALTER COLUMN amount TYPE NUMERIC(20, 0) USING ROUND(amount::numeric);
+
+ALTER TABLE installments
+    ALTER COLUMN principal_amount TYPE NUMERIC(20, 0) USING ROUND(principal_amount::numeric),
+    ALTER COLUMN interest_amount TYPE NUMERIC(20, 0) USING ROUND(interest_amount::numeric),
+    ALTER COLUMN outstanding_balance TYPE NUMERIC(20, 0) USING ROUND(outstanding_balance::numeric),
+    ALTER COLUMN fee_amount TYPE NUMERIC(20, 0) USING ROUND(fee_amount::numeric),
+    ALTER COLUMN paid_principal TYPE NUMERIC(20, 0) USING ROUND(paid_principal::numeric),
+    ALTER COLUMN paid_interest TYPE NUMERIC(20, 0) USING ROUND(paid_interest::numeric),
+    ALTER COLUMN paid_fee TYPE NUMERIC(20, 0) USING ROUND(paid_fee::numeric);
+
+ALTER TABLE repayments
+    ALTER COLUMN amount TYPE NUMERIC(20, 0) USING ROUND(amount::numeric),
+    ALTER COLUMN fee_amount TYPE NUMERIC(20, 0) USING ROUND(fee_amount::numeric),
+    ALTER COLUMN interest_amount TYPE NUMERIC(20, 0) USING ROUND(interest_amount::numeric),
+    ALTER COLUMN principal_amount TYPE NUMERIC(20, 0) USING ROUND(principal_amount::numeric);
+
+ALTER TABLE repayment_distributions
+    ALTER COLUMN principal_amount TYPE NUMERIC(20, 0) USING ROUND(principal_amount::numeric),
+    ALTER COLUMN return_amount TYPE NUMERIC(20, 0) USING ROUND(return_amount::numeric);
+
+ALTER TABLE postings
+    ALTER COLUMN amount TYPE NUMERIC(20, 0) USING ROUND(amount::numeric);
+
+ALTER TABLE topups
+    ALTER COLUMN amount TYPE NUMERIC(20, 0) USING ROUND(amount::numeric);
+
+ALTER TABLE withdrawals
+    ALTER COLUMN amount TYPE NUMERIC(20, 0) USING ROUND(amount::numeric);
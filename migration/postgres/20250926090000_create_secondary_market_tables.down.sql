@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS investment_transfers;
+DROP TABLE IF EXISTS investment_listings;
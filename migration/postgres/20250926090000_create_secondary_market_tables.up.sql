@@ -0,0 +1,33 @@
+CREATE TABLE investment_listings (
+    id UUID PRIMARY KEY,
+    investment_id UUID NOT NULL REFERENCES investments(id),
+    loan_id UUID NOT NULL REFERENCES loans(id),
+    seller_investor_id UUID NOT NULL REFERENCES investors(id),
+    buyer_investor_id UUID REFERENCES investors(id),
+    price NUMERIC(20, 0) NOT NULL CHECK (price > 0),
+    status VARCHAR NOT NULL DEFAULT 'open',
+    sold_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_investment_listings_status ON investment_listings(status);
+CREATE UNIQUE INDEX idx_investment_listings_open_investment_id ON investment_listings(investment_id) WHERE status = 'open' AND deleted_at IS NULL;
+
+CREATE TABLE investment_transfers (
+    id UUID PRIMARY KEY,
+    listing_id UUID NOT NULL REFERENCES investment_listings(id),
+    investment_id UUID NOT NULL REFERENCES investments(id),
+    loan_id UUID NOT NULL REFERENCES loans(id),
+    seller_investor_id UUID NOT NULL REFERENCES investors(id),
+    buyer_investor_id UUID NOT NULL REFERENCES investors(id),
+    price NUMERIC(20, 0) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_investment_transfers_investment_id ON investment_transfers(investment_id);
+CREATE INDEX idx_investment_transfers_seller_investor_id ON investment_transfers(seller_investor_id);
+CREATE INDEX idx_investment_transfers_buyer_investor_id ON investment_transfers(buyer_investor_id);
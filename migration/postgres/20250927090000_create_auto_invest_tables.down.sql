@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS auto_investments;
+DROP TABLE IF EXISTS auto_invest_rules;
+
+ALTER TABLE borrowers DROP COLUMN IF EXISTS segment;
@@ -0,0 +1,34 @@
+ALTER TABLE borrowers ADD COLUMN segment VARCHAR NOT NULL DEFAULT 'micro';
+
+CREATE INDEX idx_borrowers_segment ON borrowers(segment);
+
+CREATE TABLE auto_invest_rules (
+    id UUID PRIMARY KEY,
+    investor_id UUID NOT NULL REFERENCES investors(id),
+    max_amount_per_loan NUMERIC(20, 0) NOT NULL CHECK (max_amount_per_loan > 0),
+    min_roi float4 NOT NULL DEFAULT 0,
+    max_tenor INTEGER NOT NULL CHECK (max_tenor > 0),
+    daily_budget NUMERIC(20, 0) NOT NULL CHECK (daily_budget > 0),
+    borrower_segments TEXT[],
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_auto_invest_rules_investor_id ON auto_invest_rules(investor_id);
+CREATE INDEX idx_auto_invest_rules_active ON auto_invest_rules(active) WHERE deleted_at IS NULL;
+
+CREATE TABLE auto_investments (
+    id UUID PRIMARY KEY,
+    rule_id UUID NOT NULL REFERENCES auto_invest_rules(id),
+    investor_id UUID NOT NULL REFERENCES investors(id),
+    loan_id UUID NOT NULL REFERENCES loans(id),
+    investment_id UUID NOT NULL REFERENCES investments(id),
+    amount NUMERIC(20, 0) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_auto_investments_rule_id_created_at ON auto_investments(rule_id, created_at);
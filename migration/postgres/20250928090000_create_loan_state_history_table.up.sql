@@ -0,0 +1,14 @@
+CREATE TABLE loan_state_history (
+    id UUID PRIMARY KEY,
+    loan_id UUID NOT NULL REFERENCES loans(id),
+    from_state VARCHAR NOT NULL,
+    to_state VARCHAR NOT NULL,
+    actor_type VARCHAR NOT NULL,
+    actor_id UUID,
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_loan_state_history_loan_id ON loan_state_history(loan_id);
@@ -0,0 +1,8 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS proposed_by,
+    DROP COLUMN IF EXISTS rejected_by,
+    DROP COLUMN IF EXISTS approved_by,
+    DROP COLUMN IF EXISTS disbursed_by;
+
+ALTER TABLE employees
+    DROP COLUMN IF EXISTS role;
@@ -0,0 +1,10 @@
+ALTER TABLE employees
+    ADD COLUMN role VARCHAR NOT NULL DEFAULT 'staff';
+
+UPDATE employees SET role = 'supervisor' WHERE id = '019955b8-0981-7c83-9078-c0e021845487';
+
+ALTER TABLE loans
+    ADD COLUMN proposed_by UUID REFERENCES employees(id),
+    ADD COLUMN rejected_by UUID REFERENCES employees(id),
+    ADD COLUMN approved_by UUID REFERENCES employees(id),
+    ADD COLUMN disbursed_by UUID REFERENCES employees(id);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS revoked_tokens;
+
+ALTER TABLE investors
+    DROP COLUMN IF EXISTS password_hash;
+
+ALTER TABLE employees
+    DROP COLUMN IF EXISTS password_hash;
@@ -0,0 +1,18 @@
+ALTER TABLE employees
+    ADD COLUMN password_hash VARCHAR NOT NULL DEFAULT '';
+
+ALTER TABLE investors
+    ADD COLUMN password_hash VARCHAR NOT NULL DEFAULT '';
+
+-- Seeded accounts sign in with the password "password"
+UPDATE employees SET password_hash = '$2a$10$lMY/iz09PnnMd7MnQcps9eORrpJZOxZ7UNjxiRsHDJKf469jfcuOO' WHERE password_hash = '';
+UPDATE investors SET password_hash = '$2a$10$lMY/iz09PnnMd7MnQcps9eORrpJZOxZ7UNjxiRsHDJKf469jfcuOO' WHERE password_hash = '';
+
+CREATE TABLE revoked_tokens (
+    id UUID PRIMARY KEY,
+    token_id UUID UNIQUE NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
@@ -0,0 +1,8 @@
+ALTER TABLE employees
+    ADD COLUMN role VARCHAR NOT NULL DEFAULT 'staff';
+
+UPDATE employees SET role = 'supervisor' WHERE id = '019955b8-0981-7c83-9078-c0e021845487';
+
+DROP TABLE IF EXISTS employee_roles;
+
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,39 @@
+CREATE TABLE roles (
+    id UUID PRIMARY KEY,
+    name VARCHAR UNIQUE NOT NULL,
+    description VARCHAR NOT NULL DEFAULT '',
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE TABLE employee_roles (
+    id UUID PRIMARY KEY,
+    employee_id UUID NOT NULL REFERENCES employees(id),
+    role_id UUID NOT NULL REFERENCES roles(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_employee_roles_employee_id_role_id ON employee_roles (employee_id, role_id) WHERE deleted_at IS NULL;
+
+INSERT INTO roles (id, name, description, permissions) VALUES
+('0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d01', 'field_agent', 'Proposes loans for borrowers and collects repayments', '{loan.create,loan.read,repayment.create}'),
+('0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d02', 'approver', 'Validates borrowers and approves or rejects proposed loans', '{loan.read,loan.approve,loan.reject}'),
+('0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d03', 'disbursement_officer', 'Hands over funds of invested loans to borrowers', '{loan.read,loan.disburse}'),
+('0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d04', 'supervisor', 'Oversees loans and acts on behalf of other employees', '{loan.read,loan.approve,loan.reject,loan.disburse,loan.act_on_behalf}'),
+('0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d05', 'admin', 'Manages employee roles and reviews investor withdrawals', '{loan.read,withdrawal.review,role.assign}');
+
+INSERT INTO employee_roles (id, employee_id, role_id) VALUES
+('0199a0c2-5c00-7b20-9c2f-2a3b4c5d6e01', '019955b8-0981-7c83-9078-c0e021845487', '0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d05'),
+('0199a0c2-5c00-7b20-9c2f-2a3b4c5d6e02', '019955b8-0981-7c83-9078-c0e021845487', '0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d04'),
+('0199a0c2-5c00-7b20-9c2f-2a3b4c5d6e03', '019955b8-0981-7dd9-a087-ace6d5e4906b', '0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d01'),
+('0199a0c2-5c00-7b20-9c2f-2a3b4c5d6e04', '019955b8-0981-7787-9747-c04068fbbd62', '0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d02'),
+('0199a0c2-5c00-7b20-9c2f-2a3b4c5d6e05', '019955b8-0981-7324-acd6-9166775bbb71', '0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d03'),
+('0199a0c2-5c00-7b20-9c2f-2a3b4c5d6e06', '019955b8-0981-7c16-afc0-8218a5d0131c', '0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d01');
+
+-- Roles replace the single supervisor flag
+ALTER TABLE employees
+    DROP COLUMN IF EXISTS role;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS loan_approval_votes;
+
+DROP TABLE IF EXISTS loan_approval_policies;
@@ -0,0 +1,29 @@
+CREATE TABLE loan_approval_policies (
+    id UUID PRIMARY KEY,
+    min_amount float8 NOT NULL,
+    required_approvals INT NOT NULL CHECK (required_approvals >= 1),
+    required_roles TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_loan_approval_policies_min_amount ON loan_approval_policies (min_amount) WHERE deleted_at IS NULL;
+
+CREATE TABLE loan_approval_votes (
+    id UUID PRIMARY KEY,
+    loan_id UUID NOT NULL REFERENCES loans(id),
+    validator_employee_id UUID NOT NULL REFERENCES employees(id),
+    visit_proof_picture_url VARCHAR NOT NULL,
+    recorded_by UUID NOT NULL REFERENCES employees(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_loan_approval_votes_loan_id_validator_employee_id ON loan_approval_votes (loan_id, validator_employee_id) WHERE deleted_at IS NULL;
+
+-- Loans up to 50,000,000 keep needing a single approval
+INSERT INTO loan_approval_policies (id, min_amount, required_approvals, required_roles) VALUES
+('0199a5e8-2400-7c30-8d3a-3b4c5d6e7f01', 50000000, 2, '{approver,supervisor}'),
+('0199a5e8-2400-7c30-8d3a-3b4c5d6e7f02', 200000000, 3, '{approver,supervisor}');
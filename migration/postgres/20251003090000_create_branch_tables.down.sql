@@ -0,0 +1,15 @@
+UPDATE roles SET permissions = array_remove(permissions, 'branch.assign') WHERE name = 'admin';
+
+DELETE FROM employee_roles WHERE role_id = '0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d06';
+
+DELETE FROM roles WHERE id = '0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d06';
+
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS branch_id;
+
+ALTER TABLE borrowers
+    DROP COLUMN IF EXISTS branch_id;
+
+DROP TABLE IF EXISTS employee_branches;
+
+DROP TABLE IF EXISTS branches;
@@ -0,0 +1,62 @@
+CREATE TABLE branches (
+    id UUID PRIMARY KEY,
+    code VARCHAR UNIQUE NOT NULL,
+    name VARCHAR NOT NULL,
+    region VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE TABLE employee_branches (
+    id UUID PRIMARY KEY,
+    employee_id UUID NOT NULL REFERENCES employees(id),
+    branch_id UUID NOT NULL REFERENCES branches(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_employee_branches_employee_id_branch_id ON employee_branches (employee_id, branch_id) WHERE deleted_at IS NULL;
+
+INSERT INTO branches (id, code, name, region) VALUES
+('0199aa0e-1800-7d40-9e4b-4c5d6e7f8a01', 'JKS', 'Jakarta Selatan', 'Jabodetabek'),
+('0199aa0e-1800-7d40-9e4b-4c5d6e7f8a02', 'BGR', 'Bogor', 'Jabodetabek'),
+('0199aa0e-1800-7d40-9e4b-4c5d6e7f8a03', 'BDG', 'Bandung', 'Jawa Barat');
+
+-- Existing borrowers and loans start in Jakarta Selatan
+ALTER TABLE borrowers
+    ADD COLUMN branch_id UUID REFERENCES branches(id);
+
+UPDATE borrowers SET branch_id = '0199aa0e-1800-7d40-9e4b-4c5d6e7f8a01';
+UPDATE borrowers SET branch_id = '0199aa0e-1800-7d40-9e4b-4c5d6e7f8a02' WHERE id = '01995594-af29-709c-bf5f-96cb28d01412';
+UPDATE borrowers SET branch_id = '0199aa0e-1800-7d40-9e4b-4c5d6e7f8a03' WHERE id = '01995594-af29-7d57-a3fc-8553b4421c67';
+
+ALTER TABLE borrowers
+    ALTER COLUMN branch_id SET NOT NULL;
+
+ALTER TABLE loans
+    ADD COLUMN branch_id UUID REFERENCES branches(id);
+
+UPDATE loans SET branch_id = borrowers.branch_id FROM borrowers WHERE borrowers.id = loans.borrower_id;
+
+ALTER TABLE loans
+    ALTER COLUMN branch_id SET NOT NULL;
+
+CREATE INDEX idx_loans_branch_id ON loans (branch_id);
+
+INSERT INTO employee_branches (id, employee_id, branch_id) VALUES
+('0199aa0e-1800-7e50-8f5c-5d6e7f8a9b01', '019955b8-0981-7dd9-a087-ace6d5e4906b', '0199aa0e-1800-7d40-9e4b-4c5d6e7f8a01'),
+('0199aa0e-1800-7e50-8f5c-5d6e7f8a9b02', '019955b8-0981-7787-9747-c04068fbbd62', '0199aa0e-1800-7d40-9e4b-4c5d6e7f8a01'),
+('0199aa0e-1800-7e50-8f5c-5d6e7f8a9b03', '019955b8-0981-7324-acd6-9166775bbb71', '0199aa0e-1800-7d40-9e4b-4c5d6e7f8a01'),
+('0199aa0e-1800-7e50-8f5c-5d6e7f8a9b04', '019955b8-0981-7324-acd6-9166775bbb71', '0199aa0e-1800-7d40-9e4b-4c5d6e7f8a02'),
+('0199aa0e-1800-7e50-8f5c-5d6e7f8a9b05', '019955b8-0981-7c16-afc0-8218a5d0131c', '0199aa0e-1800-7d40-9e4b-4c5d6e7f8a03');
+
+-- Head office sees every branch; Alice works at head office
+INSERT INTO roles (id, name, description, permissions) VALUES
+('0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d06', 'head_office', 'Sees and acts on loans of every branch', '{loan.read,branch.all}');
+
+INSERT INTO employee_roles (id, employee_id, role_id) VALUES
+('0199a0c2-5c00-7b20-9c2f-2a3b4c5d6e07', '019955b8-0981-7c83-9078-c0e021845487', '0199a0c2-5c00-7a10-8b1e-1f2a3b4c5d06');
+
+UPDATE roles SET permissions = array_append(permissions, 'branch.assign') WHERE name = 'admin';
@@ -0,0 +1,10 @@
+UPDATE roles SET permissions = array_remove(permissions, 'group.manage') WHERE name = 'field_agent';
+
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS group_loan_id;
+
+DROP TABLE IF EXISTS group_loans;
+
+DROP TABLE IF EXISTS borrower_group_members;
+
+DROP TABLE IF EXISTS borrower_groups;
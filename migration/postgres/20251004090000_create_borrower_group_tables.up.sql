@@ -0,0 +1,45 @@
+CREATE TABLE borrower_groups (
+    id UUID PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    branch_id UUID NOT NULL REFERENCES branches(id),
+    leader_borrower_id UUID NOT NULL REFERENCES borrowers(id),
+    meeting_day VARCHAR NOT NULL,
+    meeting_time VARCHAR NOT NULL,
+    meeting_location VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_borrower_groups_branch_id ON borrower_groups (branch_id);
+
+CREATE TABLE borrower_group_members (
+    id UUID PRIMARY KEY,
+    group_id UUID NOT NULL REFERENCES borrower_groups(id),
+    borrower_id UUID NOT NULL REFERENCES borrowers(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+-- A borrower belongs to at most one group at a time
+CREATE UNIQUE INDEX idx_borrower_group_members_borrower_id ON borrower_group_members (borrower_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_borrower_group_members_group_id ON borrower_group_members (group_id);
+
+CREATE TABLE group_loans (
+    id UUID PRIMARY KEY,
+    group_id UUID NOT NULL REFERENCES borrower_groups(id),
+    branch_id UUID NOT NULL REFERENCES branches(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_group_loans_group_id ON group_loans (group_id);
+
+ALTER TABLE loans
+    ADD COLUMN group_loan_id UUID REFERENCES group_loans(id);
+
+CREATE INDEX idx_loans_group_loan_id ON loans (group_loan_id);
+
+UPDATE roles SET permissions = array_append(permissions, 'group.manage') WHERE name = 'field_agent';
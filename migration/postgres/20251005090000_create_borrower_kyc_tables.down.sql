@@ -0,0 +1,10 @@
+UPDATE roles SET permissions = array_remove(permissions, 'kyc.review') WHERE name IN ('approver', 'supervisor');
+UPDATE roles SET permissions = array_remove(permissions, 'borrower.manage') WHERE name = 'field_agent';
+UPDATE roles SET permissions = array_remove(permissions, 'borrower.read');
+
+DROP TABLE IF EXISTS borrower_kyc_documents;
+
+DROP INDEX IF EXISTS idx_borrowers_branch_id;
+
+ALTER TABLE borrowers
+    DROP COLUMN IF EXISTS kyc_status;
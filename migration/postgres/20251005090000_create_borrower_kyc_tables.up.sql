@@ -0,0 +1,29 @@
+-- Existing borrowers were onboarded before KYC was tracked; active ones have been lending and count as verified
+ALTER TABLE borrowers
+    ADD COLUMN kyc_status VARCHAR NOT NULL DEFAULT 'pending';
+
+UPDATE borrowers SET kyc_status = 'verified' WHERE status = 'active';
+
+CREATE INDEX idx_borrowers_kyc_status ON borrowers(kyc_status);
+CREATE INDEX idx_borrowers_branch_id ON borrowers(branch_id);
+
+CREATE TABLE borrower_kyc_documents (
+    id UUID PRIMARY KEY,
+    borrower_id UUID NOT NULL REFERENCES borrowers(id),
+    type VARCHAR NOT NULL,
+    file_url VARCHAR NOT NULL,
+    status VARCHAR NOT NULL,
+    reject_reason TEXT,
+    submitted_by UUID NOT NULL REFERENCES employees(id),
+    reviewed_by UUID REFERENCES employees(id),
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_borrower_kyc_documents_borrower_id ON borrower_kyc_documents (borrower_id);
+
+UPDATE roles SET permissions = array_append(permissions, 'borrower.read') WHERE 'loan.read' = ANY(permissions);
+UPDATE roles SET permissions = array_append(permissions, 'borrower.manage') WHERE name = 'field_agent';
+UPDATE roles SET permissions = array_append(permissions, 'kyc.review') WHERE name IN ('approver', 'supervisor');
@@ -0,0 +1,6 @@
+UPDATE roles SET permissions = array_remove(permissions, 'borrower.blacklist') WHERE name = 'supervisor';
+
+DROP TABLE IF EXISTS borrower_blacklist;
+
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS eligibility_checks;
@@ -0,0 +1,20 @@
+-- Loans proposed before eligibility rules existed keep an empty list of checks
+ALTER TABLE loans
+    ADD COLUMN eligibility_checks JSONB NOT NULL DEFAULT '[]';
+
+CREATE TABLE borrower_blacklist (
+    id UUID PRIMARY KEY,
+    borrower_id UUID NOT NULL REFERENCES borrowers(id),
+    reason TEXT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    blacklisted_by UUID NOT NULL REFERENCES employees(id),
+    lifted_by UUID REFERENCES employees(id),
+    lifted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_borrower_blacklist_borrower_id ON borrower_blacklist (borrower_id);
+
+UPDATE roles SET permissions = array_append(permissions, 'borrower.blacklist') WHERE name = 'supervisor';
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS credit_scorecards;
+
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS credit_score,
+    DROP COLUMN IF EXISTS risk_grade,
+    DROP COLUMN IF EXISTS scorecard_version,
+    DROP COLUMN IF EXISTS scored_at;
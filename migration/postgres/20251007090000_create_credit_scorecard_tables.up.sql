@@ -0,0 +1,30 @@
+-- Loans proposed before credit scoring existed stay unscored
+ALTER TABLE loans
+    ADD COLUMN credit_score INT,
+    ADD COLUMN risk_grade VARCHAR(1),
+    ADD COLUMN scorecard_version INT,
+    ADD COLUMN scored_at TIMESTAMPTZ;
+
+CREATE TABLE credit_scorecards (
+    id UUID PRIMARY KEY,
+    version INT NOT NULL,
+    weights JSONB NOT NULL,
+    segment_scores JSONB NOT NULL,
+    grade_cutoffs JSONB NOT NULL,
+    loan_size_reference NUMERIC(20, 0) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_credit_scorecards_version ON credit_scorecards (version);
+-- Only one scorecard can be active at a time
+CREATE UNIQUE INDEX idx_credit_scorecards_active ON credit_scorecards (active) WHERE active AND deleted_at IS NULL;
+
+INSERT INTO credit_scorecards (id, version, weights, segment_scores, grade_cutoffs, loan_size_reference, active) VALUES
+('6f1d2c4e-8a3b-4f5d-9c7e-1b2a3c4d5e6f', 1,
+ '{"repayment_history": 35, "loan_history": 20, "tenure": 15, "segment": 10, "loan_size": 20}',
+ '{"micro": 70, "small": 60, "medium": 50}',
+ '{"A": 80, "B": 65, "C": 50, "D": 35}',
+ 50000000, TRUE);
@@ -0,0 +1,33 @@
+CREATE TABLE pricing_tiers (
+    id UUID PRIMARY KEY,
+    risk_grade VARCHAR(1) NOT NULL,
+    min_tenor_months INT NOT NULL,
+    max_tenor_months INT NOT NULL,
+    rate float4 NOT NULL,
+    roi float4 NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CHECK (min_tenor_months <= max_tenor_months),
+    CHECK (roi < rate)
+);
+
+CREATE INDEX idx_pricing_tiers_risk_grade ON pricing_tiers (risk_grade, min_tenor_months);
+
+-- Rate and ROI are annual percentages, riskier grades and longer tenors pay more
+INSERT INTO pricing_tiers (id, risk_grade, min_tenor_months, max_tenor_months, rate, roi) VALUES
+('0b6e7a10-1c2d-4e3f-8a01-000000000001', 'A', 1, 6, 16, 13),
+('0b6e7a10-1c2d-4e3f-8a01-000000000002', 'A', 7, 12, 17, 14),
+('0b6e7a10-1c2d-4e3f-8a01-000000000003', 'A', 13, 36, 18, 15),
+('0b6e7a10-1c2d-4e3f-8a01-000000000004', 'B', 1, 6, 20, 16),
+('0b6e7a10-1c2d-4e3f-8a01-000000000005', 'B', 7, 12, 21, 17),
+('0b6e7a10-1c2d-4e3f-8a01-000000000006', 'B', 13, 36, 22, 18),
+('0b6e7a10-1c2d-4e3f-8a01-000000000007', 'C', 1, 6, 24, 19),
+('0b6e7a10-1c2d-4e3f-8a01-000000000008', 'C', 7, 12, 25, 20),
+('0b6e7a10-1c2d-4e3f-8a01-000000000009', 'C', 13, 36, 26, 21),
+('0b6e7a10-1c2d-4e3f-8a01-000000000010', 'D', 1, 6, 30, 23),
+('0b6e7a10-1c2d-4e3f-8a01-000000000011', 'D', 7, 12, 31, 24),
+('0b6e7a10-1c2d-4e3f-8a01-000000000012', 'D', 13, 36, 32, 25),
+('0b6e7a10-1c2d-4e3f-8a01-000000000013', 'E', 1, 6, 36, 26),
+('0b6e7a10-1c2d-4e3f-8a01-000000000014', 'E', 7, 12, 37, 27),
+('0b6e7a10-1c2d-4e3f-8a01-000000000015', 'E', 13, 36, 38, 28);
@@ -0,0 +1,2 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS amortization_method;
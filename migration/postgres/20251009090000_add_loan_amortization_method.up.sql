@@ -0,0 +1,3 @@
+ALTER TABLE loans
+    ADD COLUMN amortization_method VARCHAR NOT NULL DEFAULT 'flat'
+        CHECK (amortization_method IN ('flat', 'annuity', 'declining_balance'));
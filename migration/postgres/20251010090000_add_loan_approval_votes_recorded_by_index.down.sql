@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_loan_approval_votes_loan_id_recorded_by;
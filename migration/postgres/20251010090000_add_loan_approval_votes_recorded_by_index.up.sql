@@ -0,0 +1,2 @@
+-- An employee records at most one approval per loan, for themselves or on behalf of a validator
+CREATE UNIQUE INDEX idx_loan_approval_votes_loan_id_recorded_by ON loan_approval_votes (loan_id, recorded_by) WHERE deleted_at IS NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE loans
+    ALTER COLUMN tenor SET DEFAULT 0;
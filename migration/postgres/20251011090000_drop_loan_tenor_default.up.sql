@@ -0,0 +1,2 @@
+ALTER TABLE loans
+    ALTER COLUMN tenor DROP DEFAULT;
@@ -0,0 +1,2 @@
+ALTER TABLE loan_approval_policies
+    ALTER COLUMN min_amount TYPE float8 USING min_amount::float8;
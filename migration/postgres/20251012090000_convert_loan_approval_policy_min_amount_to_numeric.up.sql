@@ -0,0 +1,2 @@
+ALTER TABLE loan_approval_policies
+    ALTER COLUMN min_amount TYPE NUMERIC(20, 0) USING ROUND(min_amount::numeric);